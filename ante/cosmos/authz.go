@@ -3,16 +3,26 @@ package cosmos
 import (
 	"fmt"
 
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // maxNestedMsgs defines a cap for the number of nested messages on a MsgExec message
 const maxNestedMsgs = 7
 
+// DisabledAuthzMsgTypes are the type urls of the msgs that cannot be granted or
+// executed within the authorization module.
+var DisabledAuthzMsgTypes = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+}
+
 // AuthzLimiterDecorator blocks certain msg types from being granted or executed
 // within the authorization module.
 type AuthzLimiterDecorator struct {
@@ -0,0 +1,69 @@
+package cosmos
+
+import (
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BaseFeeDistributionDecorator splits the base fee charged to the Cosmos
+// transactions by the dynamic fee checker according to the feemarket params:
+// the burn share is burned and the treasury share is sent to the treasury.
+//
+// Unlike the EVM transactions, which are split on the gas used once the
+// leftover gas is refunded, the Cosmos transactions are split on the gas limit:
+// the SDK doesn't refund the fees of the unused gas, so the base fee is paid for
+// the whole gas limit. The split is done by the ante handler rather than a post
+// handler, whose changes are reverted along with the messages when they fail,
+// while the fees are still charged.
+//
+// It must run after the fee deduction and the signature verification, and does
+// nothing without feemarket params.
+type BaseFeeDistributionDecorator struct {
+	evmKeeper       anteinterfaces.EVMKeeper
+	feemarketParams *feemarkettypes.Params
+}
+
+// NewBaseFeeDistributionDecorator creates a new BaseFeeDistributionDecorator
+// instance used only for Cosmos transactions.
+func NewBaseFeeDistributionDecorator(evmKeeper anteinterfaces.EVMKeeper, feemarketParams *feemarkettypes.Params) BaseFeeDistributionDecorator {
+	return BaseFeeDistributionDecorator{
+		evmKeeper:       evmKeeper,
+		feemarketParams: feemarketParams,
+	}
+}
+
+func (bfd BaseFeeDistributionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// the fees are not checked against the base fee when simulating nor for
+	// the genesis transactions
+	height := ctx.BlockHeight()
+	if bfd.feemarketParams == nil || simulate || height == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := bfd.feemarketParams.BaseFee
+	if baseFee.IsNil() || !bfd.feemarketParams.IsBaseFeeEnabled(height) || !evmtypes.IsLondon(evmtypes.GetEthChainConfig(), height) {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	// the fee checker charges at least the base fee for the gas limit, which
+	// is not refunded
+	gasLimit := math.NewIntFromUint64(feeTx.GetGas())
+	baseFeeAmt := evmtypes.ConvertAmountTo18DecimalsLegacy(baseFee).MulInt(gasLimit).TruncateInt()
+	if err := bfd.evmKeeper.DistributeBaseFee(ctx, baseFeeAmt.BigInt()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -83,18 +83,24 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1")
 	}
 
+	// the sponsored transactions carry the signature of the fee granter, which
+	// is checked by VerifyFeeGranterSignature
 	authInfo := protoTx.AuthInfo
-	if len(authInfo.SignerInfos) > 0 {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
+	maxSigs := 0
+	if authInfo.Fee.Granter != "" {
+		maxSigs = 1
 	}
-
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	if len(authInfo.SignerInfos) != len(protoTx.Signatures) {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos and Signatures should match")
+	}
+	if len(authInfo.SignerInfos) > maxSigs {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should have at most %d entries", maxSigs)
 	}
 
-	sigs := protoTx.Signatures
-	if len(sigs) > 0 {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx Signatures should be empty")
+	// the fee granter pays the fees of the sponsored transactions, the fee payer
+	// is always the Ethereum signer
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
 	}
 
 	return authInfo.Fee, nil
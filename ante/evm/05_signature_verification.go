@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"bytes"
 	"math/big"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -12,6 +13,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 // EthSigVerificationDecorator validates an ethereum signatures
@@ -71,3 +74,39 @@ func SignatureVerification(
 
 	return nil
 }
+
+// VerifyFeeGranterSignature checks that the fee granter of a sponsored Ethereum
+// transaction signed the FeeGrantSignBytes of the transaction hash with the
+// single signature of the wrapping Cosmos tx. The granter isn't covered by the
+// Ethereum signature, so without it anyone could wrap the transaction with any
+// granter allowing its sender.
+func VerifyFeeGranterSignature(
+	tx sdk.Tx,
+	ethTx *ethtypes.Transaction,
+	granter sdk.AccAddress,
+) error {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrTxDecode, "invalid tx type %T, didn't implement interface SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if len(sigs) != 1 {
+		return errorsmod.Wrapf(errortypes.ErrNoSignatures, "fee granter %s must sign the sponsored transaction", granter)
+	}
+
+	pubKey := sigs[0].PubKey
+	if pubKey == nil || !bytes.Equal(pubKey.Address(), granter) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "sponsored transaction is not signed by fee granter %s", granter)
+	}
+
+	sigData, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	if !ok || !pubKey.VerifySignature(evmtypes.FeeGrantSignBytes(ethTx.Hash()), sigData.Signature) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid fee granter %s signature", granter)
+	}
+
+	return nil
+}
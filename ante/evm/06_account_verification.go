@@ -27,6 +27,21 @@ func VerifyAccountBalance(
 	account *statedb.Account,
 	from common.Address,
 	ethTx *ethtypes.Transaction,
+) error {
+	return verifyAccount(ctx, evmKeeper, accountKeeper, account, from, ethTx, true)
+}
+
+// verifyAccount checks that the sender is an EOA, and that its balance covers
+// the transaction cost if it pays the fees, the transferred value is checked
+// by CanTransfer otherwise.
+func verifyAccount(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+	paysFees bool,
 ) error {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.HasCodeHash() {
@@ -46,6 +61,10 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	if !paysFees {
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
@@ -36,18 +36,18 @@ func UpdateCumulativeGasWanted(
 	return cumulativeGasWanted
 }
 
-// ConsumeFeesAndEmitEvent deduces fees from sender and emits the event
+// ConsumeFeesAndEmitEvent deduces fees from the fee payer and emits the event
 func ConsumeFeesAndEmitEvent(
 	ctx sdktypes.Context,
 	evmKeeper anteinterfaces.EVMKeeper,
 	fees sdktypes.Coins,
-	from sdktypes.AccAddress,
+	feePayer sdktypes.AccAddress,
 ) error {
 	if err := deductFees(
 		ctx,
 		evmKeeper,
 		fees,
-		from,
+		feePayer,
 	); err != nil {
 		return err
 	}
@@ -56,6 +56,7 @@ func ConsumeFeesAndEmitEvent(
 		sdktypes.NewEvent(
 			sdktypes.EventTypeTx,
 			sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, feePayer.String()),
 		),
 	)
 	return nil
@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"bytes"
 	"math"
 	"math/big"
 
@@ -19,6 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 const AcceptedTxType = 0 |
@@ -33,6 +35,7 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  authante.FeegrantKeeper
 	maxGasWanted    uint64
 	evmParams       *evmtypes.Params
 	feemarketParams *feemarkettypes.Params
@@ -44,10 +47,15 @@ type MonoDecorator struct {
 // This runs all the default checks for EVM transactions enable through Cosmos EVM.
 // Any partner chains can use this in their ante handler logic and build additional EVM
 // decorators using the returned DecoratorUtils
+//
+// The fees of the transactions setting a fee granter are paid by the granter out
+// of its feegrant allowance to the Ethereum signer, they are rejected if the
+// feegrant keeper is nil.
 func NewEVMMonoDecorator(
 	accountKeeper anteinterfaces.AccountKeeper,
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
 	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 	evmParams *evmtypes.Params,
 	feemarketParams *feemarkettypes.Params,
@@ -56,6 +64,7 @@ func NewEVMMonoDecorator(
 		accountKeeper:   accountKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		evmKeeper:       evmKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		maxGasWanted:    maxGasWanted,
 		evmParams:       evmParams,
 		feemarketParams: feemarketParams,
@@ -76,6 +85,15 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
+	// the fees of the sponsored transactions are paid by the fee granter
+	var feeGranter sdk.AccAddress
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feeGranter = feeTx.FeeGranter()
+	}
+	if feeGranter != nil && md.feegrantKeeper == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
 	evmDenom := evmtypes.GetEVMCoinDenom()
 
 	// 1. setup ctx
@@ -171,21 +189,29 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	if feeGranter != nil {
+		if err := VerifyFeeGranterSignature(tx, ethTx, feeGranter); err != nil {
+			return ctx, err
+		}
+	}
+
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
+	sponsored := feeGranter != nil && !bytes.Equal(feeGranter, from)
 
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
+	if err := verifyAccount(
 		ctx,
 		md.evmKeeper,
 		md.accountKeeper,
 		account,
 		fromAddr,
 		ethTx,
+		!sponsored,
 	); err != nil {
 		return ctx, err
 	}
@@ -217,15 +243,28 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	feePayer := sdk.AccAddress(from)
+	if sponsored {
+		// the allowance is charged for the fees of the gas limit, the fees of the
+		// leftover gas are given back to it after the execution
+		md.evmKeeper.TrackTxFeeGrant(ctx, feeGranter, from, msgFees)
+		if err := md.feegrantKeeper.UseGrantedFees(ctx, feeGranter, from, msgFees, msgs); err != nil {
+			return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+		}
+		feePayer = feeGranter
+	}
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
 		msgFees,
-		from,
+		feePayer,
 	)
 	if err != nil {
 		return ctx, err
 	}
+	// the leftover gas is refunded to the fee payer after the execution
+	md.evmKeeper.SetTxFeePayer(ctx, feePayer)
 
 	gasWanted := UpdateCumulativeGasWanted(
 		ctx,
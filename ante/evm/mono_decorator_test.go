@@ -54,6 +54,14 @@ func (k *ExtendedEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, _ sdk.Co
 	return nil
 }
 
+func (k *ExtendedEVMKeeper) SetTxFeePayer(_ sdk.Context, _ sdk.AccAddress) {}
+
+func (k *ExtendedEVMKeeper) TrackTxFeeGrant(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) {}
+
+func (k *ExtendedEVMKeeper) DistributeBaseFee(_ sdk.Context, _ *big.Int) error {
+	return nil
+}
+
 func (k *ExtendedEVMKeeper) SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int {
 	account := k.GetAccount(ctx, addr)
 	if account != nil {
@@ -214,7 +222,7 @@ func TestMonoDecorator(t *testing.T) {
 			feeMarketKeeper := MockFeeMarketKeeper{}
 			params := keeper.GetParams(sdk.Context{})
 			feemarketParams := feeMarketKeeper.GetParams(sdk.Context{})
-			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, 0, &params, &feemarketParams)
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, nil, 0, &params, &feemarketParams)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
 			blockParams := tmproto.BlockParams{
@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
@@ -22,6 +24,9 @@ type EVMKeeper interface {
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer *tracing.Hooks,
 		stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	SetTxFeePayer(ctx sdk.Context, feePayer sdk.AccAddress)
+	TrackTxFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, fees sdk.Coins)
+	DistributeBaseFee(ctx sdk.Context, baseFee *big.Int) error
 	SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
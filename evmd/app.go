@@ -45,7 +45,13 @@ import (
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
+	ibccallbacksv2 "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/v2"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v10/modules/core"
@@ -179,9 +185,10 @@ type EVMD struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// IBC keepers
-	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	TransferKeeper transferkeeper.Keeper
-	CallbackKeeper ibccallbackskeeper.ContractKeeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper      transferkeeper.Keeper
+	ICAControllerKeeper *icacontrollerkeeper.Keeper
+	CallbackKeeper      ibccallbackskeeper.ContractKeeper
 
 	// Cosmos EVM keepers
 	FeeMarketKeeper   feemarketkeeper.Keeper
@@ -237,7 +244,7 @@ func NewExampleApp(
 		govtypes.StoreKey, consensusparamtypes.StoreKey,
 		upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey,
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
 	)
@@ -373,6 +380,12 @@ func NewExampleApp(
 		app.AccountKeeper,
 	)
 
+	// NOTE: the feegrant and authz precompiles call the msg servers of the keepers
+	// directly instead of the ones of the modules, and granting to a new account
+	// checks if it is a blocked address, so both keepers need the bank keeper.
+	app.FeeGrantKeeper = app.FeeGrantKeeper.SetBankKeeper(app.BankKeeper)
+	app.AuthzKeeper = app.AuthzKeeper.SetBankKeeper(app.BankKeeper)
+
 	// get skipUpgradeHeights from the app options
 	skipUpgradeHeights := map[int64]bool{}
 	for _, h := range cast.ToIntSlice(appOpts.Get(sdkserver.FlagUnsafeSkipUpgrades)) {
@@ -397,6 +410,15 @@ func NewExampleApp(
 		authAddr,
 	)
 
+	// NOTE: the ICA controller keeper is instantiated before the EVM keeper to be used by the ICS-27 precompile
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		authAddr,
+	)
+
 	govConfig := govtypes.DefaultConfig()
 	/*
 		Example of setting gov params:
@@ -457,6 +479,10 @@ func NewExampleApp(
 		&app.Erc20Keeper,
 		evmChainID,
 		tracer,
+	).WithDistributionKeeper(
+		app.DistrKeeper,
+	).WithFeeGrantKeeper(
+		app.FeeGrantKeeper,
 	).WithStaticPrecompiles(
 		precompiletypes.DefaultStaticPrecompiles(
 			*app.StakingKeeper,
@@ -465,13 +491,24 @@ func NewExampleApp(
 			&app.Erc20Keeper,
 			&app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.IBCKeeper.ChannelKeeperV2,
 			app.IBCKeeper.ClientKeeper,
+			app.IBCKeeper.ClientV2Keeper,
+			app.ICAControllerKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
 			appCodec,
 		),
 	)
 
+	liveTracer, err := cosmosevmserver.GetLiveTracer(appOpts, logger)
+	if err != nil {
+		panic(err)
+	}
+	app.EVMKeeper.WithLiveTracer(liveTracer)
+
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
 		appCodec,
@@ -527,13 +564,53 @@ func NewExampleApp(
 	callbacksMiddleware.SetUnderlyingApplication(transferStack)
 	transferStack = callbacksMiddleware
 
+	/*
+		Create Interchain Accounts Controller Stack
+
+		controller stack contains (from bottom to top):
+			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- ICA Controller
+
+		SendPacket, since it is originating from the ICS-27 precompile to core IBC:
+		 	icaControllerKeeper.SendTx -> callbacks.SendPacket -> channel.SendPacket
+	*/
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	icaCallbacksMiddleware := ibccallbacks.NewIBCMiddleware(app.CallbackKeeper, maxCallbackGas)
+	icaCallbacksMiddleware.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
+	icaCallbacksMiddleware.SetUnderlyingApplication(icaControllerStack)
+	app.ICAControllerKeeper.WithICS4Wrapper(icaCallbacksMiddleware)
+	icaControllerStack = icaCallbacksMiddleware
+
+	/*
+		Create Transfer Stack for IBC v2
+
+		transfer stack contains (from bottom to top):
+			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- ERC-20 Middleware
+			- IBC Transfer
+
+		SendPacket, since it is originating from core IBC to the application:
+			channel.SendPacket -> callbacks.OnSendPacket -> erc20.OnSendPacket -> transfer.OnSendPacket
+
+		RecvPacket, message that originates from core IBC and goes down to app:
+			channel.RecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
+	*/
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
+	transferStackV2 = ibccallbacksv2.NewIBCMiddleware(
+		transferStackV2,
+		app.IBCKeeper.ChannelKeeperV2,
+		app.CallbackKeeper,
+		app.IBCKeeper.ChannelKeeperV2,
+		maxCallbackGas,
+	)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer and interchain accounts controller routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -574,6 +651,7 @@ func NewExampleApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		transferModule,
+		ica.NewAppModule(app.ICAControllerKeeper, nil),
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.BankKeeper, app.AccountKeeper.AddressCodec()),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
@@ -614,7 +692,7 @@ func NewExampleApp(
 		minttypes.ModuleName,
 
 		// IBC modules
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 
 		// Cosmos EVM BeginBlockers
 		erc20types.ModuleName, feemarkettypes.ModuleName,
@@ -642,7 +720,7 @@ func NewExampleApp(
 		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
 
 		// no-ops
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
@@ -669,7 +747,7 @@ func NewExampleApp(
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
 
-		ibctransfertypes.ModuleName,
+		ibctransfertypes.ModuleName, icatypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	}
@@ -1014,6 +1092,10 @@ func (app *EVMD) GetFeeGrantKeeper() feegrantkeeper.Keeper {
 	return app.FeeGrantKeeper
 }
 
+func (app *EVMD) GetAuthzKeeper() authzkeeper.Keeper {
+	return app.AuthzKeeper
+}
+
 func (app *EVMD) GetConsensusParamsKeeper() consensusparamkeeper.Keeper {
 	return app.ConsensusParamsKeeper
 }
@@ -1075,6 +1157,8 @@ func (app *EVMD) Close() error {
 		err = m.Close()
 	}
 
+	app.EVMKeeper.CloseLiveTracer()
+
 	msg := "Application gracefully shutdown"
 	err = errors.Join(err, app.BaseApp.Close())
 	if err == nil {
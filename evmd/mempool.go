@@ -54,6 +54,7 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 			sdkmempool.NewDefaultSignerExtractionAdapter(),
 		),
 	)
+	abciProposalHandler.SetTxSelector(evmmempool.NewTxSelector(evmMempool))
 	app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
 
 	return nil
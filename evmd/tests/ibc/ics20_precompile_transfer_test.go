@@ -51,6 +51,9 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		*evmAppA.StakingKeeper,
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
+		evmAppA.IBCKeeper.ChannelKeeperV2,
+		evmAppA.IBCKeeper.ClientKeeper,
+		evmAppA.IBCKeeper.ClientV2Keeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
 	suite.chainBPrecompile = ics20.NewPrecompile(
@@ -58,6 +61,9 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		*evmAppB.StakingKeeper,
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
+		evmAppB.IBCKeeper.ChannelKeeperV2,
+		evmAppB.IBCKeeper.ClientKeeper,
+		evmAppB.IBCKeeper.ClientV2Keeper,
 	)
 }
 
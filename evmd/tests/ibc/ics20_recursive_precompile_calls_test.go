@@ -232,6 +232,9 @@ func (suite *ICS20RecursivePrecompileCallsTestSuite) SetupTest() {
 		*evmAppA.StakingKeeper,
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
+		evmAppA.IBCKeeper.ChannelKeeperV2,
+		evmAppA.IBCKeeper.ClientKeeper,
+		evmAppA.IBCKeeper.ClientV2Keeper,
 	)
 	bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
 	suite.Require().NoError(err)
@@ -248,6 +251,9 @@ func (suite *ICS20RecursivePrecompileCallsTestSuite) SetupTest() {
 		*evmAppB.StakingKeeper,
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
+		evmAppB.IBCKeeper.ChannelKeeperV2,
+		evmAppB.IBCKeeper.ClientKeeper,
+		evmAppB.IBCKeeper.ClientV2Keeper,
 	)
 }
 
@@ -0,0 +1,279 @@
+package ibc
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/ics27"
+	chainutil "github.com/cosmos/evm/testutil"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	ibctestutil "github.com/cosmos/evm/x/ibc/callbacks/testutil"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type ICS27PrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *ics27.Precompile
+	chainB           *evmibctesting.TestChain
+
+	path *evmibctesting.Path
+}
+
+func (suite *ICS27PrecompileTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	suite.chainAPrecompile = ics27.NewPrecompile(
+		icacontrollerkeeper.NewMsgServerImpl(evmAppA.ICAControllerKeeper),
+		evmAppA.ICAControllerKeeper,
+		evmAppA.BankKeeper,
+	)
+
+	suite.path = evmibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupConnections()
+}
+
+// registerInterchainAccount registers an interchain account of the sender through the
+// precompile and completes the channel handshake, returning the account address on chain B.
+func (suite *ICS27PrecompileTestSuite) registerInterchainAccount(senderAcc evmibctesting.SenderAccount, senderIdx int) string {
+	owner := common.BytesToAddress(senderAcc.SenderAccount.GetAddress().Bytes())
+
+	data, err := suite.chainAPrecompile.Pack(ics27.RegisterInterchainAccountMethod,
+		owner,
+		suite.path.EndpointA.ConnectionID,
+		"", // use the default metadata
+		false,
+	)
+	suite.Require().NoError(err)
+
+	_, _, _, err = suite.chainA.SendEvmTx(senderAcc, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+
+	portID, err := icatypes.NewControllerPortID(senderAcc.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	// the channel is in INIT state until the handshake is completed
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	channels := evmAppA.IBCKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(suite.chainA.GetContext(), portID)
+	suite.Require().Len(channels, 1)
+	suite.Require().Equal(channeltypes.INIT, channels[0].State)
+
+	suite.path.EndpointA.ChannelID = channels[0].ChannelId
+	suite.path.EndpointA.ChannelConfig.PortID = portID
+	suite.path.EndpointA.ChannelConfig.Version = channels[0].Version
+	suite.path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	suite.path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	suite.path.EndpointB.ChannelConfig.Version = channels[0].Version
+	suite.path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(suite.path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+
+	// query the interchain account address through the precompile
+	ctxA := evmante.BuildEvmExecutionCtx(suite.chainA.GetContext())
+	evmRes, err := evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		owner,
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ics27.GetInterchainAccountAddressMethod,
+		owner,
+		suite.path.EndpointA.ConnectionID,
+	)
+	suite.Require().NoError(err)
+	var accountAddress string
+	err = suite.chainAPrecompile.UnpackIntoInterface(&accountAddress, ics27.GetInterchainAccountAddressMethod, evmRes.Ret)
+	suite.Require().NoError(err)
+
+	expAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), suite.path.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+	suite.Require().Equal(expAddress, accountAddress)
+
+	return accountAddress
+}
+
+func (suite *ICS27PrecompileTestSuite) TestSendTx() {
+	testCases := []struct {
+		name       string
+		timeout    bool
+		expCounter int64
+	}{
+		{
+			"acknowledged transaction calls back the contract",
+			false,
+			1,
+		},
+		{
+			"timed out transaction calls back the contract",
+			true,
+			-1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			senderIdx := 1
+			senderAcc := suite.chainA.SenderAccounts[senderIdx]
+			owner := common.BytesToAddress(senderAcc.SenderAccount.GetAddress().Bytes())
+
+			// the account is not registered yet
+			evmAppA := suite.chainA.App.(*evmd.EVMD)
+			evmRes, err := evmAppA.EVMKeeper.CallEVM(
+				evmante.BuildEvmExecutionCtx(suite.chainA.GetContext()),
+				suite.chainAPrecompile.ABI,
+				owner,
+				suite.chainAPrecompile.Address(),
+				false,
+				nil,
+				ics27.GetInterchainAccountAddressMethod,
+				owner,
+				suite.path.EndpointA.ConnectionID,
+			)
+			suite.Require().NoError(err)
+			var emptyAddress string
+			err = suite.chainAPrecompile.UnpackIntoInterface(&emptyAddress, ics27.GetInterchainAccountAddressMethod, evmRes.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Empty(emptyAddress)
+
+			icaAddress := suite.registerInterchainAccount(senderAcc, senderIdx)
+
+			// fund the interchain account on chain B
+			appB := suite.chainB.GetSimApp()
+			bondDenom, err := appB.StakingKeeper.BondDenom(suite.chainB.GetContext())
+			suite.Require().NoError(err)
+			fundAmt := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000_000)))
+			_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(icaAddress), fundAmt))
+			suite.Require().NoError(err)
+
+			// deploy the callbacks contract on chain A
+			contractData, err := ibctestutil.LoadCounterWithCallbacksContract()
+			suite.Require().NoError(err)
+			contractAddr, err := DeployContract(suite.T(), suite.chainA, testutiltypes.ContractDeploymentData{
+				Contract:        contractData,
+				ConstructorArgs: nil,
+			})
+			suite.Require().NoError(err)
+			// the deployment increments the nonce of the relayer account
+			err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+			suite.Require().NoError(err)
+
+			// the interchain account sends part of its funds to the receiver
+			receiver := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			sendAmt := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000)))
+			cosmosTx, err := icatypes.SerializeCosmosTx(
+				appB.AppCodec(),
+				[]proto.Message{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddress), receiver, sendAmt)},
+				icatypes.EncodingProtobuf,
+			)
+			suite.Require().NoError(err)
+
+			memo := fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d"}}`, contractAddr.Hex(), 1_000_000)
+			relativeTimeout := uint64(time.Hour.Nanoseconds())
+			data, err := suite.chainAPrecompile.Pack(ics27.SendTxMethod,
+				owner,
+				suite.path.EndpointA.ConnectionID,
+				cosmosTx,
+				memo,
+				relativeTimeout,
+			)
+			suite.Require().NoError(err)
+
+			res, _, _, err := suite.chainA.SendEvmTx(senderAcc, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+			suite.Require().NoError(err)
+			packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			receiverBalance := appB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+
+			if tc.timeout {
+				suite.coordinator.IncrementTimeBy(time.Duration(relativeTimeout) + time.Minute) //nolint:gosec // G115 // won't exceed int64
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+				// the transaction is not executed on chain B
+				afterReceiverBalance := appB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+				suite.Require().Equal(receiverBalance, afterReceiverBalance)
+			} else {
+				suite.Require().NoError(suite.path.RelayPacket(packet))
+
+				// the transaction is executed on chain B
+				afterReceiverBalance := appB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+				suite.Require().Equal(receiverBalance.Add(sendAmt[0]), afterReceiverBalance)
+			}
+
+			// the contract is called back on chain A
+			counterRes, err := evmAppA.EVMKeeper.CallEVM(
+				suite.chainA.GetContext(),
+				contractData.ABI,
+				owner,
+				contractAddr,
+				false,
+				big.NewInt(100000),
+				"getCounter",
+			)
+			suite.Require().NoError(err)
+			var counter *big.Int
+			err = contractData.ABI.UnpackIntoInterface(&counter, "getCounter", counterRes.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCounter, counter.Int64())
+		})
+	}
+}
+
+func (suite *ICS27PrecompileTestSuite) TestRegisterInterchainAccountUnauthorized() {
+	sender := common.BytesToAddress(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().Bytes())
+	otherOwner := common.BytesToAddress(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().Bytes())
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	evmRes, err := evmAppA.EVMKeeper.CallEVM(
+		evmante.BuildEvmExecutionCtx(suite.chainA.GetContext()),
+		suite.chainAPrecompile.ABI,
+		sender,
+		suite.chainAPrecompile.Address(),
+		true,
+		nil,
+		ics27.RegisterInterchainAccountMethod,
+		otherOwner,
+		suite.path.EndpointA.ConnectionID,
+		"",
+		false,
+	)
+	suite.Require().ErrorContains(err, vm.ErrExecutionReverted.Error())
+
+	revertErr := chainutil.DecodeRevertReason(*evmRes)
+	suite.Require().Contains(revertErr.Error(), "does not match the requester address")
+}
+
+func TestICS27PrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(ICS27PrecompileTestSuite))
+}
@@ -22,6 +22,7 @@ import (
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -52,6 +53,9 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		*evmAppA.StakingKeeper,
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
+		evmAppA.IBCKeeper.ChannelKeeperV2,
+		evmAppA.IBCKeeper.ClientKeeper,
+		evmAppA.IBCKeeper.ClientV2Keeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
 	suite.chainBPrecompile = ics20.NewPrecompile(
@@ -59,6 +63,9 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		*evmAppB.StakingKeeper,
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
+		evmAppB.IBCKeeper.ChannelKeeperV2,
+		evmAppB.IBCKeeper.ClientKeeper,
+		evmAppB.IBCKeeper.ClientV2Keeper,
 	)
 }
 
@@ -359,6 +366,147 @@ func (suite *ICS20TransferV2TestSuite) TestHandleMsgTransfer() {
 	}
 }
 
+// TestTransferV2MultiplePayloads sends two payloads in a single IBC v2 packet
+// and checks that both are received on the counterparty chain.
+func (suite *ICS20TransferV2TestSuite) TestTransferV2MultiplePayloads() {
+	pathAToB := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	pathAToB.SetupV2()
+	traceAToB := transfertypes.NewHop(transfertypes.PortID, pathAToB.EndpointB.ClientID)
+
+	senderIdx := 1
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	senderAddr := senderAccount.SenderAccount.GetAddress()
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+	senderBalance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), senderAddr, bondDenom)
+
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	amounts := []sdkmath.Int{sdkmath.NewInt(100), sdkmath.NewInt(250)}
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix()) //nolint:gosec // G115
+
+	data, err := suite.chainAPrecompile.Pack(ics20.TransferV2Method,
+		pathAToB.EndpointA.ClientID,
+		[]ics20.TransferPayload{
+			{Denom: bondDenom, Amount: amounts[0].BigInt(), Receiver: receiver},
+			{Denom: bondDenom, Amount: amounts[1].BigInt(), Receiver: receiver, Encoding: transfertypes.EncodingProtobuf},
+		},
+		timeoutTimestamp,
+		"",
+	)
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	packets, err := pathAToB.EndpointA.ParseV2PacketFromEvent(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+	suite.Require().Len(packets[0].Payloads, 2)
+	suite.Require().Equal(transfertypes.EncodingJSON, packets[0].Payloads[0].Encoding)
+	suite.Require().Equal(transfertypes.EncodingProtobuf, packets[0].Payloads[1].Encoding)
+
+	total := amounts[0].Add(amounts[1])
+	afterSenderBalance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), senderAddr, bondDenom)
+	suite.Require().Equal(senderBalance.Amount.Sub(total).String(), afterSenderBalance.Amount.String())
+
+	err = pathAToB.RelayPacketV2(packets[0])
+	suite.Require().NoError(err)
+
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, pathAToB.EndpointA.ClientID)
+	chainAEscrowBalance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, bondDenom)
+	suite.Require().Equal(total.String(), chainAEscrowBalance.Amount.String())
+
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	chainBDenom := transfertypes.NewDenom(bondDenom, traceAToB)
+	chainBBalance := evmAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), chainBDenom.IBCDenom())
+	suite.Require().Equal(sdk.NewCoin(chainBDenom.IBCDenom(), total), chainBBalance)
+}
+
+// TestClientQueries checks the clientInfo and counterpartyInfo query methods
+// of the ICS20 precompile against an IBC v2 path.
+func (suite *ICS20TransferV2TestSuite) TestClientQueries() {
+	pathAToB := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	pathAToB.SetupV2()
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	chainAAddr := common.BytesToAddress(suite.chainA.SenderAccount.GetAddress().Bytes())
+	ctxA := evmante.BuildEvmExecutionCtx(suite.chainA.GetContext())
+
+	// clientInfo query method
+	evmRes, err := evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		chainAAddr,
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ics20.ClientInfoMethod,
+		pathAToB.EndpointA.ClientID,
+	)
+	suite.Require().NoError(err)
+	var clientInfoResponse struct{ ClientInfo ics20.ClientInfo }
+	err = suite.chainAPrecompile.UnpackIntoInterface(&clientInfoResponse, ics20.ClientInfoMethod, evmRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcexported.Tendermint, clientInfoResponse.ClientInfo.ClientType)
+	suite.Require().Equal(ibcexported.Active.String(), clientInfoResponse.ClientInfo.Status)
+	suite.Require().Equal(
+		evmAppA.IBCKeeper.ClientKeeper.GetClientLatestHeight(suite.chainA.GetContext(), pathAToB.EndpointA.ClientID),
+		clientInfoResponse.ClientInfo.LatestHeight,
+	)
+
+	// clientInfo query method invalid error case
+	evmRes, err = evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		chainAAddr,
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ics20.ClientInfoMethod,
+		"invalid",
+	)
+	suite.Require().ErrorContains(err, vm.ErrExecutionReverted.Error())
+	revertErr := chainutil.DecodeRevertReason(*evmRes)
+	suite.Require().Contains(revertErr.Error(), "invalid client ID")
+	ctxA.GasMeter().RefundGas(ctxA.GasMeter().Limit(), "refund after error")
+
+	// counterpartyInfo query method
+	evmRes, err = evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		chainAAddr,
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ics20.CounterpartyInfoMethod,
+		pathAToB.EndpointA.ClientID,
+	)
+	suite.Require().NoError(err)
+	var counterpartyInfoResponse struct{ CounterpartyInfo ics20.CounterpartyInfo }
+	err = suite.chainAPrecompile.UnpackIntoInterface(&counterpartyInfoResponse, ics20.CounterpartyInfoMethod, evmRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pathAToB.EndpointB.ClientID, counterpartyInfoResponse.CounterpartyInfo.ClientID)
+	suite.Require().NotEmpty(counterpartyInfoResponse.CounterpartyInfo.MerklePrefix)
+
+	// counterpartyInfo query method not exists case
+	evmRes, err = evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		chainAAddr,
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ics20.CounterpartyInfoMethod,
+		"07-tendermint-99",
+	)
+	suite.Require().NoError(err)
+	err = suite.chainAPrecompile.UnpackIntoInterface(&counterpartyInfoResponse, ics20.CounterpartyInfoMethod, evmRes.Ret)
+	suite.Require().NoError(err)
+	// ensure empty counterparty info struct when not exist
+	suite.Require().Equal(ics20.CounterpartyInfo{MerklePrefix: [][]byte{}}, counterpartyInfoResponse.CounterpartyInfo)
+}
+
 func TestICS20TransferV2TestSuite(t *testing.T) {
 	suite.Run(t, new(ICS20TransferV2TestSuite))
 }
@@ -12,3 +12,18 @@ func TestKVIndexer(t *testing.T) {
 	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
 	indexer.TestKVIndexer(t, create)
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestKVIndexerLogs(t, create)
+}
+
+func TestSQLIndexer(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestSQLIndexer(t, create)
+}
+
+func TestIndexerRewindAndPrune(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestIndexerRewindAndPrune(t, create)
+}
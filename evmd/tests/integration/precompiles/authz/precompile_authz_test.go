@@ -0,0 +1,18 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	evm "github.com/cosmos/evm"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/authz"
+	testapp "github.com/cosmos/evm/testutil/app"
+)
+
+func TestAuthzPrecompileTestSuite(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.AuthzPrecompileApp](integration.CreateEvmd, "evm.AuthzPrecompileApp")
+	s := authz.NewPrecompileTestSuite(create)
+	suite.Run(t, s)
+}
@@ -0,0 +1,18 @@
+package feegrant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	evm "github.com/cosmos/evm"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/feegrant"
+	testapp "github.com/cosmos/evm/testutil/app"
+)
+
+func TestFeegrantPrecompileTestSuite(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.FeegrantPrecompileApp](integration.CreateEvmd, "evm.FeegrantPrecompileApp")
+	s := feegrant.NewPrecompileTestSuite(create)
+	suite.Run(t, s)
+}
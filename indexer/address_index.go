@@ -0,0 +1,146 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressTxKeyLength is the length of the address index keys
+const AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
+
+// GetBySender returns a page of the eth txs sent by the address.
+func (kv *KVIndexer) GetBySender(addr common.Address, query servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	txs, err := kv.getByAddress(KeyPrefixSender, addr, query)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBySender %s", addr.Hex())
+	}
+	return txs, nil
+}
+
+// GetByRecipient returns a page of the eth txs sent to the address.
+func (kv *KVIndexer) GetByRecipient(addr common.Address, query servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	txs, err := kv.getByAddress(KeyPrefixRecipient, addr, query)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByRecipient %s", addr.Hex())
+	}
+	return txs, nil
+}
+
+// GetContractCreation finds the eth tx that created the contract, returns nil
+// if the contract was not created by a top-level eth tx.
+func (kv *KVIndexer) GetContractCreation(addr common.Address) (*servertypes.IndexedTx, error) {
+	txs, err := kv.getByAddress(KeyPrefixContract, addr, servertypes.AddressTxsQuery{ToBlock: math.MaxInt64, Limit: 1})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreation %s", addr.Hex())
+	}
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	return &txs[0], nil
+}
+
+// getByAddress iterates the address index entries of the given prefix.
+func (kv *KVIndexer) getByAddress(prefix byte, addr common.Address, query servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	if query.ToBlock < 0 || query.ToBlock < query.FromBlock {
+		return nil, nil
+	}
+	start := AddressTxKey(prefix, addr, max(query.FromBlock, 0), 0)
+	// the end of the iterator is exclusive
+	end := append(AddressTxKey(prefix, addr, query.ToBlock, math.MaxInt32), 0)
+	if query.After != nil {
+		after := AddressTxKey(prefix, addr, query.After.Height, query.After.EthTxIndex)
+		if !query.Reverse && bytes.Compare(after, start) >= 0 {
+			start = append(after, 0)
+		}
+		if query.Reverse && bytes.Compare(after, end) < 0 {
+			end = after
+		}
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return nil, nil
+	}
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if query.Reverse {
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var txs []servertypes.IndexedTx
+	for ; it.Valid() && (query.Limit <= 0 || len(txs) < query.Limit); it.Next() {
+		height, index, err := parseAddressTxKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, servertypes.IndexedTx{
+			Hash:       common.BytesToHash(it.Value()),
+			Height:     height,
+			EthTxIndex: index,
+		})
+	}
+	return txs, it.Error()
+}
+
+// AddressTxKey returns the key for db entry: `(prefix, address, block number, tx index) -> tx hash`
+func AddressTxKey(prefix byte, addr common.Address, blockNumber int64, txIndex int32) []byte {
+	key := make([]byte, 0, AddressTxKeyLength)
+	key = append(key, prefix)
+	key = append(key, addr.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+	return append(key, sdk.Uint64ToBigEndian(uint64(txIndex))...)    //nolint:gosec // G115 // index won't exceed uint64
+}
+
+// saveAddressIndex indexes the eth tx by its sender, its recipient and the
+// contract it created into the kv db batch.
+func saveAddressIndex(batch dbm.Batch, ethMsg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *servertypes.TxResult) error {
+	if len(ethMsg.From) == 0 {
+		return nil
+	}
+	from := ethMsg.GetSender()
+	if err := batch.Set(AddressTxKey(KeyPrefixSender, from, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set sender key")
+	}
+
+	tx := ethMsg.AsTransaction()
+	switch {
+	case tx.To() != nil:
+		if err := batch.Set(AddressTxKey(KeyPrefixRecipient, *tx.To(), txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set recipient key")
+		}
+	case !txResult.Failed:
+		contract := crypto.CreateAddress(from, tx.Nonce())
+		if err := batch.Set(AddressTxKey(KeyPrefixContract, contract, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set contract key")
+		}
+	}
+	return nil
+}
+
+func parseAddressTxKey(key []byte) (int64, int32, error) {
+	if len(key) != AddressTxKeyLength {
+		return 0, 0, fmt.Errorf("wrong address index key length, expect: %d, got: %d", AddressTxKeyLength, len(key))
+	}
+	height := sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8])
+	index := sdk.BigEndianToUint64(key[1+common.AddressLength+8:])
+	return int64(height), int32(index), nil //#nosec G115 -- the values were encoded from int64 and int32
+}
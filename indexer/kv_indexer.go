@@ -23,8 +23,16 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash    = 1
+	KeyPrefixTxIndex   = 2
+	KeyPrefixSender    = 3
+	KeyPrefixRecipient = 4
+	KeyPrefixContract  = 5
+	KeyPrefixLog       = 6
+	KeyPrefixLogAddr   = 7
+	KeyPrefixLogTopic  = 8
+	KeyPrefixLogRange  = 9
+	KeyPrefixBlockKeys = 10
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -37,11 +45,20 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	// logIndex enables the indexing of the eth logs
+	logIndex bool
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// WithLogIndex enables the indexing of the eth logs by address and topics for
+// the blocks indexed from now on.
+func (kv *KVIndexer) WithLogIndex() *KVIndexer {
+	kv.logIndex = true
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -49,11 +66,86 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+//
+// The entries of a previous indexing of the block are replaced.
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
-	height := block.Height
+	dbBatch := kv.db.NewBatch()
+	defer dbBatch.Close()
+	batch := &blockKeysBatch{Batch: dbBatch}
+
+	if err := kv.deleteBlockEntries(batch, block.Height, block.Height+1); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, delete entries", block.Height)
+	}
+	err := kv.indexBlock(batch, block, txResults, func(batch dbm.Batch, ethMsg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *servertypes.TxResult) error {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, txResult); err != nil {
+			return err
+		}
+		return saveAddressIndex(batch, ethMsg, txHash, txResult)
+	})
+	if err != nil {
+		return err
+	}
+	if kv.logIndex {
+		if err := kv.saveBlockLogs(batch, block.Height, txResults); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
+		}
+	}
+	if err := batch.saveBlockKeys(block.Height, nil); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set block keys", block.Height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
 
-	batch := kv.db.NewBatch()
-	defer batch.Close()
+// BackfillAddressIndex indexes the eth txs of an already indexed block by
+// their addresses, it's used to fill the address index of the blocks indexed
+// before it was introduced.
+func (kv *KVIndexer) BackfillAddressIndex(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	dbBatch := kv.db.NewBatch()
+	defer dbBatch.Close()
+	batch := &blockKeysBatch{Batch: dbBatch}
+
+	if err := kv.indexBlock(batch, block, txResults, saveAddressIndex); err != nil {
+		return err
+	}
+	blockKeys, err := kv.db.Get(BlockKeysKey(block.Height))
+	if err != nil {
+		return errorsmod.Wrapf(err, "BackfillAddressIndex %d", block.Height)
+	}
+	if err := batch.saveBlockKeys(block.Height, blockKeys); err != nil {
+		return errorsmod.Wrapf(err, "BackfillAddressIndex %d, set block keys", block.Height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "BackfillAddressIndex %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// indexBlock parses the eth txs of the block and saves them into the batch
+// with the save function.
+func (kv *KVIndexer) indexBlock(
+	batch dbm.Batch,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+	save func(dbm.Batch, *evmtypes.MsgEthereumTx, common.Hash, *servertypes.TxResult) error,
+) error {
+	return parseEthTxs(kv.clientCtx, kv.logger, block, txResults, func(ethMsg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *servertypes.TxResult) error {
+		return save(batch, ethMsg, txHash, txResult)
+	})
+}
+
+// parseEthTxs parses the eth txs of the block and passes them, along with
+// their results, to the save function.
+func parseEthTxs(
+	clientCtx client.Context,
+	logger log.Logger,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+	save func(*evmtypes.MsgEthereumTx, common.Hash, *servertypes.TxResult) error,
+) error {
+	height := block.Height
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
@@ -63,9 +155,9 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			continue
 		}
 
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		tx, err := clientCtx.TxConfig.TxDecoder()(tx)
 		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
@@ -75,7 +167,7 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 
 		txs, err := rpctypes.ParseTxResult(result, tx)
 		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
@@ -98,11 +190,11 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			} else {
 				parsedTx := txs.GetTxByMsgIndex(msgIndex)
 				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
 					continue
 				}
 				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
@@ -112,14 +204,11 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+			if err := save(ethMsg, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
-	}
 	return nil
 }
 
@@ -0,0 +1,257 @@
+package indexer
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// logPositionLength is the length of the (block number, log sequence) suffix
+// of the log index keys
+const logPositionLength = 8 + 8
+
+var (
+	_ servertypes.EVMLogIndexer = &KVIndexer{}
+
+	keyLogRangeFirst = []byte{KeyPrefixLogRange, 0}
+	keyLogRangeLast  = []byte{KeyPrefixLogRange, 1}
+)
+
+// LogIndexRange returns the range of the blocks with indexed logs, returns -1
+// if no logs are indexed.
+func (kv *KVIndexer) LogIndexRange() (int64, int64, error) {
+	first, err := loadLogRangeBound(kv.db, keyLogRangeFirst)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	last, err := loadLogRangeBound(kv.db, keyLogRangeLast)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	return first, last, nil
+}
+
+// GetLogs finds the logs matching the addresses and topics within the block
+// range. The candidate logs are found through the address index if addresses
+// are set, through the index of the first set topic otherwise, and are then
+// matched against the whole criteria.
+func (kv *KVIndexer) GetLogs(
+	fromBlock, toBlock int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	fromBlock = max(fromBlock, 0)
+	if toBlock < fromBlock {
+		return nil, nil
+	}
+
+	var prefixes [][]byte
+	switch {
+	case len(addresses) > 0:
+		for _, addr := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddr}, addr.Bytes()...))
+		}
+	default:
+		for i, sub := range topics {
+			if len(sub) == 0 {
+				continue
+			}
+			for _, topic := range sub {
+				prefixes = append(prefixes, append([]byte{KeyPrefixLogTopic, byte(i)}, topic.Bytes()...)) //#nosec G115 -- logs have at most 4 topics
+			}
+			break
+		}
+	}
+
+	// without criteria, all the logs of the range are matched
+	if len(prefixes) == 0 {
+		return kv.scanLogs(fromBlock, toBlock, limit)
+	}
+
+	var positions [][]byte
+	for _, prefix := range prefixes {
+		it, err := kv.db.Iterator(logPositionKey(prefix, fromBlock, 0), logPositionKey(prefix, toBlock+1, 0))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			positions = append(positions, bytes.Clone(key[len(key)-logPositionLength:]))
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+	}
+	slices.SortFunc(positions, bytes.Compare)
+	positions = slices.CompactFunc(positions, bytes.Equal)
+
+	var logs []*ethtypes.Log
+	for _, position := range positions {
+		bz, err := kv.db.Get(append([]byte{KeyPrefixLog}, position...))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		if len(bz) == 0 {
+			// left behind by a pruned or rewound block indexed before the
+			// block keys were saved
+			continue
+		}
+		log, err := kv.decodeLog(bz)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		if !matchLog(log, addresses, topics) {
+			continue
+		}
+		logs = append(logs, log)
+		if limit > 0 && len(logs) == limit {
+			break
+		}
+	}
+	return logs, nil
+}
+
+// scanLogs returns all the logs within the block range.
+func (kv *KVIndexer) scanLogs(fromBlock, toBlock int64, limit int) ([]*ethtypes.Log, error) {
+	it, err := kv.db.Iterator(LogKey(fromBlock, 0), LogKey(toBlock+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+	defer it.Close()
+
+	var logs []*ethtypes.Log
+	for ; it.Valid() && (limit <= 0 || len(logs) < limit); it.Next() {
+		log, err := kv.decodeLog(it.Value())
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		logs = append(logs, log)
+	}
+	return logs, it.Error()
+}
+
+// matchLog returns true if the log matches the addresses and the topics, with
+// the same semantics as the eth_getLogs filter criteria.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 && !slices.Contains(addresses, log.Address) {
+		return false
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) > 0 && !slices.Contains(sub, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (kv *KVIndexer) decodeLog(bz []byte) (*ethtypes.Log, error) {
+	var log evmtypes.Log
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+		return nil, err
+	}
+	ethLog := log.ToEthereum()
+	ethLog.BlockTimestamp = log.BlockTimestamp
+	return ethLog, nil
+}
+
+// saveBlockLogs indexes the eth logs of the block by address and topics into
+// the kv db batch and extends the range of the blocks with indexed logs.
+func (kv *KVIndexer) saveBlockLogs(batch dbm.Batch, height int64, txResults []*abci.ExecTxResult) error {
+	var seq uint64
+	for txIndex, txResult := range txResults {
+		logs, err := evmtypes.DecodeTxLogs(txResult.Data, uint64(height)) //nolint:gosec // G115 // block number won't exceed uint64
+		if err != nil {
+			kv.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		for _, log := range logs {
+			bz := kv.clientCtx.Codec.MustMarshal(evmtypes.NewLogFromEth(log))
+			if err := batch.Set(LogKey(height, seq), bz); err != nil {
+				return errorsmod.Wrap(err, "set log key")
+			}
+			if err := batch.Set(LogAddressKey(log.Address, height, seq), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log address key")
+			}
+			for i, topic := range log.Topics {
+				if err := batch.Set(LogTopicKey(i, topic, height, seq), []byte{}); err != nil {
+					return errorsmod.Wrap(err, "set log topic key")
+				}
+			}
+			seq++
+		}
+	}
+
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return err
+	}
+	switch {
+	case last != -1 && height == last+1:
+		last = height
+	case last != -1 && height == first-1:
+		first = height
+	case last != -1 && height >= first && height <= last:
+	default:
+		// start a new range if the block is the first one or is not contiguous
+		// to the indexed ones, the logs of the missing blocks are not indexed.
+		first, last = height, height
+	}
+	if err := batch.Set(keyLogRangeFirst, sdk.Uint64ToBigEndian(uint64(first))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+		return errorsmod.Wrap(err, "set log range key")
+	}
+	if err := batch.Set(keyLogRangeLast, sdk.Uint64ToBigEndian(uint64(last))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+		return errorsmod.Wrap(err, "set log range key")
+	}
+	return nil
+}
+
+// LogKey returns the key for db entry: `(block number, log sequence) -> log`
+func LogKey(blockNumber int64, seq uint64) []byte {
+	return logPositionKey([]byte{KeyPrefixLog}, blockNumber, seq)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log sequence) -> nil`
+func LogAddressKey(addr common.Address, blockNumber int64, seq uint64) []byte {
+	return logPositionKey(append([]byte{KeyPrefixLogAddr}, addr.Bytes()...), blockNumber, seq)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log sequence) -> nil`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, seq uint64) []byte {
+	return logPositionKey(append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...), blockNumber, seq) //#nosec G115 -- logs have at most 4 topics
+}
+
+func logPositionKey(prefix []byte, blockNumber int64, seq uint64) []byte {
+	key := make([]byte, 0, len(prefix)+logPositionLength)
+	key = append(key, prefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+	return append(key, sdk.Uint64ToBigEndian(seq)...)
+}
+
+func loadLogRangeBound(db dbm.DB, key []byte) (int64, error) {
+	bz, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil //#nosec G115 -- the value was encoded from int64
+}
@@ -0,0 +1,66 @@
+-- The schema is shared by SQLite and Postgres: the hashes, the addresses and
+-- the binary data are stored as 0x-prefixed lowercase hex strings, and the
+-- 256-bit amounts as decimal strings.
+
+CREATE TABLE blocks (
+    height       BIGINT  NOT NULL PRIMARY KEY,
+    hash         TEXT    NOT NULL,
+    time         BIGINT  NOT NULL,
+    eth_tx_count INTEGER NOT NULL
+);
+
+CREATE TABLE transactions (
+    hash             TEXT    NOT NULL PRIMARY KEY,
+    height           BIGINT  NOT NULL,
+    tx_index         INTEGER NOT NULL,
+    msg_index        INTEGER NOT NULL,
+    eth_tx_index     INTEGER NOT NULL,
+    type             INTEGER NOT NULL,
+    sender           TEXT,
+    recipient        TEXT,
+    contract_address TEXT,
+    nonce            BIGINT  NOT NULL,
+    value            TEXT    NOT NULL,
+    gas_limit        BIGINT  NOT NULL,
+    gas_price        TEXT    NOT NULL,
+    input            TEXT    NOT NULL
+);
+
+CREATE UNIQUE INDEX transactions_position ON transactions (height, eth_tx_index);
+CREATE INDEX transactions_sender ON transactions (sender, height, eth_tx_index);
+CREATE INDEX transactions_recipient ON transactions (recipient, height, eth_tx_index);
+CREATE INDEX transactions_contract_address ON transactions (contract_address);
+
+CREATE TABLE receipts (
+    tx_hash             TEXT    NOT NULL PRIMARY KEY,
+    height              BIGINT  NOT NULL,
+    failed              BOOLEAN NOT NULL,
+    gas_used            BIGINT  NOT NULL,
+    cumulative_gas_used BIGINT  NOT NULL
+);
+
+CREATE INDEX receipts_height ON receipts (height);
+
+CREATE TABLE logs (
+    height          BIGINT  NOT NULL,
+    seq             INTEGER NOT NULL,
+    log_index       INTEGER NOT NULL,
+    block_hash      TEXT    NOT NULL,
+    block_timestamp BIGINT  NOT NULL,
+    tx_hash         TEXT    NOT NULL,
+    tx_index        INTEGER NOT NULL,
+    address         TEXT    NOT NULL,
+    topic0          TEXT,
+    topic1          TEXT,
+    topic2          TEXT,
+    topic3          TEXT,
+    data            TEXT    NOT NULL,
+    PRIMARY KEY (height, seq)
+);
+
+CREATE INDEX logs_address ON logs (address, height, seq);
+CREATE INDEX logs_topic0 ON logs (topic0, height, seq);
+CREATE INDEX logs_topic1 ON logs (topic1, height, seq);
+CREATE INDEX logs_topic2 ON logs (topic2, height, seq);
+CREATE INDEX logs_topic3 ON logs (topic3, height, seq);
+CREATE INDEX logs_tx_hash ON logs (tx_hash);
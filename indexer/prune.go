@@ -0,0 +1,229 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Rewind removes the indexed entries of the blocks above the height.
+func (kv *KVIndexer) Rewind(height int64) error {
+	if err := kv.deleteBlocks(max(height+1, 0), math.MaxInt64); err != nil {
+		return errorsmod.Wrapf(err, "Rewind %d", height)
+	}
+	return nil
+}
+
+// Prune removes the indexed entries of the blocks below the retain height.
+func (kv *KVIndexer) Prune(retainHeight int64) error {
+	if retainHeight <= 0 {
+		return nil
+	}
+	if err := kv.deleteBlocks(0, retainHeight); err != nil {
+		return errorsmod.Wrapf(err, "Prune %d", retainHeight)
+	}
+	return nil
+}
+
+// DeleteBlock removes the indexed entries of the block along with the entries
+// of the eth txs by hash, and the address and log index entries of the block
+// missing from its block keys, which the reindexing of the block doesn't
+// replace. The address and log indexes are scanned entirely, so it's only
+// meant to repair the mismatched blocks.
+func (kv *KVIndexer) DeleteBlock(height int64, txHashes []common.Hash) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := kv.deleteBlockEntries(batch, height, height+1); err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlock %d", height)
+	}
+	for _, txHash := range txHashes {
+		if err := batch.Delete(TxHashKey(txHash)); err != nil {
+			return errorsmod.Wrapf(err, "DeleteBlock %d", height)
+		}
+	}
+	for _, prefix := range []byte{KeyPrefixSender, KeyPrefixRecipient, KeyPrefixContract, KeyPrefixLogAddr, KeyPrefixLogTopic} {
+		if err := kv.deleteIndexEntries(batch, prefix, height); err != nil {
+			return errorsmod.Wrapf(err, "DeleteBlock %d", height)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// deleteIndexEntries deletes the entries of the index at the height into the
+// batch, the keys of the address and log indexes all end with the block number
+// followed by the position within the block.
+func (kv *KVIndexer) deleteIndexEntries(batch dbm.Batch, prefix byte, height int64) error {
+	it, err := kv.db.Iterator([]byte{prefix}, []byte{prefix + 1})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	heightBz := sdk.Uint64ToBigEndian(uint64(height)) //nolint:gosec // G115 // block number won't exceed uint64
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) < 1+logPositionLength || !bytes.Equal(key[len(key)-logPositionLength:len(key)-8], heightBz) {
+			continue
+		}
+		if err := batch.Delete(bytes.Clone(key)); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// deleteBlocks removes the indexed entries of the blocks within [from, to) and
+// shrinks the range of the blocks with indexed logs accordingly.
+func (kv *KVIndexer) deleteBlocks(from, to int64) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := kv.deleteBlockEntries(batch, from, to); err != nil {
+		return err
+	}
+
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return err
+	}
+	if last != -1 {
+		if first >= from && first < to {
+			first = to
+		}
+		if last >= from && last < to {
+			last = from - 1
+		}
+		if first > last {
+			if err := batch.Delete(keyLogRangeFirst); err != nil {
+				return err
+			}
+			if err := batch.Delete(keyLogRangeLast); err != nil {
+				return err
+			}
+		} else {
+			if err := batch.Set(keyLogRangeFirst, sdk.Uint64ToBigEndian(uint64(first))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+				return err
+			}
+			if err := batch.Set(keyLogRangeLast, sdk.Uint64ToBigEndian(uint64(last))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+				return err
+			}
+		}
+	}
+	return batch.Write()
+}
+
+// deleteBlockEntries deletes the entries of the blocks within [from, to) into
+// the batch: the tx entries are found through the tx index, the logs by their
+// position and the address and log index entries through the block keys.
+func (kv *KVIndexer) deleteBlockEntries(batch dbm.Batch, from, to int64) error {
+	it, err := kv.db.Iterator(TxIndexKey(from, 0), TxIndexKey(to, 0))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(append([]byte{KeyPrefixTxHash}, it.Value()...)); err != nil {
+			return err
+		}
+		if err := batch.Delete(bytes.Clone(it.Key())); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	blockIt, err := kv.db.Iterator(BlockKeysKey(from), BlockKeysKey(to))
+	if err != nil {
+		return err
+	}
+	defer blockIt.Close()
+	for ; blockIt.Valid(); blockIt.Next() {
+		keys, err := decodeBlockKeys(blockIt.Value())
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+		if err := batch.Delete(bytes.Clone(blockIt.Key())); err != nil {
+			return err
+		}
+	}
+	if err := blockIt.Error(); err != nil {
+		return err
+	}
+
+	logIt, err := kv.db.Iterator(LogKey(from, 0), LogKey(to, 0))
+	if err != nil {
+		return err
+	}
+	defer logIt.Close()
+	for ; logIt.Valid(); logIt.Next() {
+		if err := batch.Delete(bytes.Clone(logIt.Key())); err != nil {
+			return err
+		}
+	}
+	return logIt.Error()
+}
+
+// BlockKeysKey returns the key for db entry: `block number -> address and log index keys`
+func BlockKeysKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockKeys}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// blockKeysBatch records the keys of the address and log indexes set into the
+// batch, which can't be found from the block number, so they are saved along
+// with the block to be deleted with it.
+type blockKeysBatch struct {
+	dbm.Batch
+	keys [][]byte
+}
+
+func (b *blockKeysBatch) Set(key, value []byte) error {
+	switch key[0] {
+	case KeyPrefixSender, KeyPrefixRecipient, KeyPrefixContract, KeyPrefixLogAddr, KeyPrefixLogTopic:
+		b.keys = append(b.keys, key)
+	}
+	return b.Batch.Set(key, value)
+}
+
+// saveBlockKeys saves the recorded keys of the block, appended to the
+// previously saved ones.
+func (b *blockKeysBatch) saveBlockKeys(height int64, bz []byte) error {
+	if len(b.keys) == 0 {
+		return nil
+	}
+	for _, key := range b.keys {
+		bz = append(bz, byte(len(key))) //#nosec G115 -- the index keys are shorter than 256 bytes
+		bz = append(bz, key...)
+	}
+	return b.Batch.Set(BlockKeysKey(height), bz)
+}
+
+func decodeBlockKeys(bz []byte) ([][]byte, error) {
+	var keys [][]byte
+	for len(bz) > 0 {
+		n := int(bz[0])
+		if len(bz) < 1+n {
+			return nil, fmt.Errorf("invalid block keys entry")
+		}
+		keys = append(keys, bz[1:1+n])
+		bz = bz[1+n:]
+	}
+	return keys, nil
+}
@@ -0,0 +1,502 @@
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// maxLogTopics is the max number of topics of an eth log
+const maxLogTopics = 4
+
+// sqlBlockTables are the tables holding the rows of the indexed blocks
+var sqlBlockTables = []string{"blocks", "transactions", "receipts", "logs"}
+
+var (
+	_ servertypes.EVMTxIndexer  = &SQLIndexer{}
+	_ servertypes.EVMLogIndexer = &SQLIndexer{}
+)
+
+// SQLIndexer implements a eth tx indexer on a SQL database, it indexes the
+// blocks, the eth txs, their receipts and their logs into relational tables
+// which can also be queried by external tools. The queries are compatible with
+// both SQLite and Postgres.
+type SQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewSQLIndexer creates the SQLIndexer and applies the pending migrations of
+// the schema to the db.
+func NewSQLIndexer(db *sql.DB, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if err := MigrateSQL(db); err != nil {
+		return nil, err
+	}
+	return &SQLIndexer{db: db, logger: logger, clientCtx: clientCtx}, nil
+}
+
+// IndexBlock indexes the block along with its eth txs, their receipts and
+// their logs, the rows of a previous indexing of the block are replaced.
+func (si *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	tx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op once committed
+
+	for _, table := range sqlBlockTables {
+		if _, err := tx.Exec(`DELETE FROM `+table+` WHERE height = $1`, block.Height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete %s", block.Height, table)
+		}
+	}
+
+	var ethTxCount int
+	err = parseEthTxs(si.clientCtx, si.logger, block, txResults, func(ethMsg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *servertypes.TxResult) error {
+		ethTxCount++
+		return insertSQLTx(tx, ethMsg, txHash, txResult)
+	})
+	if err != nil {
+		return err
+	}
+	if err := si.insertBlockLogs(tx, block, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
+	}
+
+	_, err = tx.Exec(
+		`INSERT INTO blocks (height, hash, time, eth_tx_count) VALUES ($1, $2, $3, $4)`,
+		block.Height, hexutil.Encode(block.Hash()), block.Time.Unix(), ethTxCount,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", block.Height)
+	}
+	if err := tx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", block.Height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.loadBlockBound("MAX")
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.loadBlockBound("MIN")
+}
+
+// Rewind removes the indexed entries of the blocks above the height.
+func (si *SQLIndexer) Rewind(height int64) error {
+	if err := si.deleteBlocks(`height > $1`, height); err != nil {
+		return errorsmod.Wrapf(err, "Rewind %d", height)
+	}
+	return nil
+}
+
+// Prune removes the indexed entries of the blocks below the retain height.
+func (si *SQLIndexer) Prune(retainHeight int64) error {
+	if err := si.deleteBlocks(`height < $1`, retainHeight); err != nil {
+		return errorsmod.Wrapf(err, "Prune %d", retainHeight)
+	}
+	return nil
+}
+
+// DeleteBlock removes the rows of the block along with the rows of the eth txs,
+// which might be indexed at another height.
+func (si *SQLIndexer) DeleteBlock(height int64, txHashes []common.Hash) error {
+	tx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlock %d", height)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op once committed
+
+	for _, table := range sqlBlockTables {
+		if _, err := tx.Exec(`DELETE FROM `+table+` WHERE height = $1`, height); err != nil {
+			return errorsmod.Wrapf(err, "DeleteBlock %d, delete %s", height, table)
+		}
+	}
+	for _, txHash := range txHashes {
+		for _, stmt := range []string{
+			`DELETE FROM transactions WHERE hash = $1`,
+			`DELETE FROM receipts WHERE tx_hash = $1`,
+			`DELETE FROM logs WHERE tx_hash = $1`,
+		} {
+			if _, err := tx.Exec(stmt, txHash.Hex()); err != nil {
+				return errorsmod.Wrapf(err, "DeleteBlock %d, delete eth tx %s", height, txHash.Hex())
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlock %d, commit", height)
+	}
+	return nil
+}
+
+// deleteBlocks deletes the rows of the blocks matching the height condition.
+func (si *SQLIndexer) deleteBlocks(cond string, height int64) error {
+	tx, err := si.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op once committed
+
+	for _, table := range sqlBlockTables {
+		if _, err := tx.Exec(`DELETE FROM `+table+` WHERE `+cond, height); err != nil {
+			return errorsmod.Wrapf(err, "delete %s", table)
+		}
+	}
+	return tx.Commit()
+}
+
+func (si *SQLIndexer) loadBlockBound(aggregate string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(`SELECT ` + aggregate + `(height) FROM blocks`).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "load indexed block")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error) {
+	res, err := si.getTxResult(`t.hash = $1`, hash.Hex())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	if res == nil {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*servertypes.TxResult, error) {
+	res, err := si.getTxResult(`t.height = $1 AND t.eth_tx_index = $2`, blockNumber, txIndex)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	if res == nil {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+// getTxResult returns the result of the eth tx matching the condition, nil if
+// not found.
+func (si *SQLIndexer) getTxResult(cond string, args ...any) (*servertypes.TxResult, error) {
+	row := si.db.QueryRow(`
+		SELECT t.height, t.tx_index, t.msg_index, t.eth_tx_index, r.failed, r.gas_used, r.cumulative_gas_used
+		FROM transactions t JOIN receipts r ON r.tx_hash = t.hash
+		WHERE `+cond, args...)
+
+	var (
+		res                        servertypes.TxResult
+		gasUsed, cumulativeGasUsed int64
+	)
+	err := row.Scan(&res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &res.Failed, &gasUsed, &cumulativeGasUsed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.GasUsed = uint64(gasUsed)                     //#nosec G115 -- the value was encoded from uint64
+	res.CumulativeGasUsed = uint64(cumulativeGasUsed) //#nosec G115 -- the value was encoded from uint64
+	return &res, nil
+}
+
+// GetBySender returns a page of the eth txs sent by the address.
+func (si *SQLIndexer) GetBySender(addr common.Address, query servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	txs, err := si.getByAddress("sender", addr, query)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBySender %s", addr.Hex())
+	}
+	return txs, nil
+}
+
+// GetByRecipient returns a page of the eth txs sent to the address.
+func (si *SQLIndexer) GetByRecipient(addr common.Address, query servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	txs, err := si.getByAddress("recipient", addr, query)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByRecipient %s", addr.Hex())
+	}
+	return txs, nil
+}
+
+// GetContractCreation finds the eth tx that created the contract, returns nil
+// if the contract was not created by a top-level eth tx.
+func (si *SQLIndexer) GetContractCreation(addr common.Address) (*servertypes.IndexedTx, error) {
+	txs, err := si.getByAddress("contract_address", addr, servertypes.AddressTxsQuery{ToBlock: math.MaxInt64, Limit: 1})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreation %s", addr.Hex())
+	}
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	return &txs[0], nil
+}
+
+// getByAddress queries the page of the eth txs whose address column matches
+// the address.
+func (si *SQLIndexer) getByAddress(column string, addr common.Address, query servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	if query.ToBlock < 0 || query.ToBlock < query.FromBlock {
+		return nil, nil
+	}
+
+	stmt := `SELECT hash, height, eth_tx_index FROM transactions WHERE ` + column + ` = $1 AND height >= $2 AND height <= $3`
+	args := []any{encodeSQLAddress(addr), max(query.FromBlock, 0), query.ToBlock}
+	order := "ASC"
+	if query.Reverse {
+		order = "DESC"
+	}
+	if query.After != nil {
+		cmp := ">"
+		if query.Reverse {
+			cmp = "<"
+		}
+		stmt += fmt.Sprintf(` AND (height %s $4 OR (height = $4 AND eth_tx_index %s $5))`, cmp, cmp)
+		args = append(args, query.After.Height, query.After.EthTxIndex)
+	}
+	stmt += fmt.Sprintf(` ORDER BY height %s, eth_tx_index %s`, order, order)
+	if query.Limit > 0 {
+		stmt += fmt.Sprintf(` LIMIT %d`, query.Limit)
+	}
+
+	rows, err := si.db.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var txs []servertypes.IndexedTx
+	for rows.Next() {
+		var (
+			hash string
+			tx   servertypes.IndexedTx
+		)
+		if err := rows.Scan(&hash, &tx.Height, &tx.EthTxIndex); err != nil {
+			return nil, err
+		}
+		tx.Hash = common.HexToHash(hash)
+		txs = append(txs, tx)
+	}
+	return txs, rows.Err()
+}
+
+// LogIndexRange returns the range of the indexed blocks, the logs are always
+// indexed along with the blocks. Returns -1 if the db is empty.
+func (si *SQLIndexer) LogIndexRange() (int64, int64, error) {
+	first, err := si.FirstIndexedBlock()
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	last, err := si.LastIndexedBlock()
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	return first, last, nil
+}
+
+// GetLogs finds the logs matching the addresses and topics within the block
+// range, with the same semantics as the eth_getLogs filter criteria.
+func (si *SQLIndexer) GetLogs(
+	fromBlock, toBlock int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	fromBlock = max(fromBlock, 0)
+	if toBlock < fromBlock || len(topics) > maxLogTopics {
+		return nil, nil
+	}
+
+	stmt := `SELECT height, log_index, block_hash, block_timestamp, tx_hash, tx_index, address, topic0, topic1, topic2, topic3, data
+		FROM logs WHERE height >= $1 AND height <= $2`
+	args := []any{fromBlock, toBlock}
+	// in appends the condition of the column matching one of the values
+	in := func(column string, values []string) {
+		placeholders := make([]string, len(values))
+		for i, value := range values {
+			args = append(args, value)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		stmt += fmt.Sprintf(` AND %s IN (%s)`, column, strings.Join(placeholders, ", "))
+	}
+
+	if len(addresses) > 0 {
+		values := make([]string, len(addresses))
+		for i, addr := range addresses {
+			values[i] = encodeSQLAddress(addr)
+		}
+		in("address", values)
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		values := make([]string, len(sub))
+		for j, topic := range sub {
+			values[j] = topic.Hex()
+		}
+		in(fmt.Sprintf("topic%d", i), values)
+	}
+	if len(topics) > 0 {
+		// the logs must have at least as many topics as the criteria
+		stmt += fmt.Sprintf(` AND topic%d IS NOT NULL`, len(topics)-1)
+	}
+	stmt += ` ORDER BY height, seq`
+	if limit > 0 {
+		stmt += fmt.Sprintf(` LIMIT %d`, limit)
+	}
+
+	rows, err := si.db.Query(stmt, args...)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+	defer rows.Close()
+
+	var logs []*ethtypes.Log
+	for rows.Next() {
+		log, err := scanSQLLog(rows)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		logs = append(logs, log)
+	}
+	return logs, rows.Err()
+}
+
+// insertBlockLogs inserts the eth logs of the block.
+func (si *SQLIndexer) insertBlockLogs(tx *sql.Tx, block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	var seq int
+	for txIndex, txResult := range txResults {
+		logs, err := evmtypes.DecodeTxLogs(txResult.Data, uint64(block.Height)) //nolint:gosec // G115 // block number won't exceed uint64
+		if err != nil {
+			si.logger.Error("Fail to decode tx logs", "err", err, "block", block.Height, "txIndex", txIndex)
+			continue
+		}
+		for _, log := range logs {
+			var topics [maxLogTopics]sql.NullString
+			for i, topic := range log.Topics {
+				if i < maxLogTopics {
+					topics[i] = sql.NullString{String: topic.Hex(), Valid: true}
+				}
+			}
+			_, err := tx.Exec(`
+				INSERT INTO logs (height, seq, log_index, block_hash, block_timestamp, tx_hash, tx_index, address, topic0, topic1, topic2, topic3, data)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+				block.Height, seq, int64(log.Index), log.BlockHash.Hex(), int64(log.BlockTimestamp), //#nosec G115 -- int overflow is not a concern here
+				log.TxHash.Hex(), int64(log.TxIndex), encodeSQLAddress(log.Address), //#nosec G115 -- int overflow is not a concern here
+				topics[0], topics[1], topics[2], topics[3], hexutil.Encode(log.Data),
+			)
+			if err != nil {
+				return errorsmod.Wrap(err, "insert log")
+			}
+			seq++
+		}
+	}
+	return nil
+}
+
+// insertSQLTx inserts the eth tx and its receipt.
+func insertSQLTx(tx *sql.Tx, ethMsg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *servertypes.TxResult) error {
+	ethTx := ethMsg.AsTransaction()
+
+	var sender, recipient, contract sql.NullString
+	if len(ethMsg.From) > 0 {
+		from := ethMsg.GetSender()
+		sender = sql.NullString{String: encodeSQLAddress(from), Valid: true}
+		if ethTx.To() == nil && !txResult.Failed {
+			contract = sql.NullString{String: encodeSQLAddress(crypto.CreateAddress(from, ethTx.Nonce())), Valid: true}
+		}
+	}
+	if ethTx.To() != nil {
+		recipient = sql.NullString{String: encodeSQLAddress(*ethTx.To()), Valid: true}
+	}
+
+	_, err := tx.Exec(`
+		INSERT INTO transactions (hash, height, tx_index, msg_index, eth_tx_index, type, sender, recipient, contract_address, nonce, value, gas_limit, gas_price, input)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		txHash.Hex(), txResult.Height, txResult.TxIndex, txResult.MsgIndex, txResult.EthTxIndex, int(ethTx.Type()),
+		sender, recipient, contract, int64(ethTx.Nonce()), ethTx.Value().String(), //#nosec G115 -- int overflow is not a concern here
+		int64(ethTx.Gas()), ethTx.GasPrice().String(), hexutil.Encode(ethTx.Data()), //#nosec G115 -- int overflow is not a concern here
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "insert transaction")
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO receipts (tx_hash, height, failed, gas_used, cumulative_gas_used)
+		VALUES ($1, $2, $3, $4, $5)`,
+		txHash.Hex(), txResult.Height, txResult.Failed,
+		int64(txResult.GasUsed), int64(txResult.CumulativeGasUsed), //#nosec G115 -- int overflow is not a concern here
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "insert receipt")
+	}
+	return nil
+}
+
+func scanSQLLog(rows *sql.Rows) (*ethtypes.Log, error) {
+	var (
+		height, blockTimestamp     int64
+		logIndex, txIndex          int64
+		blockHash, txHash, address string
+		data                       string
+		topics                     [maxLogTopics]sql.NullString
+	)
+	err := rows.Scan(&height, &logIndex, &blockHash, &blockTimestamp, &txHash, &txIndex, &address,
+		&topics[0], &topics[1], &topics[2], &topics[3], &data)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := hexutil.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	log := &ethtypes.Log{
+		Address:        common.HexToAddress(address),
+		Data:           bz,
+		BlockNumber:    uint64(height), //#nosec G115 -- the value was encoded from uint64
+		TxHash:         common.HexToHash(txHash),
+		TxIndex:        uint(txIndex), //#nosec G115 -- the value was encoded from uint
+		BlockHash:      common.HexToHash(blockHash),
+		BlockTimestamp: uint64(blockTimestamp), //#nosec G115 -- the value was encoded from uint64
+		Index:          uint(logIndex),         //#nosec G115 -- the value was encoded from uint
+	}
+	for _, topic := range topics {
+		if !topic.Valid {
+			break
+		}
+		log.Topics = append(log.Topics, common.HexToHash(topic.String))
+	}
+	return log, nil
+}
+
+// encodeSQLAddress encodes the address as a lowercase hex string, so the
+// addresses can be compared as strings.
+func encodeSQLAddress(addr common.Address) string {
+	return hexutil.Encode(addr.Bytes())
+}
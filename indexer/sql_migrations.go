@@ -0,0 +1,73 @@
+package indexer
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// sqlMigrations are the migrations of the SQL indexer schema, they are applied
+// in the order of the version prefixing their file names.
+//
+//go:embed migrations/*.sql
+var sqlMigrations embed.FS
+
+// MigrateSQL applies the migrations of the SQL indexer schema that were not
+// applied yet to the db.
+func MigrateSQL(db *sql.DB) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER NOT NULL PRIMARY KEY)`); err != nil {
+		return errorsmod.Wrap(err, "create schema migrations table")
+	}
+
+	// the entries are sorted by file name
+	entries, err := fs.ReadDir(sqlMigrations, "migrations")
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return fmt.Errorf("invalid migration file name %s", name)
+		}
+
+		var applied int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM schema_migrations WHERE version = $1`, version).Scan(&applied); err != nil {
+			return errorsmod.Wrapf(err, "migration %s", name)
+		}
+		if applied > 0 {
+			continue
+		}
+
+		script, err := sqlMigrations.ReadFile("migrations/" + name)
+		if err != nil {
+			return err
+		}
+		if err := applySQLMigration(db, version, string(script)); err != nil {
+			return errorsmod.Wrapf(err, "migration %s", name)
+		}
+	}
+	return nil
+}
+
+func applySQLMigration(db *sql.DB, version int, script string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op once committed
+
+	if _, err := tx.Exec(script); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES ($1)`, version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,141 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// VerifyBlock cross-checks the entries indexed for the block against the ones
+// parsed from its results, and returns the description of the mismatches. The
+// address index is checked by the senders of the eth txs, and the logs only if
+// the block is within the range of the log index.
+func VerifyBlock(
+	clientCtx client.Context,
+	logger log.Logger,
+	idxer servertypes.EVMTxIndexer,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+) ([]string, error) {
+	var (
+		mismatches []string
+		ethTxCount int32
+		senders    []common.Address
+		sentTxs    = make(map[common.Address][]servertypes.IndexedTx)
+	)
+	err := parseEthTxs(clientCtx, logger, block, txResults, func(ethMsg *evmtypes.MsgEthereumTx, txHash common.Hash, expected *servertypes.TxResult) error {
+		ethTxCount++
+		if len(ethMsg.From) > 0 {
+			from := ethMsg.GetSender()
+			if _, ok := sentTxs[from]; !ok {
+				senders = append(senders, from)
+			}
+			sentTxs[from] = append(sentTxs[from], servertypes.IndexedTx{Hash: txHash, Height: block.Height, EthTxIndex: expected.EthTxIndex})
+		}
+		if res, err := idxer.GetByBlockAndIndex(block.Height, expected.EthTxIndex); err != nil {
+			mismatches = append(mismatches, fmt.Sprintf("eth tx %d not indexed by position", expected.EthTxIndex))
+		} else if *res != *expected {
+			mismatches = append(mismatches, fmt.Sprintf("eth tx %d indexed as %v, expected %v", expected.EthTxIndex, res, expected))
+		}
+		if res, err := idxer.GetByTxHash(txHash); err != nil {
+			mismatches = append(mismatches, fmt.Sprintf("eth tx %s not indexed by hash", txHash.Hex()))
+		} else if *res != *expected {
+			mismatches = append(mismatches, fmt.Sprintf("eth tx %s indexed as %v, expected %v", txHash.Hex(), res, expected))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := idxer.GetByBlockAndIndex(block.Height, ethTxCount); err == nil {
+		mismatches = append(mismatches, fmt.Sprintf("unexpected eth tx %d indexed", ethTxCount))
+	}
+	for _, sender := range senders {
+		txs, err := idxer.GetBySender(sender, servertypes.AddressTxsQuery{FromBlock: block.Height, ToBlock: block.Height})
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Equal(txs, sentTxs[sender]) {
+			mismatches = append(mismatches, fmt.Sprintf("eth txs of sender %s indexed as %v, expected %v", sender.Hex(), txs, sentTxs[sender]))
+		}
+	}
+
+	logIdxer, ok := idxer.(servertypes.EVMLogIndexer)
+	if !ok {
+		return mismatches, nil
+	}
+	first, last, err := logIdxer.LogIndexRange()
+	if err != nil {
+		return nil, err
+	}
+	if first == -1 || block.Height < first || block.Height > last {
+		return mismatches, nil
+	}
+	var expLogs []*ethtypes.Log
+	for _, txResult := range txResults {
+		logs, err := evmtypes.DecodeTxLogs(txResult.Data, uint64(block.Height)) //nolint:gosec // G115 // block number won't exceed uint64
+		if err != nil {
+			// not indexed either
+			continue
+		}
+		expLogs = append(expLogs, logs...)
+	}
+	logs, err := logIdxer.GetLogs(block.Height, block.Height, nil, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) != len(expLogs) {
+		return append(mismatches, fmt.Sprintf("%d logs indexed, expected %d", len(logs), len(expLogs))), nil
+	}
+	for i, log := range logs {
+		if !sameLog(log, expLogs[i]) {
+			mismatches = append(mismatches, fmt.Sprintf("log %d of eth tx %s doesn't match", expLogs[i].Index, expLogs[i].TxHash.Hex()))
+		}
+	}
+	return mismatches, nil
+}
+
+// RepairBlock deletes the indexed entries of the block, including the stale
+// ones a reindexing doesn't replace, then reindexes the block.
+func RepairBlock(
+	clientCtx client.Context,
+	logger log.Logger,
+	idxer servertypes.EVMTxIndexer,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+) error {
+	var txHashes []common.Hash
+	err := parseEthTxs(clientCtx, logger, block, txResults, func(_ *evmtypes.MsgEthereumTx, txHash common.Hash, _ *servertypes.TxResult) error {
+		txHashes = append(txHashes, txHash)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := idxer.DeleteBlock(block.Height, txHashes); err != nil {
+		return err
+	}
+	return idxer.IndexBlock(block, txResults)
+}
+
+// sameLog compares the indexed content of the logs.
+func sameLog(a, b *ethtypes.Log) bool {
+	return a.TxHash == b.TxHash &&
+		a.Index == b.Index &&
+		a.Address == b.Address &&
+		slices.Equal(a.Topics, b.Topics) &&
+		bytes.Equal(a.Data, b.Data)
+}
@@ -25,6 +25,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -63,6 +64,7 @@ type EvmApp interface { //nolint:revive
 	TestApp
 	AccountKeeperProvider
 	AnteHandlerProvider
+	AuthzKeeperProvider
 	CallbackKeeperProvider
 	ConsensusParamsKeeperProvider
 	DistrKeeperProvider
@@ -91,6 +93,9 @@ type (
 	AnteHandlerProvider interface {
 		GetAnteHandler() sdk.AnteHandler
 	}
+	AuthzKeeperProvider interface {
+		GetAuthzKeeper() authzkeeper.Keeper
+	}
 	BankKeeperProvider interface {
 		GetBankKeeper() bankkeeper.Keeper
 	}
@@ -171,6 +176,12 @@ type (
 	// Precompile-focused application interfaces describe the exact keepers that a
 	// given precompile test suite requires. External chains can implement only the
 	// interfaces relevant to the suites they wish to run.
+	AuthzPrecompileApp interface {
+		TestApp
+		AuthzKeeperProvider
+		DistrKeeperProvider
+		StakingKeeperProvider
+	}
 	BankPrecompileApp interface {
 		TestApp
 		BankKeeperProvider
@@ -192,6 +203,10 @@ type (
 		PreciseBankKeeperProvider
 		TransferKeeperProvider
 	}
+	FeegrantPrecompileApp interface {
+		TestApp
+		FeeGrantKeeperProvider
+	}
 	GovPrecompileApp interface {
 		TestApp
 		GovKeeperProvider
@@ -0,0 +1,284 @@
+package mempool
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Reasons for an EVM transaction to be queued
+const (
+	QueuedReasonNonceGap            = "nonce gap"
+	QueuedReasonInsufficientBalance = "insufficient balance"
+	QueuedReasonUnderpriced         = "underpriced"
+)
+
+// EVMTxInfo describes an EVM transaction of the pool.
+type EVMTxInfo struct {
+	Tx      *ethtypes.Transaction
+	From    common.Address
+	Pending bool
+	Private bool
+	// QueuedReason is why a queued transaction is not executable, empty for the
+	// pending ones or if the transaction is about to be promoted
+	QueuedReason string
+	// EffectiveTip is the tip paid against the current base fee, negative if
+	// the fee cap is below the base fee
+	EffectiveTip *big.Int
+}
+
+// CosmosTxInfo describes a Cosmos transaction of the pool.
+type CosmosTxInfo struct {
+	Tx       sdk.Tx
+	Hash     []byte // CometBFT hash of the encoded transaction
+	Signer   sdk.AccAddress
+	Sequence uint64
+	Gas      uint64
+	// GasPrice is the gas price in the EVM denom, zero if the fee is not paid
+	// in the EVM denom
+	GasPrice *big.Int
+	// EffectiveTip is the gas price minus the current base fee, negative if the
+	// gas price is below the base fee
+	EffectiveTip *big.Int
+	// Time is when the transaction entered the pool, zero if unknown
+	Time time.Time
+}
+
+// InspectEVMTxs returns the EVM transactions of the pool ordered by sender and
+// nonce, along with why the queued ones are not executable and the tip paid
+// against the current base fee.
+func (m *ExperimentalEVMMempool) InspectEVMTxs() ([]EVMTxInfo, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, err
+	}
+	baseFee := m.vmKeeper.GetBaseFee(ctx)
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+	stateDB, err := m.blockchain.StateAt(m.blockchain.CurrentBlock().Root)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, queued := m.txPool.Content()
+	senders := make([]common.Address, 0, len(pending)+len(queued))
+	for addr := range pending {
+		senders = append(senders, addr)
+	}
+	for addr := range queued {
+		if _, ok := pending[addr]; !ok {
+			senders = append(senders, addr)
+		}
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i].Bytes(), senders[j].Bytes()) < 0
+	})
+
+	var infos []EVMTxInfo
+	for _, addr := range senders {
+		// the queued transactions are executable once the pending ones are
+		// included, if the balance left covers them
+		var nonce uint64
+		balance := new(big.Int)
+		if stateDB != nil {
+			nonce = stateDB.GetNonce(addr)
+			balance = stateDB.GetBalance(addr).ToBig()
+		}
+		for _, tx := range pending[addr] {
+			infos = append(infos, m.evmTxInfo(addr, tx, true, "", baseFee))
+			nonce = tx.Nonce() + 1
+			balance.Sub(balance, tx.Cost())
+		}
+
+		gapped := false
+		for _, tx := range queued[addr] {
+			var reason string
+			switch {
+			case gapped || tx.Nonce() > nonce:
+				gapped = true
+				reason = QueuedReasonNonceGap
+			case tx.Cost().Cmp(balance) > 0:
+				reason = QueuedReasonInsufficientBalance
+			case tx.GasFeeCapIntCmp(baseFee) < 0 || (m.minTip != nil && tx.GasTipCapIntCmp(m.minTip.ToBig()) < 0):
+				reason = QueuedReasonUnderpriced
+			}
+			infos = append(infos, m.evmTxInfo(addr, tx, false, reason, baseFee))
+			if !gapped {
+				nonce = tx.Nonce() + 1
+				balance.Sub(balance, tx.Cost())
+			}
+		}
+	}
+	return infos, nil
+}
+
+func (m *ExperimentalEVMMempool) evmTxInfo(from common.Address, tx *ethtypes.Transaction, pending bool, reason string, baseFee *big.Int) EVMTxInfo {
+	// the effective tip is negative along with the error when the fee cap is
+	// below the base fee
+	tip, _ := tx.EffectiveGasTip(baseFee)
+	return EVMTxInfo{
+		Tx:           tx,
+		From:         from,
+		Pending:      pending,
+		Private:      m.privateTxs.has(tx.Hash()),
+		QueuedReason: reason,
+		EffectiveTip: tip,
+	}
+}
+
+// InspectCosmosTxs returns the Cosmos transactions of the pool in the order of
+// the pool.
+func (m *ExperimentalEVMMempool) InspectCosmosTxs() ([]CosmosTxInfo, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, err
+	}
+	denom := m.vmKeeper.GetEvmCoinInfo(ctx).Denom
+	baseFee := m.vmKeeper.GetBaseFee(ctx)
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+
+	m.mtx.Lock()
+	txs := m.cosmosTxs(ctx)
+	m.mtx.Unlock()
+
+	signers := sdkmempool.NewDefaultSignerExtractionAdapter()
+	infos := make([]CosmosTxInfo, 0, len(txs))
+	for _, tx := range txs {
+		hash, err := m.cosmosTxHash(tx)
+		if err != nil {
+			return nil, err
+		}
+		info := CosmosTxInfo{
+			Tx:       tx,
+			Hash:     hash,
+			GasPrice: new(big.Int),
+			Time:     m.cosmosArrivals.get(tx),
+		}
+		if sigs, err := signers.GetSigners(tx); err == nil && len(sigs) > 0 {
+			info.Signer, info.Sequence = sigs[0].Signer, sigs[0].Sequence
+		}
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			info.Gas = feeTx.GetGas()
+			if found, coin := feeTx.GetFee().Find(denom); found && info.Gas > 0 {
+				info.GasPrice = coin.Amount.Quo(math.NewIntFromUint64(info.Gas)).BigInt()
+			}
+		}
+		info.EffectiveTip = new(big.Int).Sub(info.GasPrice, baseFee)
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// RemoveTxByHash removes the EVM or Cosmos transaction of the given hash from
+// the pool, the Cosmos transactions are identified by their CometBFT hash.
+// Removing a pending EVM transaction moves the following ones of its sender to
+// the queue. Returns false if the transaction is not in the pool.
+func (m *ExperimentalEVMMempool) RemoveTxByHash(hash common.Hash) (bool, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return false, err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.legacyTxPool.Has(hash) {
+		m.logger.Info("removing EVM transaction", "tx_hash", hash)
+		m.legacyTxPool.RemoveTx(hash, false, true)
+		m.privateTxs.remove(hash)
+		return true, nil
+	}
+
+	for _, tx := range m.cosmosTxs(ctx) {
+		txHash, err := m.cosmosTxHash(tx)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(txHash, hash.Bytes()) {
+			m.logger.Info("removing Cosmos transaction", "tx_hash", hash)
+			return true, m.removeCosmosTx(tx)
+		}
+	}
+	return false, nil
+}
+
+// RemoveSenderTxs removes all the EVM transactions of the sender and the Cosmos
+// transactions it signed first from the pool, and returns the number of
+// removed transactions.
+func (m *ExperimentalEVMMempool) RemoveSenderTxs(sender common.Address) (int, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return 0, err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// the transactions are removed from the highest nonce so the following ones
+	// are not moved to the queue
+	pending, queued := m.txPool.ContentFrom(sender)
+	txs := make([]*ethtypes.Transaction, 0, len(pending)+len(queued))
+	txs = append(append(txs, pending...), queued...)
+	for i := len(txs) - 1; i >= 0; i-- {
+		hash := txs[i].Hash()
+		m.legacyTxPool.RemoveTx(hash, false, true)
+		m.privateTxs.remove(hash)
+	}
+	removed := len(txs)
+
+	signers := sdkmempool.NewDefaultSignerExtractionAdapter()
+	for _, tx := range m.cosmosTxs(ctx) {
+		sigs, err := signers.GetSigners(tx)
+		if err != nil || len(sigs) == 0 || !bytes.Equal(sigs[0].Signer, sender.Bytes()) {
+			continue
+		}
+		if err := m.removeCosmosTx(tx); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+
+	m.logger.Info("removed sender transactions", "sender", sender, "tx_count", removed)
+	return removed, nil
+}
+
+// cosmosTxs returns the Cosmos transactions of the pool in the order of the
+// pool. The caller must hold the mempool lock.
+func (m *ExperimentalEVMMempool) cosmosTxs(ctx sdk.Context) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := m.cosmosPool.Select(ctx, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+// removeCosmosTx removes the transaction from the Cosmos pool. The caller must
+// hold the mempool lock.
+func (m *ExperimentalEVMMempool) removeCosmosTx(tx sdk.Tx) error {
+	// the arrival is forgotten even if the transaction is no longer in the pool
+	m.cosmosArrivals.remove(tx)
+	return m.cosmosPool.Remove(tx)
+}
+
+// cosmosTxHash returns the CometBFT hash of the Cosmos transaction.
+func (m *ExperimentalEVMMempool) cosmosTxHash(tx sdk.Tx) ([]byte, error) {
+	bz, err := m.txConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+	return cmttypes.Tx(bz).Hash(), nil
+}
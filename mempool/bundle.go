@@ -0,0 +1,441 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+const (
+	// MaxBundleTxs is the maximum number of transactions of a bundle
+	MaxBundleTxs = 16
+	// MaxBundlesPerBlock is the maximum number of bundles targeting a block,
+	// which bounds the bundles checked when the block is proposed
+	MaxBundlesPerBlock = 16
+	// MaxBundles is the maximum number of bundles kept by the pool
+	MaxBundles = 256
+	// MaxBundlesPerSender is the maximum number of bundles kept by the pool an
+	// account sends transactions in
+	MaxBundlesPerSender = 4
+)
+
+// Bundle is an ordered set of EVM transactions targeting the block of the
+// given number. The bundle is checked as a whole when the block is proposed:
+// either all of its transactions are selected, in order and ahead of the pool
+// ones, or none of them.
+//
+// The proposal includes the bundle atomically when its handler uses the
+// TxSelector of NewTxSelector: the bundle is left out as a whole if its
+// transactions don't all fit in the max block bytes and gas.
+type Bundle struct {
+	Txs         []*ethtypes.Transaction
+	BlockNumber uint64
+	// MinTimestamp and MaxTimestamp bound the block time in seconds, they are
+	// ignored when 0
+	MinTimestamp uint64
+	MaxTimestamp uint64
+
+	senders map[common.Address]struct{}
+}
+
+// Hash returns the keccak256 hash of the concatenated transaction hashes.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// Gas returns the sum of the gas limits of the transactions.
+func (b *Bundle) Gas() uint64 {
+	var gas uint64
+	for _, tx := range b.Txs {
+		gas += tx.Gas()
+	}
+	return gas
+}
+
+// InsertBundle stores the bundle until its block is proposed by the node. The
+// bundle transactions are not added to the pool nor broadcast to the peers,
+// they are checked against the state of the proposed block and included ahead
+// of the pool ones. The block number defaults to the next block when 0.
+//
+// The bundles are bounded by MaxBundleTxs, MaxBundlesPerBlock, MaxBundles and
+// MaxBundlesPerSender, the bundles exceeding them are rejected.
+func (m *ExperimentalEVMMempool) InsertBundle(bundle *Bundle) (common.Hash, error) {
+	if len(bundle.Txs) == 0 {
+		return common.Hash{}, ErrEmptyBundle
+	}
+	if len(bundle.Txs) > MaxBundleTxs {
+		return common.Hash{}, fmt.Errorf("%w: %d transactions exceed the max of %d", ErrInvalidBundle, len(bundle.Txs), MaxBundleTxs)
+	}
+	if bundle.MaxTimestamp != 0 && bundle.MinTimestamp > bundle.MaxTimestamp {
+		return common.Hash{}, fmt.Errorf("%w: min timestamp %d is after max timestamp %d", ErrInvalidBundle, bundle.MinTimestamp, bundle.MaxTimestamp)
+	}
+	if gas := bundle.Gas(); gas > m.blockGasLimit {
+		return common.Hash{}, fmt.Errorf("%w: bundle gas %d exceeds block gas limit %d", ErrInvalidBundle, gas, m.blockGasLimit)
+	}
+
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	height := uint64(ctx.BlockHeight()) // #nosec G115 -- block height is never negative
+	if bundle.BlockNumber == 0 {
+		bundle.BlockNumber = height + 1
+	}
+	if bundle.BlockNumber <= height || bundle.BlockNumber > height+MaxPrivateBlocks {
+		return common.Hash{}, fmt.Errorf("%w: block number %d, chain head %d", ErrInvalidBlockNumber, bundle.BlockNumber, height)
+	}
+	signer := ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)
+	bundle.senders = make(map[common.Address]struct{}, len(bundle.Txs))
+	for _, tx := range bundle.Txs {
+		if _, err := m.buildSDKTx(ctx, tx); err != nil {
+			return common.Hash{}, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+		}
+		from, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+		}
+		bundle.senders[from] = struct{}{}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.pruneBundles(height + 1)
+
+	hash := bundle.Hash()
+	for _, b := range m.bundles[bundle.BlockNumber] {
+		if b.Hash() == hash {
+			return hash, nil
+		}
+	}
+	if err := m.checkBundleLimits(bundle); err != nil {
+		return common.Hash{}, err
+	}
+	m.bundles[bundle.BlockNumber] = append(m.bundles[bundle.BlockNumber], bundle)
+	m.logger.Debug("bundle inserted", "bundle_hash", hash, "block_number", bundle.BlockNumber, "tx_count", len(bundle.Txs))
+	return hash, nil
+}
+
+// checkBundleLimits checks that the pool can keep the bundle without
+// exceeding the bundle limits.
+func (m *ExperimentalEVMMempool) checkBundleLimits(bundle *Bundle) error {
+	if len(m.bundles[bundle.BlockNumber]) >= MaxBundlesPerBlock {
+		return fmt.Errorf("%w: %d bundles already target block %d", ErrBundleLimit, MaxBundlesPerBlock, bundle.BlockNumber)
+	}
+
+	count := 0
+	senderCounts := make(map[common.Address]int, len(bundle.senders))
+	for _, bundles := range m.bundles {
+		count += len(bundles)
+		for _, b := range bundles {
+			for sender := range b.senders {
+				if _, ok := bundle.senders[sender]; ok {
+					senderCounts[sender]++
+				}
+			}
+		}
+	}
+	if count >= MaxBundles {
+		return fmt.Errorf("%w: the pool already holds %d bundles", ErrBundleLimit, MaxBundles)
+	}
+	for sender, senderCount := range senderCounts {
+		if senderCount >= MaxBundlesPerSender {
+			return fmt.Errorf("%w: %d bundles already hold transactions of %s", ErrBundleLimit, MaxBundlesPerSender, sender)
+		}
+	}
+	return nil
+}
+
+// pruneBundles drops the bundles targeting the blocks before the block number.
+func (m *ExperimentalEVMMempool) pruneBundles(blockNumber uint64) {
+	for number := range m.bundles {
+		if number < blockNumber {
+			delete(m.bundles, number)
+		}
+	}
+}
+
+// selectBundles returns the transactions of the bundles targeting the block of
+// the context, along with their senders. The bundles are checked in insertion
+// order against the state left by the previous ones, and skipped entirely if
+// one of their transactions fails the ante handler or doesn't fit in the block
+// gas limit. The selected bundles are recorded for the TxSelector, see Bundle.
+func (m *ExperimentalEVMMempool) selectBundles(ctx sdk.Context) ([]sdk.Tx, map[common.Address]struct{}) {
+	height := uint64(ctx.BlockHeight()) // #nosec G115 -- block height is never negative
+	m.pruneBundles(height)
+
+	bundles := m.bundles[height]
+	selected := make([]proposedBundle, 0, len(bundles))
+	defer func() { m.bundleHashes.set(height, selected) }()
+	if len(bundles) == 0 {
+		return nil, nil
+	}
+
+	var (
+		txs       []sdk.Tx
+		senders   = make(map[common.Address]struct{})
+		gas       uint64
+		blockTime = uint64(max(ctx.BlockTime().Unix(), 0)) // #nosec G115 -- clamped to the epoch
+	)
+	cacheCtx, _ := ctx.CacheContext()
+	for _, bundle := range bundles {
+		hash := bundle.Hash()
+		if blockTime < bundle.MinTimestamp || (bundle.MaxTimestamp != 0 && blockTime > bundle.MaxTimestamp) {
+			m.logger.Debug("skipping bundle out of its time range", "bundle_hash", hash, "block_time", blockTime)
+			continue
+		}
+		if gas+bundle.Gas() > m.blockGasLimit {
+			m.logger.Debug("skipping bundle exceeding the block gas limit", "bundle_hash", hash)
+			continue
+		}
+
+		bundleCtx, write := cacheCtx.CacheContext()
+		bundleTxs, err := m.checkBundle(bundleCtx, bundle)
+		if err != nil {
+			m.logger.Debug("skipping invalid bundle", "bundle_hash", hash, "error", err)
+			continue
+		}
+		proposed, err := m.bundleSize(bundle, bundleTxs)
+		if err != nil {
+			m.logger.Debug("skipping invalid bundle", "bundle_hash", hash, "error", err)
+			continue
+		}
+		write()
+
+		for from := range bundle.senders {
+			senders[from] = struct{}{}
+		}
+		txs = append(txs, bundleTxs...)
+		gas += bundle.Gas()
+		selected = append(selected, proposed)
+	}
+	return txs, senders
+}
+
+// bundleSize returns the bytes and gas the bundle transactions take in the
+// proposal.
+func (m *ExperimentalEVMMempool) bundleSize(bundle *Bundle, txs []sdk.Tx) (proposedBundle, error) {
+	proposed := proposedBundle{hashes: make([]common.Hash, 0, len(bundle.Txs))}
+	for i, tx := range txs {
+		txBz, err := m.txConfig.TxEncoder()(tx)
+		if err != nil {
+			return proposedBundle{}, fmt.Errorf("failed to encode transaction %s: %w", bundle.Txs[i].Hash(), err)
+		}
+		txBytes, txGas := proposalTxSize(tx, txBz)
+		proposed.hashes = append(proposed.hashes, bundle.Txs[i].Hash())
+		proposed.txBytes += txBytes
+		proposed.txGas += txGas
+	}
+	return proposed, nil
+}
+
+// checkBundle runs the ante handler on the bundle transactions in order.
+func (m *ExperimentalEVMMempool) checkBundle(ctx sdk.Context, bundle *Bundle) ([]sdk.Tx, error) {
+	txs := make([]sdk.Tx, 0, len(bundle.Txs))
+	for _, tx := range bundle.Txs {
+		sdkTx, err := m.buildSDKTx(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		if m.anteHandler != nil {
+			if _, err := m.anteHandler(ctx, sdkTx, false); err != nil {
+				return nil, fmt.Errorf("transaction %s: %w", tx.Hash(), err)
+			}
+		}
+		txs = append(txs, sdkTx)
+	}
+	return txs, nil
+}
+
+var _ sdkmempool.Iterator = &bundleIterator{}
+
+// bundleIterator yields the bundle transactions ahead of the ones of the
+// mempool iterator.
+type bundleIterator struct {
+	txs  []sdk.Tx
+	next sdkmempool.Iterator
+}
+
+// newBundleIterator returns the mempool iterator as is when there are no
+// bundle transactions.
+func newBundleIterator(txs []sdk.Tx, next sdkmempool.Iterator) sdkmempool.Iterator {
+	if len(txs) == 0 {
+		return next
+	}
+	return &bundleIterator{txs: txs, next: next}
+}
+
+// Next returns the iterator over the remaining transactions.
+func (i *bundleIterator) Next() sdkmempool.Iterator {
+	if len(i.txs) > 1 {
+		i.txs = i.txs[1:]
+		return i
+	}
+	return i.next
+}
+
+// Tx returns the current bundle transaction.
+func (i *bundleIterator) Tx() sdk.Tx {
+	return i.txs[0]
+}
+
+// proposedBundle is a bundle selected for the proposed block, along with the
+// bytes and gas its transactions take in the proposal.
+type proposedBundle struct {
+	hashes  []common.Hash
+	txBytes uint64
+	txGas   uint64
+}
+
+// proposedBundles tracks the bundles selected for the proposed block, by the
+// hash of their first transaction.
+type proposedBundles struct {
+	mu          sync.RWMutex
+	blockNumber uint64
+	bundles     map[common.Hash]proposedBundle
+}
+
+func newProposedBundles() *proposedBundles {
+	return &proposedBundles{bundles: make(map[common.Hash]proposedBundle)}
+}
+
+// set records the bundles selected for the block number.
+func (p *proposedBundles) set(blockNumber uint64, bundles []proposedBundle) {
+	byFirstTx := make(map[common.Hash]proposedBundle, len(bundles))
+	for _, bundle := range bundles {
+		byFirstTx[bundle.hashes[0]] = bundle
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.blockNumber = blockNumber
+	p.bundles = byFirstTx
+}
+
+// get returns the bundle selected for the block number which starts with the
+// transaction.
+func (p *proposedBundles) get(blockNumber uint64, first common.Hash) (proposedBundle, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.blockNumber != blockNumber {
+		return proposedBundle{}, false
+	}
+	bundle, ok := p.bundles[first]
+	return bundle, ok
+}
+
+var _ baseapp.TxSelector = &txSelector{}
+
+// txSelector selects the transactions of the proposal within the max block
+// bytes and gas like the default TxSelector of the SDK, except for the bundles
+// which are selected as a whole: the block space of the bundle is checked when
+// its first transaction is selected, and all of its transactions are left out
+// if they don't fit.
+type txSelector struct {
+	bundles *proposedBundles
+
+	totalTxBytes uint64
+	totalTxGas   uint64
+	selectedTxs  [][]byte
+	skippedTxs   map[common.Hash]struct{} // the transactions of the bundles left out
+}
+
+// NewTxSelector returns the TxSelector of the proposal handler which includes
+// the bundles of the mempool atomically.
+func NewTxSelector(mempool *ExperimentalEVMMempool) baseapp.TxSelector {
+	return &txSelector{bundles: mempool.bundleHashes}
+}
+
+// SelectedTxs returns a copy of the selected transactions.
+func (ts *txSelector) SelectedTxs(_ context.Context) [][]byte {
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+// Clear clears the selected transactions.
+func (ts *txSelector) Clear() {
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+	ts.selectedTxs = nil
+	ts.skippedTxs = nil
+}
+
+// SelectTxForProposal selects the transaction if it fits in the proposal, along
+// with the whole bundle it starts. It returns true once the proposal is full.
+func (ts *txSelector) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	txBytes, txGas := proposalTxSize(memTx, txBz)
+
+	if hash, ok := evmTxHash(memTx); ok {
+		if _, skipped := ts.skippedTxs[hash]; skipped {
+			return ts.full(maxTxBytes, maxBlockGas)
+		}
+		blockNumber := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) // #nosec G115 -- block height is never negative
+		if bundle, ok := ts.bundles.get(blockNumber, hash); ok && !ts.fits(maxTxBytes, maxBlockGas, bundle.txBytes, bundle.txGas) {
+			if ts.skippedTxs == nil {
+				ts.skippedTxs = make(map[common.Hash]struct{})
+			}
+			for _, hash := range bundle.hashes[1:] {
+				ts.skippedTxs[hash] = struct{}{}
+			}
+			return ts.full(maxTxBytes, maxBlockGas)
+		}
+	}
+
+	if ts.fits(maxTxBytes, maxBlockGas, txBytes, txGas) {
+		ts.totalTxBytes += txBytes
+		ts.totalTxGas += txGas
+		ts.selectedTxs = append(ts.selectedTxs, txBz)
+	}
+	return ts.full(maxTxBytes, maxBlockGas)
+}
+
+// fits returns true if the transactions of the given bytes and gas fit in the
+// proposal.
+func (ts *txSelector) fits(maxTxBytes, maxBlockGas, txBytes, txGas uint64) bool {
+	return ts.totalTxBytes+txBytes <= maxTxBytes && (maxBlockGas == 0 || ts.totalTxGas+txGas <= maxBlockGas)
+}
+
+// full returns true once no transaction can be selected anymore.
+func (ts *txSelector) full(maxTxBytes, maxBlockGas uint64) bool {
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && ts.totalTxGas >= maxBlockGas)
+}
+
+// proposalTxSize returns the bytes and gas the transaction takes in the
+// proposal, as accounted by the default TxSelector of the SDK.
+func proposalTxSize(tx sdk.Tx, txBz []byte) (uint64, uint64) {
+	txBytes := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	var txGas uint64
+	if gasTx, ok := tx.(baseapp.GasTx); ok {
+		txGas = gasTx.GetGas()
+	}
+	return txBytes, txGas
+}
+
+// evmTxHash returns the hash of the EVM transaction of the Cosmos transaction.
+func evmTxHash(tx sdk.Tx) (common.Hash, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return common.Hash{}, false
+	}
+	msg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return common.Hash{}, false
+	}
+	return msg.Hash(), true
+}
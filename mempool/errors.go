@@ -9,4 +9,8 @@ var (
 	ErrNotEVMTransaction  = errors.New("transaction is not an EVM transaction")
 	ErrNonceGap           = errors.New("tx nonce is higher than account nonce")
 	ErrNonceLow           = errors.New("tx nonce is lower than account nonce")
+	ErrInvalidBlockNumber = errors.New("invalid target block number")
+	ErrEmptyBundle        = errors.New("bundle has no transactions")
+	ErrInvalidBundle      = errors.New("invalid bundle")
+	ErrBundleLimit        = errors.New("bundle limit reached")
 )
@@ -2,7 +2,9 @@ package mempool
 
 import (
 	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/holiman/uint256"
 
@@ -21,17 +23,26 @@ import (
 var _ mempool.Iterator = &EVMMempoolIterator{}
 
 // EVMMempoolIterator provides a unified iterator over both EVM and Cosmos transactions in the mempool.
-// It implements priority-based transaction selection, choosing between EVM and Cosmos transactions
-// based on their fee values. The iterator maintains state to track transaction types and ensures
-// proper sequencing during block building.
+// The order of the transactions is decided by a TxSelector, which by default chooses between EVM and
+// Cosmos transactions based on their fee values. The iterator maintains state to track transaction
+// types and ensures proper sequencing during block building.
 type EVMMempoolIterator struct {
 	/** Mempool Iterators **/
 	evmIterator    *miner.TransactionsByPriceAndNonce
 	cosmosIterator mempool.Iterator
 
+	/** Ordering **/
+	selector      TxSelector
+	cosmosArrival func(tx sdk.Tx) time.Time
+	setFeeGrant   func(hash common.Hash, txBuilder client.TxBuilder) error
+	skippedCosmos map[string]uint64 // lowest skipped sequence by signer of the Cosmos transactions
+	current       sdk.Tx
+	currentIsEVM  bool
+
 	/** Utils **/
 	logger   log.Logger
 	txConfig client.TxConfig
+	signers  mempool.SignerExtractionAdapter
 
 	/** Chain Params **/
 	bondDenom string
@@ -42,10 +53,13 @@ type EVMMempoolIterator struct {
 }
 
 // NewEVMMempoolIterator creates a new unified iterator over EVM and Cosmos transactions.
-// It combines iterators from both transaction pools and selects transactions based on fee priority.
-// Returns nil if both iterators are empty or nil. The bondDenom parameter specifies the native
+// It combines iterators from both transaction pools and selects transactions with the selector,
+// which defaults to the fee priority of the DefaultOrderingPolicy when nil. The cosmosArrival
+// function returns the time the Cosmos transactions entered the pool, and the setFeeGrant function
+// sets the fee grant of the sponsored EVM transactions on their Cosmos transaction, they may be nil.
+// Returns nil if no transaction is selected. The bondDenom parameter specifies the native
 // token denomination for fee comparisons, and chainId is used for EVM transaction conversion.
-func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmosIterator mempool.Iterator, logger log.Logger, txConfig client.TxConfig, bondDenom string, chainID *big.Int, blockchain *Blockchain) mempool.Iterator {
+func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmosIterator mempool.Iterator, logger log.Logger, txConfig client.TxConfig, bondDenom string, chainID *big.Int, blockchain *Blockchain, selector TxSelector, cosmosArrival func(tx sdk.Tx) time.Time, setFeeGrant func(hash common.Hash, txBuilder client.TxBuilder) error) mempool.Iterator {
 	// Check if we have any transactions at all
 	hasEVM := evmIterator != nil && !evmIterator.Empty()
 	hasCosmos := cosmosIterator != nil && cosmosIterator.Tx() != nil
@@ -58,192 +72,157 @@ func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmo
 		return nil
 	}
 
-	return &EVMMempoolIterator{
+	if selector == nil {
+		selector = defaultSelector{}
+	}
+
+	iterator := &EVMMempoolIterator{
 		evmIterator:    evmIterator,
 		cosmosIterator: cosmosIterator,
+		selector:       selector,
+		cosmosArrival:  cosmosArrival,
+		setFeeGrant:    setFeeGrant,
+		skippedCosmos:  make(map[string]uint64),
 		logger:         logger,
 		txConfig:       txConfig,
+		signers:        mempool.NewDefaultSignerExtractionAdapter(),
 		bondDenom:      bondDenom,
 		chainID:        chainID,
 		blockchain:     blockchain,
 	}
+	if !iterator.selectNext() {
+		logger.Debug("no transaction selected")
+		return nil
+	}
+	return iterator
 }
 
 // Next advances the iterator to the next transaction and returns the updated iterator.
-// It determines which iterator (EVM or Cosmos) provided the current transaction and advances
-// that iterator accordingly. Returns nil when no more transactions are available.
+// It advances the iterator (EVM or Cosmos) which provided the current transaction, then
+// selects the next one. Returns nil when no more transactions are available.
 func (i *EVMMempoolIterator) Next() mempool.Iterator {
-	// Get next transactions on both iterators to determine which iterator to advance
-	nextEVMTx, _ := i.getNextEVMTx()
-	nextCosmosTx, _ := i.getNextCosmosTx()
-
-	// If no transactions available, we're done
-	if nextEVMTx == nil && nextCosmosTx == nil {
-		i.logger.Debug("no more transactions available, ending iteration")
-		return nil
+	if i.currentIsEVM {
+		i.logger.Debug("advancing EVM iterator")
+		// NOTE: EVM transactions are automatically removed by the maintenance loop in the txpool
+		// so we shift instead of popping
+		i.evmIterator.Shift()
+	} else {
+		i.logger.Debug("advancing Cosmos iterator")
+		i.cosmosIterator = i.cosmosIterator.Next()
 	}
 
-	i.logger.Debug("advancing to next transaction", "has_evm", nextEVMTx != nil, "has_cosmos", nextCosmosTx != nil)
-
-	// Advance the iterator that provided the current transaction
-	i.advanceCurrentIterator()
-
-	// Check if we still have transactions after advancing
-	if !i.hasMoreTransactions() {
-		i.logger.Debug("no more transactions after advancing, ending iteration")
+	if !i.selectNext() {
+		i.logger.Debug("no more transactions available, ending iteration")
 		return nil
 	}
-
 	return i
 }
 
-// Tx returns the current transaction from the iterator.
-// It selects between EVM and Cosmos transactions based on fee priority
-// and converts EVM transactions to SDK format.
+// Tx returns the current transaction from the iterator, EVM transactions are converted to SDK format.
 func (i *EVMMempoolIterator) Tx() sdk.Tx {
-	// Get current transactions from both iterators
-	nextEVMTx, _ := i.getNextEVMTx()
-	nextCosmosTx, _ := i.getNextCosmosTx()
-
-	i.logger.Debug("getting current transaction", "has_evm", nextEVMTx != nil, "has_cosmos", nextCosmosTx != nil)
-
-	// Return the preferred transaction based on fee priority
-	tx := i.getPreferredTransaction(nextEVMTx, nextCosmosTx)
-
-	if tx == nil {
-		i.logger.Debug("no preferred transaction available")
-	} else {
-		i.logger.Debug("returning preferred transaction")
-	}
-
-	return tx
+	return i.current
 }
 
 // =============================================================================
 // UTILITY FUNCTIONS
 // =============================================================================
 
-// shouldUseEVM determines which transaction type to prioritize based on fee comparison.
-// Returns true if the EVM transaction should be selected, false if Cosmos transaction should be used.
-// EVM transactions will be prioritized in the following conditions:
-// 1. Cosmos mempool has no transactions
-// 2. EVM mempool has no transactions (fallback to Cosmos)
-// 3. Cosmos transaction has no fee information
-// 4. Cosmos transaction fee denomination doesn't match bond denom
-// 5. Cosmos transaction fee is lower than the EVM transaction fee
-// 6. Cosmos transaction fee overflows when converted to uint256
-func (i *EVMMempoolIterator) shouldUseEVM() bool {
-	// Get next transactions from both iterators
-	nextEVMTx, evmFee := i.getNextEVMTx()
-	nextCosmosTx, cosmosFee := i.getNextCosmosTx()
-
-	// Handle cases where only one type is available
-	if nextEVMTx == nil {
-		i.logger.Debug("no EVM transaction available, preferring Cosmos")
-		return false // Use Cosmos when no EVM transaction available
-	}
-	if nextCosmosTx == nil {
-		i.logger.Debug("no Cosmos transaction available, preferring EVM")
-		return true // Use EVM when no Cosmos transaction available
-	}
-
-	// Both have transactions - compare fees
-	// cosmosFee can never be nil, but can be zero if no valid fee found
-	if cosmosFee.IsZero() {
-		i.logger.Debug("Cosmos transaction has no valid fee, preferring EVM", "evm_fee", evmFee.String())
-		return true // Use EVM if Cosmos transaction has no valid fee
-	}
-
-	// Compare fees - prefer EVM unless Cosmos has higher fee
-	cosmosHigher := cosmosFee.Gt(evmFee)
-	i.logger.Debug("comparing transaction fees",
-		"evm_fee", evmFee.String(),
-		"cosmos_fee", cosmosFee.String())
-
-	return !cosmosHigher
-}
-
-// getNextEVMTx retrieves the next EVM transaction and its fee
-func (i *EVMMempoolIterator) getNextEVMTx() (*txpool.LazyTransaction, *uint256.Int) {
-	if i.evmIterator == nil {
-		return nil, nil
-	}
-	return i.evmIterator.Peek()
-}
-
-// getNextCosmosTx retrieves the next Cosmos transaction and its effective gas tip
-func (i *EVMMempoolIterator) getNextCosmosTx() (sdk.Tx, *uint256.Int) {
-	if i.cosmosIterator == nil {
-		return nil, nil
-	}
+// selectNext asks the selector for the next transaction until one is selected.
+// Returns false if the selection ended or both iterators are exhausted.
+func (i *EVMMempoolIterator) selectNext() bool {
+	for {
+		nextEVMTx := i.getNextEVMTx()
+		nextCosmosTx := i.getNextCosmosTx()
+		if nextEVMTx == nil && nextCosmosTx == nil {
+			return false
+		}
 
-	tx := i.cosmosIterator.Tx()
-	if tx == nil {
-		return nil, nil
-	}
+		selection := i.selector.Pick(nextEVMTx, nextCosmosTx)
+		i.logger.Debug("selecting next transaction", "has_evm", nextEVMTx != nil, "has_cosmos", nextCosmosTx != nil, "selection", selection)
 
-	// Extract effective gas tip from the transaction (gas price - base fee)
-	cosmosEffectiveTip := i.extractCosmosEffectiveTip(tx)
-	if cosmosEffectiveTip == nil {
-		return tx, uint256.NewInt(0) // Return zero fee if no valid fee found
+		switch {
+		case selection == SelectEVM && nextEVMTx != nil:
+			tx := i.convertEVMToSDKTx(nextEVMTx.Tx)
+			if tx == nil {
+				// the following transactions of the sender can't be included either
+				i.evmIterator.Pop()
+				continue
+			}
+			i.current, i.currentIsEVM = tx, true
+			return true
+		case selection == SelectCosmos && nextCosmosTx != nil:
+			i.current, i.currentIsEVM = nextCosmosTx.Tx, false
+			return true
+		case selection == SkipEVM && nextEVMTx != nil:
+			i.evmIterator.Pop()
+		case selection == SkipCosmos && nextCosmosTx != nil:
+			// the transactions of the signer with a higher sequence would fail on it
+			if signer, sequence, ok := i.cosmosSigner(nextCosmosTx.Tx); ok {
+				if skipped, found := i.skippedCosmos[signer]; !found || sequence < skipped {
+					i.skippedCosmos[signer] = sequence
+				}
+			}
+			i.cosmosIterator = i.cosmosIterator.Next()
+		case selection == SelectNone:
+			return false
+		default:
+			i.logger.Error("invalid transaction selection", "selection", selection, "has_evm", nextEVMTx != nil, "has_cosmos", nextCosmosTx != nil)
+			return false
+		}
 	}
-
-	return tx, cosmosEffectiveTip
 }
 
-// getPreferredTransaction returns the preferred transaction based on fee priority.
-// Takes both transaction types as input and returns the preferred one, or nil if neither is available.
-func (i *EVMMempoolIterator) getPreferredTransaction(nextEVMTx *txpool.LazyTransaction, nextCosmosTx sdk.Tx) sdk.Tx {
-	// If no transactions available, return nil
-	if nextEVMTx == nil && nextCosmosTx == nil {
-		i.logger.Debug("no transactions available from either mempool")
+// getNextEVMTx retrieves the next EVM transaction along with its sender and fee
+func (i *EVMMempoolIterator) getNextEVMTx() *miner.OrderedTx {
+	if i.evmIterator == nil {
 		return nil
 	}
+	return i.evmIterator.Head()
+}
 
-	// Determine which transaction type to prioritize based on fee comparison
-	useEVM := i.shouldUseEVM()
-
-	if useEVM {
-		i.logger.Debug("preferring EVM transaction based on fee comparison")
-		// Prefer EVM transaction if available and convertible
-		if nextEVMTx != nil {
-			if evmTx := i.convertEVMToSDKTx(nextEVMTx); evmTx != nil {
-				return evmTx
+// getNextCosmosTx retrieves the next Cosmos transaction along with its effective gas tip,
+// skipping the ones following a skipped transaction of their signer
+func (i *EVMMempoolIterator) getNextCosmosTx() *CosmosTx {
+	for i.cosmosIterator != nil {
+		tx := i.cosmosIterator.Tx()
+		if tx == nil {
+			return nil
+		}
+		if signer, sequence, ok := i.cosmosSigner(tx); ok {
+			if skipped, found := i.skippedCosmos[signer]; found && sequence > skipped {
+				i.cosmosIterator = i.cosmosIterator.Next()
+				continue
 			}
 		}
-		// Fall back to Cosmos if EVM is not available or conversion fails
-		i.logger.Debug("EVM transaction conversion failed, falling back to Cosmos transaction")
-		return nextCosmosTx
-	}
-
-	// Prefer Cosmos transaction
-	i.logger.Debug("preferring Cosmos transaction based on fee comparison")
-	return nextCosmosTx
-}
 
-// advanceCurrentIterator advances the appropriate iterator based on which transaction was used
-func (i *EVMMempoolIterator) advanceCurrentIterator() {
-	useEVM := i.shouldUseEVM()
-
-	if useEVM {
-		i.logger.Debug("advancing EVM iterator")
-		// We used EVM transaction, advance EVM iterator
-		// NOTE: EVM transactions are automatically removed by the maintenance loop in the txpool
-		// so we shift instead of popping
-		if i.evmIterator != nil {
-			i.evmIterator.Shift()
-		} else {
-			i.logger.Error("EVM iterator is nil but shouldUseEVM returned true")
+		next := &CosmosTx{Tx: tx}
+		// Extract effective gas tip from the transaction (gas price - base fee)
+		next.Tip = i.extractCosmosEffectiveTip(tx)
+		if next.Tip == nil {
+			next.Tip = uint256.NewInt(0) // Use zero fee if no valid fee found
 		}
-	} else {
-		i.logger.Debug("advancing Cosmos iterator")
-		// We used Cosmos transaction (or EVM failed), advance Cosmos iterator
-		if i.cosmosIterator != nil {
-			i.cosmosIterator = i.cosmosIterator.Next()
-		} else {
-			i.logger.Error("Cosmos iterator is nil but shouldUseEVM returned false")
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			next.Gas = feeTx.GetGas()
+		}
+		if i.cosmosArrival != nil {
+			next.Time = i.cosmosArrival(tx)
 		}
+		return next
 	}
+	return nil
+}
+
+// cosmosSigner returns the first signer of the Cosmos transaction and its sequence,
+// unordered transactions don't depend on the sequence and are not reported
+func (i *EVMMempoolIterator) cosmosSigner(tx sdk.Tx) (string, uint64, bool) {
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		return "", 0, false
+	}
+	signers, err := i.signers.GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		return "", 0, false
+	}
+	return string(signers[0].Signer), signers[0].Sequence, true
 }
 
 // extractCosmosEffectiveTip extracts the effective gas tip from a Cosmos transaction
@@ -323,13 +302,6 @@ func (i *EVMMempoolIterator) getCurrentBaseFee() *uint256.Int {
 	return baseFeeUint
 }
 
-// hasMoreTransactions checks if there are more transactions available in either iterator
-func (i *EVMMempoolIterator) hasMoreTransactions() bool {
-	nextEVMTx, _ := i.getNextEVMTx()
-	nextCosmosTx, _ := i.getNextCosmosTx()
-	return nextEVMTx != nil || nextCosmosTx != nil
-}
-
 // convertEVMToSDKTx converts an Ethereum transaction to a Cosmos SDK transaction.
 // It wraps the EVM transaction in a MsgEthereumTx and builds a proper SDK transaction
 // using the configured transaction builder and bond denomination for fees.
@@ -346,11 +318,20 @@ func (i *EVMMempoolIterator) convertEVMToSDKTx(nextEVMTx *txpool.LazyTransaction
 		return nil // Return nil for invalid tx instead of panicking
 	}
 
-	cosmosTx, err := msgEthereumTx.BuildTx(i.txConfig.NewTxBuilder(), i.bondDenom)
+	txBuilder := i.txConfig.NewTxBuilder()
+	cosmosTx, err := msgEthereumTx.BuildTx(txBuilder, i.bondDenom)
 	if err != nil {
 		i.logger.Error("failed to build Cosmos transaction from EVM transaction", "error", err, "tx_hash", hash)
 		return nil
 	}
+	// the sponsored transactions keep the fee grant they were submitted with
+	if i.setFeeGrant != nil {
+		if err := i.setFeeGrant(hash, txBuilder); err != nil {
+			i.logger.Error("failed to set fee grant of EVM transaction", "error", err, "tx_hash", hash)
+			return nil
+		}
+		cosmosTx = txBuilder.GetTx()
+	}
 
 	i.logger.Debug("successfully converted EVM transaction to Cosmos transaction", "tx_hash", hash)
 	return cosmosTx
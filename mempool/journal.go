@@ -0,0 +1,318 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	"github.com/cosmos/evm/mempool/txpool/locals"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// txJournal journals the EVM transactions of the pool so they survive node
+// restarts. Only the transactions of the local accounts are journaled, unless
+// the remote ones are journaled too. The accounts are local if they are set in
+// the pool config or if they submitted a transaction through the node.
+type txJournal struct {
+	mu        sync.Mutex
+	journal   *locals.Journal
+	remotes   bool
+	noLocals  bool
+	locals    map[common.Address]struct{}
+	rejournal time.Duration
+	signer    ethtypes.Signer
+	logger    log.Logger
+
+	// the Cosmos transactions wrapping the sponsored transactions are journaled
+	// along, so they are still sponsored once replayed
+	grants     io.WriteCloser
+	grantsPath string
+	sponsored  func(tx *ethtypes.Transaction) []byte
+
+	// loaded is set once the journal is replayed, the transactions are not
+	// journaled before
+	loaded bool
+	once   sync.Once
+	quit   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// newTxJournal creates the journal of the pool config, it returns nil if the
+// journal is disabled. The sponsored function returns the Cosmos transaction
+// wrapping the transaction with its fee grant, nil if it's not sponsored.
+func newTxJournal(config legacypool.Config, logger log.Logger, sponsored func(tx *ethtypes.Transaction) []byte) *txJournal {
+	if config.Journal == "" || (config.NoLocals && !config.JournalRemotes) {
+		return nil
+	}
+	j := &txJournal{
+		journal:   locals.NewJournal(config.Journal),
+		remotes:   config.JournalRemotes,
+		noLocals:  config.NoLocals,
+		locals:    make(map[common.Address]struct{}),
+		rejournal: max(config.Rejournal, time.Second),
+		signer:    ethtypes.LatestSigner(evmtypes.GetEthChainConfig()),
+		logger:    logger,
+		quit:      make(chan struct{}),
+
+		grantsPath: config.Journal + ".grants",
+		sponsored:  sponsored,
+	}
+	if !config.NoLocals {
+		for _, addr := range config.Locals {
+			j.locals[addr] = struct{}{}
+		}
+	}
+	return j
+}
+
+// insert journals the transaction added to the pool if it's tracked.
+func (j *txJournal) insert(tx *ethtypes.Transaction) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.loaded || !j.isTracked(tx) {
+		return
+	}
+	j.insertLocked(tx)
+}
+
+// insertLocked journals the transaction along with its fee grant, the lock must
+// be held.
+func (j *txJournal) insertLocked(tx *ethtypes.Transaction) {
+	if err := j.journal.Insert(tx); err != nil {
+		j.logger.Error("failed to journal EVM transaction", "tx_hash", tx.Hash(), "error", err)
+		return
+	}
+	if grant := j.grant(tx); grant != nil && j.grants != nil {
+		if err := rlp.Encode(j.grants, grant); err != nil {
+			j.logger.Error("failed to journal fee grant of EVM transaction", "tx_hash", tx.Hash(), "error", err)
+		}
+	}
+}
+
+// grant returns the journaled fee grant of the transaction, nil if it's not
+// sponsored.
+func (j *txJournal) grant(tx *ethtypes.Transaction) *journaledGrant {
+	if j.sponsored == nil {
+		return nil
+	}
+	wrapped := j.sponsored(tx)
+	if wrapped == nil {
+		return nil
+	}
+	return &journaledGrant{Hash: tx.Hash(), Tx: wrapped}
+}
+
+// trackLocal marks the sender of the transaction as local, the transaction is
+// journaled if the sender was not tracked yet when it was added to the pool.
+func (j *txJournal) trackLocal(tx *ethtypes.Transaction) {
+	if j.noLocals {
+		return
+	}
+	from, err := ethtypes.Sender(j.signer, tx)
+	if err != nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, ok := j.locals[from]; ok {
+		return
+	}
+	j.locals[from] = struct{}{}
+	if !j.loaded || j.remotes {
+		return
+	}
+	j.insertLocked(tx)
+}
+
+// isTracked returns true if the transaction should be journaled, the lock must
+// be held.
+func (j *txJournal) isTracked(tx *ethtypes.Transaction) bool {
+	if j.remotes {
+		return true
+	}
+	from, err := ethtypes.Sender(j.signer, tx)
+	if err != nil {
+		return false
+	}
+	_, ok := j.locals[from]
+	return ok
+}
+
+// tracked returns the journaled transactions of the pool content, the lock
+// must be held.
+func (j *txJournal) tracked(content ...map[common.Address][]*ethtypes.Transaction) map[common.Address]ethtypes.Transactions {
+	txs := make(map[common.Address]ethtypes.Transactions)
+	for _, accounts := range content {
+		for addr, accountTxs := range accounts {
+			if _, ok := j.locals[addr]; !ok && !j.remotes {
+				continue
+			}
+			txs[addr] = append(txs[addr], accountTxs...)
+		}
+	}
+	return txs
+}
+
+// load replays the journaled transactions with the add function, along with the
+// Cosmos transactions wrapping the sponsored ones by hash, and starts
+// regenerating the journal from the pool content at every rejournal interval.
+// The transactions added to the pool while loading are journaled by the first
+// regeneration.
+func (j *txJournal) load(add func([]*ethtypes.Transaction, map[common.Hash][]byte) []error, content func() (map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction)) {
+	j.once.Do(func() {
+		sponsored, err := j.loadGrants()
+		if err != nil {
+			j.logger.Error("failed to load fee grants of EVM transaction journal", "path", j.grantsPath, "error", err)
+		}
+		err = j.journal.Load(func(txs []*ethtypes.Transaction) []error {
+			if !j.remotes {
+				// the journal only holds the transactions of the local accounts
+				j.mu.Lock()
+				for _, tx := range txs {
+					if from, err := ethtypes.Sender(j.signer, tx); err == nil {
+						j.locals[from] = struct{}{}
+					}
+				}
+				j.mu.Unlock()
+			}
+			return add(txs, sponsored)
+		})
+		if err != nil {
+			j.logger.Error("failed to load EVM transaction journal", "path", j.journal.Path(), "error", err)
+		}
+		j.rotate(content)
+
+		j.wg.Add(1)
+		go func() {
+			defer j.wg.Done()
+
+			ticker := time.NewTicker(j.rejournal)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					j.rotate(content)
+				case <-j.quit:
+					return
+				}
+			}
+		}()
+	})
+}
+
+// rotate regenerates the journal with the tracked transactions of the pool
+// content.
+func (j *txJournal) rotate(content func() (map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.loaded = true
+	tracked := j.tracked(content())
+	if err := j.journal.Rotate(tracked); err != nil {
+		j.logger.Error("failed to rotate EVM transaction journal", "path", j.journal.Path(), "error", err)
+	}
+	if err := j.rotateGrants(tracked); err != nil {
+		j.logger.Error("failed to rotate fee grants of EVM transaction journal", "path", j.grantsPath, "error", err)
+	}
+}
+
+// journaledGrant is the Cosmos transaction wrapping a journaled sponsored
+// transaction, which holds its fee grant.
+type journaledGrant struct {
+	Hash common.Hash
+	Tx   []byte
+}
+
+// loadGrants reads the journaled fee grants by transaction hash.
+func (j *txJournal) loadGrants() (map[common.Hash][]byte, error) {
+	grants := make(map[common.Hash][]byte)
+	input, err := os.Open(j.grantsPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return grants, nil
+	}
+	if err != nil {
+		return grants, err
+	}
+	defer input.Close()
+
+	stream := rlp.NewStream(input, 0)
+	for {
+		var grant journaledGrant
+		if err := stream.Decode(&grant); err != nil {
+			if errors.Is(err, io.EOF) {
+				return grants, nil
+			}
+			return grants, err
+		}
+		grants[grant.Hash] = grant.Tx
+	}
+}
+
+// rotateGrants regenerates the journaled fee grants of the tracked transactions,
+// the lock must be held.
+func (j *txJournal) rotateGrants(tracked map[common.Address]ethtypes.Transactions) error {
+	if j.grants != nil {
+		if err := j.grants.Close(); err != nil {
+			return err
+		}
+		j.grants = nil
+	}
+	replacement, err := os.OpenFile(j.grantsPath+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	for _, txs := range tracked {
+		for _, tx := range txs {
+			if grant := j.grant(tx); grant != nil {
+				if err := rlp.Encode(replacement, grant); err != nil {
+					replacement.Close()
+					return err
+				}
+			}
+		}
+	}
+	replacement.Close()
+
+	if err := os.Rename(j.grantsPath+".new", j.grantsPath); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(j.grantsPath, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	j.grants = sink
+	return nil
+}
+
+// close stops regenerating the journal and closes it.
+func (j *txJournal) close() error {
+	close(j.quit)
+	j.wg.Wait()
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.grants != nil {
+		if err := j.grants.Close(); err != nil {
+			return fmt.Errorf("failed to close fee grants of EVM transaction journal: %w", err)
+		}
+		j.grants = nil
+	}
+	if err := j.journal.Close(); err != nil {
+		return fmt.Errorf("failed to close EVM transaction journal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package mempool
+
+import (
+	"crypto/ecdsa"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+func TestTxJournal(t *testing.T) {
+	require.NoError(t, evmtypes.SetChainConfig(evmtypes.DefaultChainConfig(constants.EighteenDecimalsChainID)))
+
+	localKey, _ := crypto.GenerateKey()
+	remoteKey, _ := crypto.GenerateKey()
+	local, remote := crypto.PubkeyToAddress(localKey.PublicKey), crypto.PubkeyToAddress(remoteKey.PublicKey)
+	signer := ethtypes.LatestSigner(evmtypes.GetEthChainConfig())
+	newTx := func(key *ecdsa.PrivateKey, nonce uint64) *ethtypes.Transaction {
+		return ethtypes.MustSignNewTx(key, signer, &ethtypes.LegacyTx{Nonce: nonce, Gas: 21000})
+	}
+
+	config := legacypool.DefaultConfig
+	config.Locals = []common.Address{local}
+	config.Journal = filepath.Join(t.TempDir(), "transactions.rlp")
+
+	var (
+		added     []common.Hash
+		pool      = make(map[common.Address][]*ethtypes.Transaction)
+		sponsored = make(map[common.Hash][]byte)
+		replayed  map[common.Hash][]byte
+	)
+	add := func(txs []*ethtypes.Transaction, grants map[common.Hash][]byte) []error {
+		for _, tx := range txs {
+			added = append(added, tx.Hash())
+		}
+		replayed = grants
+		return make([]error, len(txs))
+	}
+	wrap := func(tx *ethtypes.Transaction) []byte {
+		return sponsored[tx.Hash()]
+	}
+	content := func() (map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction) {
+		return pool, nil
+	}
+
+	journal := newTxJournal(config, log.NewNopLogger(), wrap)
+	require.NotNil(t, journal)
+
+	// the transactions added before the journal is loaded are journaled by
+	// the first regeneration, along with their fee grant
+	localTx := newTx(localKey, 0)
+	sponsored[localTx.Hash()] = []byte("local")
+	pool[local] = []*ethtypes.Transaction{localTx}
+	journal.insert(localTx)
+	journal.load(add, content)
+	require.Empty(t, added)
+
+	// only the transactions of the local accounts are journaled
+	localTx2, remoteTx, remoteTx2 := newTx(localKey, 1), newTx(remoteKey, 0), newTx(remoteKey, 1)
+	sponsored[localTx2.Hash()] = []byte("local2")
+	journal.insert(localTx2)
+	journal.insert(remoteTx)
+	// the account becomes local once it submits a transaction through the node
+	journal.trackLocal(remoteTx2)
+	require.NoError(t, journal.close())
+
+	reloaded := newTxJournal(config, log.NewNopLogger(), wrap)
+	reloaded.load(add, content)
+	require.Equal(t, []common.Hash{localTx.Hash(), localTx2.Hash(), remoteTx2.Hash()}, added)
+	require.Equal(t, sponsored, replayed)
+	require.Contains(t, reloaded.locals, remote)
+	require.NoError(t, reloaded.close())
+
+	// the journal is disabled without path or if only the local transactions
+	// are journaled while the local handling is disabled
+	config.NoLocals = true
+	require.Nil(t, newTxJournal(config, log.NewNopLogger(), nil))
+	config.JournalRemotes = true
+	require.NotNil(t, newTxJournal(config, log.NewNopLogger(), nil))
+	config.Journal = ""
+	require.Nil(t, newTxJournal(config, log.NewNopLogger(), nil))
+}
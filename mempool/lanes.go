@@ -0,0 +1,157 @@
+package mempool
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/cosmos/evm/mempool/miner"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// laneRankShift is the bit position of the lane rank in the priority of the
+// Cosmos transactions, the lower bits hold their gas price.
+const laneRankShift = 192
+
+var (
+	_ OrderingPolicy       = LaneOrderingPolicy{}
+	_ CosmosPriorityPolicy = LaneOrderingPolicy{}
+
+	maxLaneGasPrice = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), laneRankShift), big.NewInt(1))
+)
+
+// Lane reserves the first part of the blocks to the Cosmos transactions made of
+// some message types only, e.g. the IBC client updates and packets of the
+// relayers or the price votes of the oracles.
+type Lane struct {
+	Name string
+	// MsgTypes are the type URLs of the messages of the lane, a transaction
+	// belongs to the lane if all of its messages are of these types
+	MsgTypes []string
+	// MaxGasPercent is the share of the block gas limit the lane transactions
+	// can use, no limit if 0
+	MaxGasPercent uint64
+}
+
+// LaneOrderingPolicy selects the transactions of the lanes ahead of the other
+// ones, by order of the lanes, then orders the remaining transactions like the
+// DefaultOrderingPolicy. The lane transactions exceeding the lane gas are left
+// out of the block.
+type LaneOrderingPolicy struct {
+	Lanes []Lane
+	// MaxCosmosGasPercent is the share of the block gas limit the Cosmos
+	// transactions outside of the lanes can use, no limit if 0
+	MaxCosmosGasPercent uint64
+}
+
+// NewSelector implements OrderingPolicy.
+func (p LaneOrderingPolicy) NewSelector(_ sdk.Context, blockGasLimit uint64) TxSelector {
+	s := &laneSelector{
+		policy:       p,
+		laneGas:      make([]uint64, len(p.Lanes)),
+		laneMaxGas:   make([]uint64, len(p.Lanes)),
+		cosmosMaxGas: gasShare(blockGasLimit, p.MaxCosmosGasPercent),
+	}
+	for i, lane := range p.Lanes {
+		s.laneMaxGas[i] = gasShare(blockGasLimit, lane.MaxGasPercent)
+	}
+	return s
+}
+
+// CosmosTxPriority implements CosmosPriorityPolicy, the lane transactions come
+// first in the Cosmos pool, by order of the lanes.
+func (p LaneOrderingPolicy) CosmosTxPriority(defaultPriority sdkmempool.TxPriority[math.Int], _ func(tx sdk.Tx) time.Time) sdkmempool.TxPriority[math.Int] {
+	return sdkmempool.TxPriority[math.Int]{
+		GetTxPriority: func(ctx context.Context, tx sdk.Tx) math.Int {
+			priority := defaultPriority.GetTxPriority(ctx, tx).BigInt()
+			if priority.Cmp(maxLaneGasPrice) > 0 {
+				priority = maxLaneGasPrice
+			}
+			if lane := p.lane(tx); lane >= 0 {
+				rank := new(big.Int).Lsh(big.NewInt(int64(len(p.Lanes)-lane)), laneRankShift)
+				priority = new(big.Int).Add(rank, priority)
+			}
+			return math.NewIntFromBigInt(priority)
+		},
+		Compare:  defaultPriority.Compare,
+		MinValue: defaultPriority.MinValue,
+	}
+}
+
+// lane returns the index of the lane of the transaction, -1 if none.
+func (p LaneOrderingPolicy) lane(tx sdk.Tx) int {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return -1
+	}
+	for i, lane := range p.Lanes {
+		if laneContains(lane, msgs) {
+			return i
+		}
+	}
+	return -1
+}
+
+func laneContains(lane Lane, msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		found := false
+		for _, msgType := range lane.MsgTypes {
+			if sdk.MsgTypeURL(msg) == msgType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// gasShare returns the percent of the gas limit, no limit if 0.
+func gasShare(gasLimit, percent uint64) uint64 {
+	if percent == 0 || percent >= 100 {
+		return gasLimit
+	}
+	return new(big.Int).Div(
+		new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), new(big.Int).SetUint64(percent)),
+		big.NewInt(100),
+	).Uint64()
+}
+
+type laneSelector struct {
+	defaultSelector
+
+	policy       LaneOrderingPolicy
+	laneGas      []uint64
+	laneMaxGas   []uint64
+	cosmosGas    uint64
+	cosmosMaxGas uint64
+}
+
+func (s *laneSelector) Pick(evmTx *miner.OrderedTx, cosmosTx *CosmosTx) Selection {
+	if cosmosTx == nil {
+		return s.defaultSelector.Pick(evmTx, nil)
+	}
+
+	if lane := s.policy.lane(cosmosTx.Tx); lane >= 0 {
+		if s.laneGas[lane]+cosmosTx.Gas > s.laneMaxGas[lane] {
+			return SkipCosmos
+		}
+		s.laneGas[lane] += cosmosTx.Gas
+		return SelectCosmos
+	}
+
+	if s.cosmosGas+cosmosTx.Gas > s.cosmosMaxGas {
+		return SkipCosmos
+	}
+	selection := s.defaultSelector.Pick(evmTx, cosmosTx)
+	if selection == SelectCosmos {
+		s.cosmosGas += cosmosTx.Gas
+	}
+	return selection
+}
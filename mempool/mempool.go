@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/holiman/uint256"
 
@@ -63,6 +65,21 @@ type (
 		mtx sync.Mutex
 
 		eventBus *cmttypes.EventBus
+
+		/** Persistence **/
+		journal *txJournal
+
+		/** Private Submission **/
+		privateTxs   *privateTxs
+		bundles      map[uint64][]*Bundle // block number -> bundles
+		bundleHashes *proposedBundles     // bundles selected for the proposal by first transaction hash
+
+		/** Ordering **/
+		orderingPolicy OrderingPolicy
+		cosmosArrivals *cosmosArrivals
+
+		/** Fee Delegation **/
+		feeGranters *feeGranters
 	}
 )
 
@@ -76,6 +93,9 @@ type EVMMempoolConfig struct {
 	BroadCastTxFn    func(txs []*ethtypes.Transaction) error
 	BlockGasLimit    uint64 // Block gas limit from consensus parameters
 	MinTip           *uint256.Int
+	// OrderingPolicy decides the order of the transactions in the proposed
+	// blocks, the DefaultOrderingPolicy is used when nil
+	OrderingPolicy OrderingPolicy
 }
 
 // NewExperimentalEVMMempool creates a new unified mempool for EVM and Cosmos transactions.
@@ -120,6 +140,8 @@ func NewExperimentalEVMMempool(
 	}
 
 	legacyPool := legacypool.New(legacyConfig, blockchain)
+	privateTxs := newPrivateTxs()
+	feeGranters := newFeeGranters()
 
 	// Set up broadcast function using clientCtx
 	if config.BroadCastTxFn != nil {
@@ -130,8 +152,20 @@ func NewExperimentalEVMMempool(
 		// from queued into pending, noting their readiness to be executed.
 		legacyPool.BroadcastTxFn = func(txs []*ethtypes.Transaction) error {
 			logger.Debug("broadcasting EVM transactions", "tx_count", len(txs))
-			return broadcastEVMTransactions(clientCtx, txConfig, txs)
+			return broadcastEVMTransactions(clientCtx, txConfig, txs, feeGranters.set)
+		}
+	}
+	// The fees of the sponsored transactions are not charged to their sender
+	legacyPool.SponsoredTxFn = func(tx *ethtypes.Transaction) bool {
+		return feeGranters.has(tx.Hash())
+	}
+	// The private transactions are never broadcast
+	broadcastTxFn := legacyPool.BroadcastTxFn
+	legacyPool.BroadcastTxFn = func(txs []*ethtypes.Transaction) error {
+		if txs = privateTxs.public(txs); len(txs) == 0 {
+			return nil
 		}
+		return broadcastTxFn(txs)
 	}
 
 	txPool, err := txpool.New(uint64(0), blockchain, []txpool.SubPool{legacyPool})
@@ -146,6 +180,13 @@ func NewExperimentalEVMMempool(
 		panic("tx pool should contain only legacypool")
 	}
 
+	orderingPolicy := config.OrderingPolicy
+	if orderingPolicy == nil {
+		orderingPolicy = DefaultOrderingPolicy{}
+	}
+
+	arrivals := newCosmosArrivals(txConfig.TxEncoder())
+
 	// TODO: move this logic to evmd.createMempoolConfig and set the max tx there
 	// Create Cosmos Mempool from configuration
 	cosmosPoolConfig := config.CosmosPoolConfig
@@ -173,23 +214,38 @@ func NewExperimentalEVMMempool(
 			},
 			MinValue: math.ZeroInt(),
 		}
+		if policy, ok := orderingPolicy.(CosmosPriorityPolicy); ok {
+			defaultConfig.TxPriority = policy.CosmosTxPriority(defaultConfig.TxPriority, arrivals.get)
+		}
 		cosmosPoolConfig = &defaultConfig
 	}
 
 	cosmosPoolConfig.MaxTx = cosmosPoolMaxTx
 	cosmosPool = sdkmempool.NewPriorityMempool(*cosmosPoolConfig)
 
+	// The sponsored transactions are journaled with their fee grant
+	journal := newTxJournal(legacyConfig, logger, func(tx *ethtypes.Transaction) []byte {
+		return feeGranters.wrap(txConfig, tx)
+	})
+
 	evmMempool := &ExperimentalEVMMempool{
-		vmKeeper:      vmKeeper,
-		txPool:        txPool,
-		legacyTxPool:  txPool.Subpools[0].(*legacypool.LegacyPool),
-		cosmosPool:    cosmosPool,
-		logger:        logger,
-		txConfig:      txConfig,
-		blockchain:    blockchain,
-		blockGasLimit: config.BlockGasLimit,
-		minTip:        config.MinTip,
-		anteHandler:   config.AnteHandler,
+		vmKeeper:       vmKeeper,
+		txPool:         txPool,
+		legacyTxPool:   txPool.Subpools[0].(*legacypool.LegacyPool),
+		cosmosPool:     cosmosPool,
+		logger:         logger,
+		txConfig:       txConfig,
+		blockchain:     blockchain,
+		blockGasLimit:  config.BlockGasLimit,
+		minTip:         config.MinTip,
+		anteHandler:    config.AnteHandler,
+		journal:        journal,
+		privateTxs:     privateTxs,
+		bundles:        make(map[uint64][]*Bundle),
+		bundleHashes:   newProposedBundles(),
+		orderingPolicy: orderingPolicy,
+		cosmosArrivals: arrivals,
+		feeGranters:    feeGranters,
 	}
 
 	vmKeeper.SetEvmMempool(evmMempool)
@@ -227,21 +283,35 @@ func (m *ExperimentalEVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error
 		hash := ethMsg.Hash()
 		m.logger.Debug("inserting EVM transaction", "tx_hash", hash)
 		ethTxs := []*ethtypes.Transaction{ethMsg.AsTransaction()}
+		sponsored := m.feeGranters.add(hash, tx)
 		errs := m.txPool.Add(ethTxs, true)
 		if len(errs) > 0 && errs[0] != nil {
+			if sponsored {
+				m.feeGranters.remove(hash)
+			}
 			m.logger.Error("failed to insert EVM transaction", "error", errs[0], "tx_hash", hash)
 			return errs[0]
 		}
+		if m.journal != nil {
+			m.journal.insert(ethTxs[0])
+		}
 		m.logger.Debug("EVM transaction inserted successfully", "tx_hash", hash)
 		return nil
 	}
 
 	// Insert into cosmos pool for non-EVM transactions
 	m.logger.Debug("inserting Cosmos transaction", "error", err)
+	// the arrival is recorded first, the priority of the transaction may be
+	// derived from it
+	arrived := m.cosmosArrivals.add(tx)
 	err = m.cosmosPool.Insert(goCtx, tx)
 	if err != nil {
+		if arrived {
+			m.cosmosArrivals.remove(tx)
+		}
 		m.logger.Error("failed to insert Cosmos transaction", "error", err)
 	} else {
+		m.cosmosArrivals.inserted(tx)
 		m.logger.Debug("Cosmos transaction inserted successfully")
 	}
 	return err
@@ -269,29 +339,57 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 			continue
 		}
 	}
-	errs := m.txPool.Add(ethTxs, false)
-	if errs != nil {
-		if len(errs) != 1 {
-			return fmt.Errorf("%w, got %d", ErrExpectedOneError, len(errs))
+	var sponsored []common.Hash
+	for _, ethTx := range ethTxs {
+		if m.feeGranters.add(ethTx.Hash(), tx) {
+			sponsored = append(sponsored, ethTx.Hash())
+		}
+	}
+	if err := addError(m.txPool.Add(ethTxs, false)); err != nil {
+		for _, hash := range sponsored {
+			m.feeGranters.remove(hash)
+		}
+		return err
+	}
+	if m.journal != nil {
+		for _, ethTx := range ethTxs {
+			m.journal.insert(ethTx)
 		}
-		return errs[0]
 	}
 	return nil
 }
 
+// addError returns the error of the single transaction added to the pool.
+func addError(errs []error) error {
+	if errs == nil {
+		return nil
+	}
+	if len(errs) != 1 {
+		return fmt.Errorf("%w, got %d", ErrExpectedOneError, len(errs))
+	}
+	return errs[0]
+}
+
+// TrackLocalTx marks the sender of the EVM transaction submitted through the
+// node as a local account, so its transactions are journaled and survive node
+// restarts. It's a no-op if the journal is disabled.
+func (m *ExperimentalEVMMempool) TrackLocalTx(tx *ethtypes.Transaction) {
+	if m.journal != nil {
+		m.journal.trackLocal(tx)
+	}
+}
+
 // Select returns a unified iterator over both EVM and Cosmos transactions.
 // The iterator prioritizes transactions based on their fees and manages proper
-// sequencing. The i parameter contains transaction hashes to exclude from selection.
+// sequencing, the transactions of the bundles targeting the block come first.
+// The i parameter contains transaction hashes to exclude from selection.
 func (m *ExperimentalEVMMempool) Select(goCtx context.Context, i [][]byte) sdkmempool.Iterator {
+	m.waitPromotions()
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
-
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
-
-	return combinedIterator
+	return m.iterator(goCtx, i)
 }
 
 // CountTx returns the total number of transactions in both EVM and Cosmos pools.
@@ -335,7 +433,7 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 	}
 
 	m.logger.Debug("removing Cosmos transaction")
-	err = m.cosmosPool.Remove(tx)
+	err = m.removeCosmosTx(tx)
 	if err != nil {
 		m.logger.Error("failed to remove Cosmos transaction", "error", err)
 	} else {
@@ -381,19 +479,25 @@ func (m *ExperimentalEVMMempool) shouldRemoveFromEVMPool(tx sdk.Tx) bool {
 // It uses the same unified iterator as Select but allows early termination based on
 // custom criteria defined by the filter function.
 func (m *ExperimentalEVMMempool) SelectBy(goCtx context.Context, i [][]byte, f func(sdk.Tx) bool) {
+	m.waitPromotions()
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
-
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
 
+	combinedIterator := m.iterator(goCtx, i)
 	for combinedIterator != nil && f(combinedIterator.Tx()) {
 		combinedIterator = combinedIterator.Next()
 	}
 }
 
+// waitPromotions waits for the pool to promote the nonce gapped transactions
+// added asynchronously by InsertInvalidNonce, so that the ones whose gaps were
+// filled are selected. It must be called without holding the mempool lock, as
+// the promoted transactions are rebroadcast through CheckTx.
+func (m *ExperimentalEVMMempool) waitPromotions() {
+	m.legacyTxPool.WaitPromotions()
+}
+
 // SetEventBus sets CometBFT event bus to listen for new block header event.
 func (m *ExperimentalEVMMempool) SetEventBus(eventBus *cmttypes.EventBus) {
 	if m.HasEventBus() {
@@ -406,7 +510,13 @@ func (m *ExperimentalEVMMempool) SetEventBus(eventBus *cmttypes.EventBus) {
 	}
 	go func() {
 		for range sub.Out() {
+			// the chain head is built from the chain state once the previous
+			// header is known, the journal is replayed against it
+			replay := m.journal != nil && m.GetBlockchain().getPreviousHeaderHash() != (common.Hash{})
 			m.GetBlockchain().NotifyNewBlock()
+			if replay {
+				m.loadJournal()
+			}
 		}
 	}()
 }
@@ -425,6 +535,12 @@ func (m *ExperimentalEVMMempool) Close() error {
 		}
 	}
 
+	if m.journal != nil {
+		if err := m.journal.close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if err := m.txPool.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("failed to close txpool: %w", err))
 	}
@@ -432,6 +548,48 @@ func (m *ExperimentalEVMMempool) Close() error {
 	return errors.Join(errs...)
 }
 
+// loadJournal replays the journaled EVM transactions into the pool once its
+// state is loaded, it's a no-op once the journal is loaded.
+func (m *ExperimentalEVMMempool) loadJournal() {
+	if err := m.txPool.Sync(); err != nil {
+		m.logger.Error("failed to sync txpool before loading the journal", "error", err)
+		return
+	}
+	m.journal.load(m.insertJournaledTxs, m.Content)
+}
+
+// insertJournaledTxs inserts the journaled EVM transactions into the pool. The
+// sponsored transactions are inserted with the journaled Cosmos transactions
+// wrapping them, so they keep their fee grant.
+func (m *ExperimentalEVMMempool) insertJournaledTxs(ethTxs []*ethtypes.Transaction, sponsored map[common.Hash][]byte) []error {
+	errs := make([]error, len(ethTxs))
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	for i, ethTx := range ethTxs {
+		if txBytes, ok := sponsored[ethTx.Hash()]; ok {
+			var tx sdk.Tx
+			if tx, errs[i] = m.txConfig.TxDecoder()(txBytes); errs[i] == nil {
+				errs[i] = m.Insert(ctx, tx)
+			}
+			continue
+		}
+		msg := &evmtypes.MsgEthereumTx{}
+		msg.FromEthereumTx(ethTx)
+
+		txBuilder := m.txConfig.NewTxBuilder()
+		if errs[i] = txBuilder.SetMsgs(msg); errs[i] != nil {
+			continue
+		}
+		errs[i] = m.Insert(ctx, txBuilder.GetTx())
+	}
+	return errs
+}
+
 // getEVMMessage validates that the transaction contains exactly one message and returns it if it's an EVM message.
 // Returns an error if the transaction has no messages, multiple messages, or the single message is not an EVM transaction.
 func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereumTx, error) {
@@ -449,19 +607,97 @@ func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereum
 	return ethMsg, nil
 }
 
-// getIterators prepares iterators over pending EVM and Cosmos transactions.
-// It configures EVM transactions with proper base fee filtering and priority ordering,
-// while setting up the Cosmos iterator with the provided exclusion list.
-func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte) (*miner.TransactionsByPriceAndNonce, sdkmempool.Iterator) {
+// iterator prepares the unified iterator over the bundle, pending EVM and Cosmos
+// transactions for the block of the context. The pending EVM transactions of
+// the bundle senders are left out of the block, the bundles set their nonces.
+// The Cosmos iterator is set up with the provided exclusion list, and the
+// transactions are ordered by the ordering policy.
+func (m *ExperimentalEVMMempool) iterator(goCtx context.Context, i [][]byte) sdkmempool.Iterator {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m.logger.Debug("getting iterators")
+
+	m.expirePrivateTxs(uint64(ctx.BlockHeight())) // #nosec G115 -- block height is never negative
+	m.feeGranters.prune(m.legacyTxPool.Has)
+	bundleTxs, bundleSenders := m.selectBundles(ctx)
+
+	blockGasLimit := m.blockGasLimit
+	if params := ctx.ConsensusParams(); params.Block != nil && params.Block.MaxGas > 0 {
+		blockGasLimit = uint64(params.Block.MaxGas) // #nosec G115 -- checked to be positive
+	}
+	selector := m.orderingPolicy.NewSelector(ctx, blockGasLimit)
+
+	orderedEVMPendingTxes := m.pendingEVMTransactions(ctx, bundleSenders, selector.LessEVM)
+
+	cosmosPendingTxes := m.cosmosPool.Select(ctx, i)
+
+	combinedIterator := NewEVMMempoolIterator(orderedEVMPendingTxes, cosmosPendingTxes, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain, selector, m.cosmosArrivals.get, m.feeGranters.set)
+
+	return newBundleIterator(bundleTxs, combinedIterator)
+}
+
+// PendingEVMTransactions returns the executable EVM transactions of the pool
+// ordered by price and nonce, the way they are selected for the next block. It
+// also returns a digest of the set of transactions, which changes whenever a
+// transaction is added to or removed from it. The private transactions are left
+// out, along with the following ones of their sender.
+func (m *ExperimentalEVMMempool) PendingEVMTransactions(ctx sdk.Context) (evmtypes.PendingTxIterator, common.Hash) {
+	pending, baseFee := m.pendingEVMTxs(ctx)
+	m.privateTxs.truncatePending(pending)
+
+	var digest common.Hash
+	for _, txs := range pending {
+		for _, tx := range txs {
+			for i := range digest {
+				digest[i] ^= tx.Hash[i]
+			}
+		}
+	}
+	txs := miner.NewTransactionsByOrderAndNonce(nil, pending, baseFee, miner.LessByPriceAndTime)
+	return pendingTxIterator{txs}, digest
+}
+
+// pendingTxIterator adapts the ordered pending transactions to the iterator of
+// the pending state.
+type pendingTxIterator struct {
+	*miner.TransactionsByPriceAndNonce
+}
+
+// Peek implements evmtypes.PendingTxIterator, the transactions which can no
+// longer be resolved are left out along with the following ones of their sender.
+func (it pendingTxIterator) Peek() *ethtypes.Transaction {
+	for {
+		lazyTx, _ := it.TransactionsByPriceAndNonce.Peek()
+		if lazyTx == nil {
+			return nil
+		}
+		if tx := lazyTx.Resolve(); tx != nil {
+			return tx
+		}
+		it.Pop()
+	}
+}
+
+// pendingEVMTransactions returns the executable EVM transactions of the pool
+// ordered by the less function and nonce, leaving out the ones of the excluded
+// senders.
+func (m *ExperimentalEVMMempool) pendingEVMTransactions(ctx sdk.Context, exclude map[common.Address]struct{}, less miner.TxLess) *miner.TransactionsByPriceAndNonce {
+	pending, baseFee := m.pendingEVMTxs(ctx)
+	for addr := range exclude {
+		delete(pending, addr)
+	}
+	return miner.NewTransactionsByOrderAndNonce(nil, pending, baseFee, less)
+}
+
+// pendingEVMTxs returns the executable EVM transactions of the pool by sender,
+// along with the base fee they are filtered with.
+func (m *ExperimentalEVMMempool) pendingEVMTxs(ctx sdk.Context) (map[common.Address][]*txpool.LazyTransaction, *big.Int) {
 	baseFee := m.vmKeeper.GetBaseFee(ctx)
 	var baseFeeUint *uint256.Int
 	if baseFee != nil {
 		baseFeeUint = uint256.MustFromBig(baseFee)
 	}
 
-	m.logger.Debug("getting iterators")
-
 	pendingFilter := txpool.PendingFilter{
 		MinTip:       m.minTip,
 		BaseFee:      baseFeeUint,
@@ -469,30 +705,18 @@ func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte)
 		OnlyPlainTxs: true,
 		OnlyBlobTxs:  false,
 	}
-	evmPendingTxes := m.txPool.Pending(pendingFilter)
-	orderedEVMPendingTxes := miner.NewTransactionsByPriceAndNonce(nil, evmPendingTxes, baseFee)
-
-	cosmosPendingTxes := m.cosmosPool.Select(ctx, i)
-
-	return orderedEVMPendingTxes, cosmosPendingTxes
+	return m.txPool.Pending(pendingFilter), baseFee
 }
 
 // broadcastEVMTransactions converts Ethereum transactions to Cosmos SDK format and broadcasts them.
 // This function wraps EVM transactions in MsgEthereumTx messages and submits them to the network
 // using the provided client context. It handles encoding and error reporting for each transaction.
-func broadcastEVMTransactions(clientCtx client.Context, txConfig client.TxConfig, ethTxs []*ethtypes.Transaction) error {
+// The sponsored transactions are broadcast with the fee grant they were submitted with.
+func broadcastEVMTransactions(clientCtx client.Context, txConfig client.TxConfig, ethTxs []*ethtypes.Transaction, setFeeGrant func(common.Hash, client.TxBuilder) error) error {
 	for _, ethTx := range ethTxs {
-		msg := &evmtypes.MsgEthereumTx{}
-		msg.FromEthereumTx(ethTx)
-
-		txBuilder := txConfig.NewTxBuilder()
-		if err := txBuilder.SetMsgs(msg); err != nil {
-			return fmt.Errorf("failed to set msg in tx builder: %w", err)
-		}
-
-		txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		txBytes, err := wrapEVMTransaction(txConfig, ethTx, setFeeGrant)
 		if err != nil {
-			return fmt.Errorf("failed to encode transaction: %w", err)
+			return err
 		}
 
 		res, err := clientCtx.BroadcastTxSync(txBytes)
@@ -505,3 +729,24 @@ func broadcastEVMTransactions(clientCtx client.Context, txConfig client.TxConfig
 	}
 	return nil
 }
+
+// wrapEVMTransaction encodes the Cosmos transaction wrapping the EVM transaction,
+// along with its fee grant if it's sponsored.
+func wrapEVMTransaction(txConfig client.TxConfig, ethTx *ethtypes.Transaction, setFeeGrant func(common.Hash, client.TxBuilder) error) ([]byte, error) {
+	msg := &evmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(ethTx)
+
+	txBuilder := txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msg); err != nil {
+		return nil, fmt.Errorf("failed to set msg in tx builder: %w", err)
+	}
+	if err := setFeeGrant(ethTx.Hash(), txBuilder); err != nil {
+		return nil, fmt.Errorf("failed to set fee grant of transaction %s: %w", ethTx.Hash().Hex(), err)
+	}
+
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	return txBytes, nil
+}
@@ -27,17 +27,34 @@ import (
 	"github.com/cosmos/evm/mempool/txpool"
 )
 
-// txWithMinerFee wraps a transaction with its gas price or effective miner gasTipCap
-type txWithMinerFee struct {
-	tx   *txpool.LazyTransaction
-	from common.Address
-	fees *uint256.Int
+// OrderedTx wraps the next transaction of an account with its sender and its
+// gas price or effective miner gasTipCap
+type OrderedTx struct {
+	Tx   *txpool.LazyTransaction
+	From common.Address
+	Fee  *uint256.Int
 }
 
-// newTxWithMinerFee creates a wrapped transaction, calculating the effective
+// TxLess reports whether the next transaction of an account must be returned
+// before the one of another account.
+type TxLess func(a, b *OrderedTx) bool
+
+// LessByPriceAndTime orders the transactions by price, then by the time they
+// were first seen.
+func LessByPriceAndTime(a, b *OrderedTx) bool {
+	// If the prices are equal, use the time the transaction was first seen for
+	// deterministic sorting
+	cmp := a.Fee.Cmp(b.Fee)
+	if cmp == 0 {
+		return a.Tx.Time.Before(b.Tx.Time)
+	}
+	return cmp > 0
+}
+
+// newOrderedTx creates a wrapped transaction, calculating the effective
 // miner gasTipCap if a base fee is provided.
 // Returns error in case of a negative effective miner gasTipCap.
-func newTxWithMinerFee(tx *txpool.LazyTransaction, from common.Address, baseFee *uint256.Int) (*txWithMinerFee, error) {
+func newOrderedTx(tx *txpool.LazyTransaction, from common.Address, baseFee *uint256.Int) (*OrderedTx, error) {
 	tip := new(uint256.Int).Set(tx.GasTipCap)
 	if baseFee != nil {
 		if tx.GasFeeCap.Cmp(baseFee) < 0 {
@@ -48,39 +65,34 @@ func newTxWithMinerFee(tx *txpool.LazyTransaction, from common.Address, baseFee
 			tip = tx.GasTipCap
 		}
 	}
-	return &txWithMinerFee{
-		tx:   tx,
-		from: from,
-		fees: tip,
+	return &OrderedTx{
+		Tx:   tx,
+		From: from,
+		Fee:  tip,
 	}, nil
 }
 
-// txByPriceAndTime implements both the sort and the heap interface, making it useful
+// txHeap implements both the sort and the heap interface, making it useful
 // for all at once sorting as well as individually adding and removing elements.
-type txByPriceAndTime []*txWithMinerFee
-
-func (s txByPriceAndTime) Len() int { return len(s) }
-func (s txByPriceAndTime) Less(i, j int) bool {
-	// If the prices are equal, use the time the transaction was first seen for
-	// deterministic sorting
-	cmp := s[i].fees.Cmp(s[j].fees)
-	if cmp == 0 {
-		return s[i].tx.Time.Before(s[j].tx.Time)
-	}
-	return cmp > 0
+type txHeap struct {
+	txs  []*OrderedTx
+	less TxLess
 }
-func (s txByPriceAndTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
-func (s *txByPriceAndTime) Push(x interface{}) {
-	*s = append(*s, x.(*txWithMinerFee))
+func (s *txHeap) Len() int           { return len(s.txs) }
+func (s *txHeap) Less(i, j int) bool { return s.less(s.txs[i], s.txs[j]) }
+func (s *txHeap) Swap(i, j int)      { s.txs[i], s.txs[j] = s.txs[j], s.txs[i] }
+
+func (s *txHeap) Push(x interface{}) {
+	s.txs = append(s.txs, x.(*OrderedTx))
 }
 
-func (s *txByPriceAndTime) Pop() interface{} {
-	old := *s
+func (s *txHeap) Pop() interface{} {
+	old := s.txs
 	n := len(old)
 	x := old[n-1]
 	old[n-1] = nil
-	*s = old[0 : n-1]
+	s.txs = old[0 : n-1]
 	return x
 }
 
@@ -89,7 +101,7 @@ func (s *txByPriceAndTime) Pop() interface{} {
 // entire batches of transactions for non-executable accounts.
 type TransactionsByPriceAndNonce struct {
 	txs     map[common.Address][]*txpool.LazyTransaction // Per account nonce-sorted list of transactions
-	heads   txByPriceAndTime                             // Next transaction for each unique account (price heap)
+	heads   txHeap                                       // Next transaction for each unique account (price heap)
 	signer  types.Signer                                 // Signer for the set of transactions
 	baseFee *uint256.Int                                 // Current base fee
 }
@@ -100,20 +112,29 @@ type TransactionsByPriceAndNonce struct {
 // Note, the input map is reowned so the caller should not interact any more with
 // if after providing it to the constructor.
 func NewTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) *TransactionsByPriceAndNonce {
+	return NewTransactionsByOrderAndNonce(signer, txs, baseFee, LessByPriceAndTime)
+}
+
+// NewTransactionsByOrderAndNonce creates a transaction set that can retrieve
+// transactions sorted by the less function in a nonce-honouring way.
+//
+// Note, the input map is reowned so the caller should not interact any more with
+// if after providing it to the constructor.
+func NewTransactionsByOrderAndNonce(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int, less TxLess) *TransactionsByPriceAndNonce {
 	// Convert the basefee from header format to uint256 format
 	var baseFeeUint *uint256.Int
 	if baseFee != nil {
 		baseFeeUint = uint256.MustFromBig(baseFee)
 	}
-	// Initialize a price and received time based heap with the head transactions
-	heads := make(txByPriceAndTime, 0, len(txs))
+	// Initialize a heap ordered by the less function with the head transactions
+	heads := txHeap{txs: make([]*OrderedTx, 0, len(txs)), less: less}
 	for from, accTxs := range txs {
-		wrapped, err := newTxWithMinerFee(accTxs[0], from, baseFeeUint)
+		wrapped, err := newOrderedTx(accTxs[0], from, baseFeeUint)
 		if err != nil {
 			delete(txs, from)
 			continue
 		}
-		heads = append(heads, wrapped)
+		heads.txs = append(heads.txs, wrapped)
 		txs[from] = accTxs[1:]
 	}
 	heap.Init(&heads)
@@ -129,18 +150,27 @@ func NewTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address]
 
 // Peek returns the next transaction by price.
 func (t *TransactionsByPriceAndNonce) Peek() (*txpool.LazyTransaction, *uint256.Int) {
-	if len(t.heads) == 0 {
+	if len(t.heads.txs) == 0 {
 		return nil, nil
 	}
-	return t.heads[0].tx, t.heads[0].fees
+	return t.heads.txs[0].Tx, t.heads.txs[0].Fee
+}
+
+// Head returns the next transaction along with its sender, nil if the set is
+// empty.
+func (t *TransactionsByPriceAndNonce) Head() *OrderedTx {
+	if len(t.heads.txs) == 0 {
+		return nil
+	}
+	return t.heads.txs[0]
 }
 
 // Shift replaces the current best head with the next one from the same account.
 func (t *TransactionsByPriceAndNonce) Shift() {
-	acc := t.heads[0].from
+	acc := t.heads.txs[0].From
 	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
-		if wrapped, err := newTxWithMinerFee(txs[0], acc, t.baseFee); err == nil {
-			t.heads[0], t.txs[acc] = wrapped, txs[1:]
+		if wrapped, err := newOrderedTx(txs[0], acc, t.baseFee); err == nil {
+			t.heads.txs[0], t.txs[acc] = wrapped, txs[1:]
 			heap.Fix(&t.heads, 0)
 			return
 		}
@@ -158,10 +188,10 @@ func (t *TransactionsByPriceAndNonce) Pop() {
 // Empty returns if the price heap is empty. It can be used to check it simpler
 // than calling peek and checking for nil return.
 func (t *TransactionsByPriceAndNonce) Empty() bool {
-	return len(t.heads) == 0
+	return len(t.heads.txs) == 0
 }
 
 // Clear removes the entire content of the heap.
 func (t *TransactionsByPriceAndNonce) Clear() {
-	t.heads, t.txs = nil, nil
+	t.heads.txs, t.txs = nil, nil
 }
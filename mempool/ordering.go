@@ -0,0 +1,265 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/mempool/miner"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Selection is the decision of a TxSelector on the next transactions of the
+// EVM and Cosmos pools.
+type Selection int
+
+const (
+	// SelectEVM selects the next EVM transaction.
+	SelectEVM Selection = iota
+	// SelectCosmos selects the next Cosmos transaction.
+	SelectCosmos
+	// SkipEVM leaves the next EVM transaction out of the block, along with the
+	// following ones of its sender.
+	SkipEVM
+	// SkipCosmos leaves the next Cosmos transaction out of the block.
+	SkipCosmos
+	// SelectNone ends the selection.
+	SelectNone
+)
+
+// CosmosTx is the next Cosmos transaction of the pool.
+type CosmosTx struct {
+	Tx  sdk.Tx
+	Tip *uint256.Int // effective gas tip, zero if the fee is not in the EVM denom
+	Gas uint64
+	// Time is when the transaction entered the pool, zero if unknown
+	Time time.Time
+}
+
+// OrderingPolicy decides the order of the mempool transactions in the blocks
+// proposed by the node. The EVM transactions of an account are always selected
+// by nonce, and the Cosmos ones in the order of the Cosmos pool.
+type OrderingPolicy interface {
+	// NewSelector returns the selector of the transactions of a block.
+	NewSelector(ctx sdk.Context, blockGasLimit uint64) TxSelector
+}
+
+// TxSelector orders the transactions of a block, it may keep the state of the
+// selection.
+type TxSelector interface {
+	// LessEVM reports whether the next EVM transaction of an account is
+	// selected before the one of another account.
+	LessEVM(a, b *miner.OrderedTx) bool
+	// Pick decides on the next EVM and Cosmos transactions, nil if their pool
+	// is exhausted. They are never both nil.
+	Pick(evmTx *miner.OrderedTx, cosmosTx *CosmosTx) Selection
+}
+
+// CosmosPriorityPolicy is implemented by the ordering policies which set the
+// priority of the transactions in the Cosmos pool. It's only used if the
+// Cosmos pool config is not set.
+type CosmosPriorityPolicy interface {
+	// CosmosTxPriority returns the priority of the Cosmos pool given its
+	// default one, ordering the transactions by gas price, and the time the
+	// transactions entered the pool.
+	CosmosTxPriority(defaultPriority sdkmempool.TxPriority[math.Int], arrival func(tx sdk.Tx) time.Time) sdkmempool.TxPriority[math.Int]
+}
+
+var (
+	_ OrderingPolicy       = DefaultOrderingPolicy{}
+	_ OrderingPolicy       = FIFOOrderingPolicy{}
+	_ CosmosPriorityPolicy = FIFOOrderingPolicy{}
+)
+
+// DefaultOrderingPolicy orders the EVM transactions by effective tip then by
+// arrival time, and merges them with the Cosmos transactions by effective tip.
+// EVM transactions are preferred on ties and over the Cosmos transactions
+// without a fee in the EVM denom.
+type DefaultOrderingPolicy struct{}
+
+// NewSelector implements OrderingPolicy.
+func (DefaultOrderingPolicy) NewSelector(sdk.Context, uint64) TxSelector {
+	return defaultSelector{}
+}
+
+type defaultSelector struct{}
+
+func (defaultSelector) LessEVM(a, b *miner.OrderedTx) bool {
+	return miner.LessByPriceAndTime(a, b)
+}
+
+func (defaultSelector) Pick(evmTx *miner.OrderedTx, cosmosTx *CosmosTx) Selection {
+	switch {
+	case evmTx == nil:
+		return SelectCosmos
+	case cosmosTx == nil || cosmosTx.Tip.IsZero():
+		return SelectEVM
+	case cosmosTx.Tip.Gt(evmTx.Fee):
+		return SelectCosmos
+	default:
+		return SelectEVM
+	}
+}
+
+// FIFOOrderingPolicy orders the transactions by the time they entered the
+// pool, regardless of their fees. The Cosmos pool is ordered by arrival too.
+type FIFOOrderingPolicy struct{}
+
+// NewSelector implements OrderingPolicy.
+func (FIFOOrderingPolicy) NewSelector(sdk.Context, uint64) TxSelector {
+	return fifoSelector{}
+}
+
+// fifoMinPriority is the priority of the Cosmos transactions of unknown arrival.
+var fifoMinPriority = math.NewInt(-1 << 63)
+
+// CosmosTxPriority implements CosmosPriorityPolicy, the earlier transactions
+// get the higher priorities. The priority of a transaction is derived from its
+// arrival so it doesn't change if it's computed again, the transactions of
+// unknown arrival get the lowest priority.
+func (FIFOOrderingPolicy) CosmosTxPriority(defaultPriority sdkmempool.TxPriority[math.Int], arrival func(tx sdk.Tx) time.Time) sdkmempool.TxPriority[math.Int] {
+	return sdkmempool.TxPriority[math.Int]{
+		GetTxPriority: func(_ context.Context, tx sdk.Tx) math.Int {
+			arrived := arrival(tx)
+			if arrived.IsZero() {
+				// the transactions of unknown arrival come last, the pool breaks
+				// the ties by sender and nonce
+				return fifoMinPriority
+			}
+			return math.NewInt(arrived.UnixNano()).Neg()
+		},
+		Compare:  defaultPriority.Compare,
+		MinValue: fifoMinPriority,
+	}
+}
+
+type fifoSelector struct{}
+
+func (fifoSelector) LessEVM(a, b *miner.OrderedTx) bool {
+	if a.Tx.Time.Equal(b.Tx.Time) {
+		return miner.LessByPriceAndTime(a, b)
+	}
+	return a.Tx.Time.Before(b.Tx.Time)
+}
+
+func (fifoSelector) Pick(evmTx *miner.OrderedTx, cosmosTx *CosmosTx) Selection {
+	switch {
+	case evmTx == nil:
+		return SelectCosmos
+	case cosmosTx == nil || cosmosTx.Time.IsZero():
+		return SelectEVM
+	case cosmosTx.Time.Before(evmTx.Tx.Time):
+		return SelectCosmos
+	default:
+		return SelectEVM
+	}
+}
+
+// cosmosArrivals records the time the Cosmos transactions entered the pool,
+// keyed by their hash. It also tracks the transaction held by each slot of the
+// pool, i.e. first signer and nonce, to forget the replaced transactions.
+type cosmosArrivals struct {
+	mu      sync.RWMutex
+	times   map[string]time.Time
+	slots   map[string]string
+	encoder sdk.TxEncoder
+	signers sdkmempool.SignerExtractionAdapter
+}
+
+func newCosmosArrivals(encoder sdk.TxEncoder) *cosmosArrivals {
+	return &cosmosArrivals{
+		times:   make(map[string]time.Time),
+		slots:   make(map[string]string),
+		encoder: encoder,
+		signers: sdkmempool.NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+func (a *cosmosArrivals) key(tx sdk.Tx) (string, bool) {
+	bz, err := a.encoder(tx)
+	if err != nil {
+		return "", false
+	}
+	return string(cmttypes.Tx(bz).Hash()), true
+}
+
+// slot returns the slot of the transaction in the Cosmos pool, which is keyed
+// by the first signer and the nonce, or the timeout of the unordered ones.
+func (a *cosmosArrivals) slot(tx sdk.Tx) (string, bool) {
+	signers, err := a.signers.GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		return "", false
+	}
+	nonce, err := sdkmempool.ChooseNonce(signers[0].Sequence, tx)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%x/%d", signers[0].Signer.Bytes(), nonce), true
+}
+
+// add records the arrival of the transaction, unless it arrived before.
+// Returns true if the arrival was recorded.
+func (a *cosmosArrivals) add(tx sdk.Tx) bool {
+	key, ok := a.key(tx)
+	if !ok {
+		return false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, found := a.times[key]; found {
+		return false
+	}
+	a.times[key] = time.Now()
+	return true
+}
+
+// inserted records the transaction as the one of its pool slot once inserted,
+// forgetting the arrival of the transaction it replaced.
+func (a *cosmosArrivals) inserted(tx sdk.Tx) {
+	key, ok := a.key(tx)
+	if !ok {
+		return
+	}
+	slot, ok := a.slot(tx)
+	if !ok {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if replaced, found := a.slots[slot]; found && replaced != key {
+		delete(a.times, replaced)
+	}
+	a.slots[slot] = key
+}
+
+func (a *cosmosArrivals) remove(tx sdk.Tx) {
+	key, ok := a.key(tx)
+	if !ok {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.times, key)
+	if slot, ok := a.slot(tx); ok && a.slots[slot] == key {
+		delete(a.slots, slot)
+	}
+}
+
+// get returns the arrival time of the transaction, zero if unknown.
+func (a *cosmosArrivals) get(tx sdk.Tx) time.Time {
+	key, ok := a.key(tx)
+	if !ok {
+		return time.Time{}
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.times[key]
+}
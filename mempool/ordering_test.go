@@ -0,0 +1,269 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/evm/mempool/miner"
+	"github.com/cosmos/evm/mempool/txpool"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestDefaultOrderingPolicy(t *testing.T) {
+	now := time.Now()
+	selector := DefaultOrderingPolicy{}.NewSelector(sdk.Context{}, 100_000)
+
+	cheap, expensive := orderedTx(1, now), orderedTx(2, now.Add(time.Second))
+	require.True(t, selector.LessEVM(expensive, cheap))
+	require.False(t, selector.LessEVM(cheap, expensive))
+	require.True(t, selector.LessEVM(cheap, orderedTx(1, now.Add(time.Second))), "earlier transactions come first on ties")
+
+	require.Equal(t, SelectCosmos, selector.Pick(nil, cosmosTx(1, 0, now)))
+	require.Equal(t, SelectEVM, selector.Pick(cheap, nil))
+	require.Equal(t, SelectEVM, selector.Pick(cheap, cosmosTx(0, 0, now)), "EVM transactions are preferred over the ones without tip")
+	require.Equal(t, SelectEVM, selector.Pick(cheap, cosmosTx(1, 0, now)), "EVM transactions are preferred on ties")
+	require.Equal(t, SelectCosmos, selector.Pick(cheap, cosmosTx(2, 0, now)))
+}
+
+func TestFIFOOrderingPolicy(t *testing.T) {
+	now := time.Now()
+	policy := FIFOOrderingPolicy{}
+	selector := policy.NewSelector(sdk.Context{}, 100_000)
+
+	early, late := orderedTx(1, now), orderedTx(2, now.Add(time.Second))
+	require.True(t, selector.LessEVM(early, late))
+	require.False(t, selector.LessEVM(late, early))
+	require.True(t, selector.LessEVM(orderedTx(2, now), early), "higher tips come first on ties")
+
+	require.Equal(t, SelectCosmos, selector.Pick(late, cosmosTx(0, 0, now)))
+	require.Equal(t, SelectEVM, selector.Pick(early, cosmosTx(10, 0, now.Add(time.Second))))
+	require.Equal(t, SelectEVM, selector.Pick(early, cosmosTx(10, 0, time.Time{})), "EVM transactions are preferred when the arrival is unknown")
+
+	// the earlier Cosmos transactions get the higher priorities, derived from
+	// their arrival
+	firstTx, secondTx := msgsTx(), msgsTx(&banktypes.MsgSend{})
+	arrivals := map[int]time.Time{1: now, 2: now.Add(time.Second)}
+	priority := policy.CosmosTxPriority(sdkmempool.TxPriority[math.Int]{
+		Compare: func(a, b math.Int) int { return a.BigInt().Cmp(b.BigInt()) },
+	}, func(tx sdk.Tx) time.Time { return arrivals[len(tx.GetMsgs())+1] })
+	first := priority.GetTxPriority(context.Background(), firstTx)
+	second := priority.GetTxPriority(context.Background(), secondTx)
+	require.Equal(t, 1, priority.Compare(first, second))
+	require.Equal(t, -1, priority.Compare(priority.MinValue, second))
+	require.Equal(t, first, priority.GetTxPriority(context.Background(), firstTx), "the priority doesn't change over time")
+
+	// the transactions of unknown arrival come last, with a stable priority
+	unknown := priority.GetTxPriority(context.Background(), msgsTx(&banktypes.MsgSend{}, &banktypes.MsgSend{}))
+	require.Equal(t, priority.MinValue, unknown)
+}
+
+func TestLaneOrderingPolicy(t *testing.T) {
+	now := time.Now()
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	voteType := sdk.MsgTypeURL(&govtypes.MsgVote{})
+	policy := LaneOrderingPolicy{
+		Lanes: []Lane{
+			{Name: "votes", MsgTypes: []string{voteType}, MaxGasPercent: 10},
+			{Name: "sends", MsgTypes: []string{sendType}},
+		},
+		MaxCosmosGasPercent: 20,
+	}
+
+	voteTx := msgsTx(&govtypes.MsgVote{})
+	sendTx := msgsTx(&banktypes.MsgSend{}, &banktypes.MsgSend{})
+	otherTx := msgsTx(&banktypes.MsgSend{}, &govtypes.MsgVote{})
+	require.Equal(t, 0, policy.lane(voteTx))
+	require.Equal(t, 1, policy.lane(sendTx))
+	require.Equal(t, -1, policy.lane(otherTx))
+	require.Equal(t, -1, policy.lane(msgsTx()))
+
+	// the lane transactions come first in the Cosmos pool, by order of the lanes
+	priority := policy.CosmosTxPriority(sdkmempool.TxPriority[math.Int]{
+		GetTxPriority: func(context.Context, sdk.Tx) math.Int { return math.NewInt(1000) },
+		Compare:       func(a, b math.Int) int { return a.BigInt().Cmp(b.BigInt()) },
+		MinValue:      math.ZeroInt(),
+	}, nil)
+	votePriority := priority.GetTxPriority(context.Background(), voteTx)
+	sendPriority := priority.GetTxPriority(context.Background(), sendTx)
+	otherPriority := priority.GetTxPriority(context.Background(), otherTx)
+	require.Equal(t, 1, priority.Compare(votePriority, sendPriority))
+	require.Equal(t, 1, priority.Compare(sendPriority, otherPriority))
+	require.Equal(t, math.NewInt(1000), otherPriority)
+
+	// the lane transactions are selected ahead of the EVM ones within the lane gas
+	selector := policy.NewSelector(sdk.Context{}, 100_000)
+	evmTx := orderedTx(100, now)
+	require.Equal(t, SelectCosmos, selector.Pick(evmTx, &CosmosTx{Tx: voteTx, Tip: uint256.NewInt(0), Gas: 6_000}))
+	require.Equal(t, SkipCosmos, selector.Pick(evmTx, &CosmosTx{Tx: voteTx, Tip: uint256.NewInt(0), Gas: 6_000}))
+	require.Equal(t, SelectCosmos, selector.Pick(evmTx, &CosmosTx{Tx: voteTx, Tip: uint256.NewInt(0), Gas: 4_000}))
+	require.Equal(t, SelectCosmos, selector.Pick(evmTx, &CosmosTx{Tx: sendTx, Tip: uint256.NewInt(0), Gas: 90_000}))
+
+	// the other Cosmos transactions are limited to their share of the block gas
+	require.Equal(t, SelectEVM, selector.Pick(evmTx, &CosmosTx{Tx: otherTx, Tip: uint256.NewInt(1), Gas: 15_000}))
+	require.Equal(t, SelectCosmos, selector.Pick(evmTx, &CosmosTx{Tx: otherTx, Tip: uint256.NewInt(200), Gas: 15_000}))
+	require.Equal(t, SkipCosmos, selector.Pick(evmTx, &CosmosTx{Tx: otherTx, Tip: uint256.NewInt(200), Gas: 15_000}))
+	require.Equal(t, SkipCosmos, selector.Pick(nil, &CosmosTx{Tx: otherTx, Tip: uint256.NewInt(200), Gas: 15_000}))
+	require.Equal(t, SelectCosmos, selector.Pick(nil, &CosmosTx{Tx: otherTx, Tip: uint256.NewInt(200), Gas: 5_000}))
+	require.Equal(t, SelectEVM, selector.Pick(evmTx, nil))
+}
+
+func TestGasShare(t *testing.T) {
+	require.Equal(t, uint64(1000), gasShare(1000, 0))
+	require.Equal(t, uint64(250), gasShare(1000, 25))
+	require.Equal(t, uint64(1000), gasShare(1000, 150))
+	require.Equal(t, uint64(1<<63-1), gasShare(1<<64-1, 50))
+}
+
+func TestCosmosArrivals(t *testing.T) {
+	alice := secp256k1.GenPrivKey().PubKey()
+	first := &signedTx{pubKey: alice, sequence: 1}
+	replacement := &signedTx{pubKey: alice, sequence: 1, msgTx: msgTx{&banktypes.MsgSend{}}}
+	unordered := &signedTx{pubKey: alice, unordered: true, timeout: time.Unix(100, 0)}
+	otherUnordered := &signedTx{pubKey: alice, unordered: true, timeout: time.Unix(200, 0)}
+	arrivals := newCosmosArrivals(encodeTx)
+
+	require.True(t, arrivals.get(first).IsZero())
+	require.True(t, arrivals.add(first))
+	require.False(t, arrivals.add(first), "the first arrival is kept")
+	arrivals.inserted(first)
+	require.False(t, arrivals.get(first).IsZero())
+
+	// the unordered transactions of a signer are tracked separately
+	require.True(t, arrivals.add(unordered))
+	arrivals.inserted(unordered)
+	require.True(t, arrivals.add(otherUnordered))
+	arrivals.inserted(otherUnordered)
+	require.False(t, arrivals.get(unordered).IsZero())
+	require.False(t, arrivals.get(otherUnordered).IsZero())
+
+	// the replaced transaction is forgotten
+	require.True(t, arrivals.add(replacement))
+	require.False(t, arrivals.get(first).IsZero(), "the transaction is only replaced once inserted")
+	arrivals.inserted(replacement)
+	require.True(t, arrivals.get(first).IsZero())
+	require.False(t, arrivals.get(replacement).IsZero())
+
+	for _, tx := range []sdk.Tx{replacement, unordered, otherUnordered} {
+		arrivals.remove(tx)
+		require.True(t, arrivals.get(tx).IsZero())
+	}
+	require.Empty(t, arrivals.times)
+	require.Empty(t, arrivals.slots)
+}
+
+func TestIteratorSkipCosmos(t *testing.T) {
+	alice, bob := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	skipped := &signedTx{pubKey: alice, sequence: 1}
+	following := &signedTx{pubKey: alice, sequence: 2}
+	other := &signedTx{pubKey: bob, sequence: 1}
+	unordered := &signedTx{pubKey: alice, unordered: true}
+
+	cosmosTxs := &txsIterator{txs: []sdk.Tx{skipped, following, other, unordered}}
+	selector := skipSelector{skip: skipped}
+	iterator := NewEVMMempoolIterator(nil, cosmosTxs, log.NewNopLogger(), nil, "", nil, nil, selector, nil, nil)
+
+	// only the transactions following the skipped one of its signer are left out
+	var selected []sdk.Tx
+	for ; iterator != nil; iterator = iterator.Next() {
+		selected = append(selected, iterator.Tx())
+	}
+	require.Equal(t, []sdk.Tx{other, unordered}, selected)
+}
+
+func orderedTx(fee uint64, arrival time.Time) *miner.OrderedTx {
+	return &miner.OrderedTx{
+		Tx:  &txpool.LazyTransaction{Time: arrival},
+		Fee: uint256.NewInt(fee),
+	}
+}
+
+func cosmosTx(tip uint64, gas uint64, arrival time.Time) *CosmosTx {
+	return &CosmosTx{Tx: msgsTx(), Tip: uint256.NewInt(tip), Gas: gas, Time: arrival}
+}
+
+func msgsTx(msgs ...sdk.Msg) sdk.Tx {
+	return msgTx(msgs)
+}
+
+// msgTx is a transaction made of the messages only
+type msgTx []sdk.Msg
+
+func (tx msgTx) GetMsgs() []sdk.Msg { return tx }
+
+func (tx msgTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+// signedTx is a transaction signed by a single account
+type signedTx struct {
+	msgTx
+	pubKey    cryptotypes.PubKey
+	sequence  uint64
+	unordered bool
+	timeout   time.Time
+}
+
+func (tx *signedTx) GetSigners() ([][]byte, error) { return [][]byte{tx.pubKey.Address()}, nil }
+
+func (tx *signedTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{tx.pubKey}, nil
+}
+
+func (tx *signedTx) GetSignaturesV2() ([]signingtypes.SignatureV2, error) {
+	return []signingtypes.SignatureV2{{PubKey: tx.pubKey, Sequence: tx.sequence}}, nil
+}
+
+func (tx *signedTx) GetTimeoutTimeStamp() time.Time { return tx.timeout }
+
+func (tx *signedTx) GetUnordered() bool { return tx.unordered }
+
+// encodeTx encodes the transactions of the tests by identity
+func encodeTx(tx sdk.Tx) ([]byte, error) {
+	return fmt.Appendf(nil, "%p", tx), nil
+}
+
+// txsIterator iterates over the transactions in order
+type txsIterator struct {
+	txs []sdk.Tx
+}
+
+func (i *txsIterator) Next() sdkmempool.Iterator {
+	if len(i.txs) <= 1 {
+		return nil
+	}
+	return &txsIterator{txs: i.txs[1:]}
+}
+
+func (i *txsIterator) Tx() sdk.Tx {
+	if len(i.txs) == 0 {
+		return nil
+	}
+	return i.txs[0]
+}
+
+// skipSelector selects the Cosmos transactions except the skipped one
+type skipSelector struct {
+	defaultSelector
+	skip sdk.Tx
+}
+
+func (s skipSelector) Pick(_ *miner.OrderedTx, cosmosTx *CosmosTx) Selection {
+	if cosmosTx.Tx == s.skip {
+		return SkipCosmos
+	}
+	return SelectCosmos
+}
@@ -0,0 +1,247 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultPrivateTxBlocks is the number of blocks a private transaction is
+	// kept in the pool for when no max block number is set
+	DefaultPrivateTxBlocks = 25
+	// MaxPrivateBlocks is the maximum number of blocks ahead of the chain head
+	// the private transactions and the bundles can target
+	MaxPrivateBlocks = 100
+)
+
+// privateTxs tracks the EVM transactions submitted privately. They are kept
+// in the pool but never broadcast to the peers, and are dropped once their max
+// block number is reached.
+type privateTxs struct {
+	mu  sync.RWMutex
+	txs map[common.Hash]uint64 // tx hash -> max block number
+}
+
+func newPrivateTxs() *privateTxs {
+	return &privateTxs{txs: make(map[common.Hash]uint64)}
+}
+
+func (p *privateTxs) add(hash common.Hash, maxBlockNumber uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.txs[hash] = maxBlockNumber
+}
+
+func (p *privateTxs) remove(hash common.Hash) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.txs, hash)
+}
+
+func (p *privateTxs) has(hash common.Hash) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.txs[hash]
+	return ok
+}
+
+// public returns the transactions which are not private.
+func (p *privateTxs) public(txs []*ethtypes.Transaction) []*ethtypes.Transaction {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.txs) == 0 {
+		return txs
+	}
+	public := make([]*ethtypes.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if _, ok := p.txs[tx.Hash()]; !ok {
+			public = append(public, tx)
+		}
+	}
+	return public
+}
+
+// truncatePending leaves the private transactions out of the pending ones,
+// along with the following transactions of their sender, which can't be
+// executed without them.
+func (p *privateTxs) truncatePending(pending map[common.Address][]*txpool.LazyTransaction) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.txs) == 0 {
+		return
+	}
+	for addr, txs := range pending {
+		for i, tx := range txs {
+			if _, ok := p.txs[tx.Hash]; !ok {
+				continue
+			}
+			if i == 0 {
+				delete(pending, addr)
+			} else {
+				pending[addr] = txs[:i]
+			}
+			break
+		}
+	}
+}
+
+// expire stops tracking the transactions that can't be included from the
+// block number on, or that are no longer in the pool, and returns the hashes
+// of the expired ones.
+func (p *privateTxs) expire(blockNumber uint64, inPool func(common.Hash) bool) []common.Hash {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var expired []common.Hash
+	for hash, maxBlockNumber := range p.txs {
+		if !inPool(hash) {
+			delete(p.txs, hash)
+			continue
+		}
+		if maxBlockNumber < blockNumber {
+			delete(p.txs, hash)
+			expired = append(expired, hash)
+		}
+	}
+	return expired
+}
+
+// InsertPrivate adds an EVM transaction to the pool without broadcasting it to
+// the peers, so it's only included in the blocks proposed by the node. The
+// transaction is dropped if it's not included by the max block number, which
+// defaults to DefaultPrivateTxBlocks blocks ahead of the chain head when 0.
+// Private transactions are not journaled.
+func (m *ExperimentalEVMMempool) InsertPrivate(tx *ethtypes.Transaction, maxBlockNumber uint64) error {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return err
+	}
+	height := uint64(ctx.BlockHeight()) // #nosec G115 -- block height is never negative
+	if maxBlockNumber == 0 {
+		maxBlockNumber = height + DefaultPrivateTxBlocks
+	}
+	if maxBlockNumber <= height || maxBlockNumber > height+MaxPrivateBlocks {
+		return fmt.Errorf("%w: max block number %d, chain head %d", ErrInvalidBlockNumber, maxBlockNumber, height)
+	}
+
+	sdkTx, err := m.buildSDKTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if m.anteHandler != nil {
+		// the transaction is checked the way CheckTx does, but simulated so
+		// the pending transaction listeners don't announce it
+		cacheCtx, _ := ctx.CacheContext()
+		if cacheCtx.ConsensusParams().Block == nil {
+			// the query context has no consensus params, the ante handler
+			// needs the block gas limit
+			cacheCtx = cacheCtx.WithConsensusParams(cmtproto.ConsensusParams{
+				Block: &cmtproto.BlockParams{MaxGas: int64(m.blockGasLimit)}, // #nosec G115 -- the block gas limit comes from the int64 consensus max gas
+			})
+		}
+		if _, err := m.anteHandler(cacheCtx.WithIsCheckTx(true), sdkTx, true); err != nil && !errors.Is(err, ErrNonceGap) {
+			return err
+		}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.expirePrivateTxs(height + 1)
+
+	hash := tx.Hash()
+	m.privateTxs.add(hash, maxBlockNumber)
+	errs := m.txPool.Add([]*ethtypes.Transaction{tx}, true)
+	if len(errs) > 0 && errs[0] != nil {
+		m.privateTxs.remove(hash)
+		m.logger.Debug("failed to insert private EVM transaction", "error", errs[0], "tx_hash", hash)
+		return errs[0]
+	}
+	m.logger.Debug("private EVM transaction inserted", "tx_hash", hash, "max_block_number", maxBlockNumber)
+	return nil
+}
+
+// expirePrivateTxs removes from the pool the private transactions that can't
+// be included from the block number on.
+func (m *ExperimentalEVMMempool) expirePrivateTxs(blockNumber uint64) {
+	for _, hash := range m.privateTxs.expire(blockNumber, m.legacyTxPool.Has) {
+		m.logger.Debug("removing expired private EVM transaction", "tx_hash", hash)
+		m.legacyTxPool.RemoveTx(hash, false, true)
+	}
+}
+
+// SubscribeTransactions subscribes to the EVM transactions entering the pending
+// state of the pool, without the private transactions.
+func (m *ExperimentalEVMMempool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription {
+	txsCh := make(chan core.NewTxsEvent, cap(ch))
+	sub := m.txPool.SubscribeTransactions(txsCh, reorgs)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-txsCh:
+				txs := m.privateTxs.public(ev.Txs)
+				if len(txs) == 0 {
+					continue
+				}
+				select {
+				case ch <- core.NewTxsEvent{Txs: txs}:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
+
+// Content returns the pending and queued EVM transactions of the pool grouped
+// by account, without the private transactions.
+func (m *ExperimentalEVMMempool) Content() (map[common.Address][]*ethtypes.Transaction, map[common.Address][]*ethtypes.Transaction) {
+	pending, queued := m.txPool.Content()
+	for _, content := range []map[common.Address][]*ethtypes.Transaction{pending, queued} {
+		for addr, txs := range content {
+			if public := m.privateTxs.public(txs); len(public) > 0 {
+				content[addr] = public
+			} else {
+				delete(content, addr)
+			}
+		}
+	}
+	return pending, queued
+}
+
+// ContentFrom returns the pending and queued EVM transactions of the account,
+// without the private transactions.
+func (m *ExperimentalEVMMempool) ContentFrom(addr common.Address) ([]*ethtypes.Transaction, []*ethtypes.Transaction) {
+	pending, queued := m.txPool.ContentFrom(addr)
+	return m.privateTxs.public(pending), m.privateTxs.public(queued)
+}
+
+// buildSDKTx wraps the signed EVM transaction into a Cosmos transaction.
+func (m *ExperimentalEVMMempool) buildSDKTx(ctx sdk.Context, tx *ethtypes.Transaction) (sdk.Tx, error) {
+	msg := &evmtypes.MsgEthereumTx{}
+	if err := msg.FromSignedEthereumTx(tx, ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)); err != nil {
+		return nil, fmt.Errorf("failed to convert signed Ethereum transaction %s: %w", tx.Hash(), err)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid Ethereum transaction %s: %w", tx.Hash(), err)
+	}
+	return msg.BuildTx(m.txConfig.NewTxBuilder(), m.vmKeeper.GetEvmCoinInfo(ctx).Denom)
+}
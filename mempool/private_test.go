@@ -0,0 +1,117 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPrivateTxs(t *testing.T) {
+	txs := make([]*ethtypes.Transaction, 3)
+	for i := range txs {
+		txs[i] = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: uint64(i), Gas: 21000})
+	}
+
+	p := newPrivateTxs()
+	require.Equal(t, txs, p.public(txs))
+
+	p.add(txs[0].Hash(), 10)
+	p.add(txs[1].Hash(), 20)
+	p.add(txs[2].Hash(), 20)
+	require.Empty(t, p.public(txs))
+	p.remove(txs[2].Hash())
+	require.Equal(t, txs[2:], p.public(txs))
+
+	// the transactions which left the pool are no longer tracked
+	inPool := map[common.Hash]bool{txs[0].Hash(): true}
+	has := func(hash common.Hash) bool { return inPool[hash] }
+	require.Empty(t, p.expire(10, has))
+	require.Equal(t, txs[1:], p.public(txs))
+	require.Equal(t, []common.Hash{txs[0].Hash()}, p.expire(11, has))
+	require.Equal(t, txs, p.public(txs))
+}
+
+func TestBundle(t *testing.T) {
+	tx1 := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, Gas: 21000})
+	tx2 := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 2, Gas: 50000})
+
+	bundle := &Bundle{Txs: []*ethtypes.Transaction{tx1, tx2}}
+	require.Equal(t, uint64(71000), bundle.Gas())
+	require.Equal(t, crypto.Keccak256Hash(tx1.Hash().Bytes(), tx2.Hash().Bytes()), bundle.Hash())
+	require.NotEqual(t, bundle.Hash(), (&Bundle{Txs: []*ethtypes.Transaction{tx2, tx1}}).Hash())
+}
+
+func TestBundleIterator(t *testing.T) {
+	require.Nil(t, newBundleIterator(nil, nil))
+
+	txs := []sdk.Tx{&mockTx{id: 1}, &mockTx{id: 2}}
+	var got []sdk.Tx
+	for it := newBundleIterator(txs, nil); it != nil; it = it.Next() {
+		got = append(got, it.Tx())
+	}
+	require.Equal(t, txs, got)
+}
+
+func TestTxSelector(t *testing.T) {
+	txs := make([]*ethtypes.Transaction, 3)
+	memTxs := make([]sdk.Tx, 3)
+	for i := range txs {
+		txs[i] = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: uint64(i), Gas: 21000})
+		msg := &evmtypes.MsgEthereumTx{}
+		msg.FromEthereumTx(txs[i])
+		memTxs[i] = &mockEVMTx{msg: msg}
+	}
+	txBz := make([]byte, 100)
+	txBytes, txGas := proposalTxSize(memTxs[0], txBz)
+	require.Equal(t, uint64(21000), txGas)
+	ctx := sdk.Context{}.WithBlockHeight(10)
+
+	bundles := newProposedBundles()
+	bundles.set(10, []proposedBundle{{
+		hashes:  []common.Hash{txs[0].Hash(), txs[1].Hash()},
+		txBytes: 2 * txBytes,
+		txGas:   2 * txGas,
+	}})
+	selector := &txSelector{bundles: bundles}
+	selectTxs := func(maxTxBytes, maxBlockGas uint64, memTxs ...sdk.Tx) int {
+		defer selector.Clear()
+		for _, memTx := range memTxs {
+			if selector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz) {
+				break
+			}
+		}
+		return len(selector.SelectedTxs(ctx))
+	}
+
+	// the bundle is selected as a whole
+	require.Equal(t, 2, selectTxs(2*txBytes, 0, memTxs...))
+	require.Equal(t, 3, selectTxs(3*txBytes, 0, memTxs...))
+	// the bundle is left out if one of its transactions doesn't fit
+	require.Equal(t, 1, selectTxs(2*txBytes-1, 0, memTxs...))
+	require.Equal(t, 1, selectTxs(3*txBytes, 2*txGas-1, memTxs...))
+
+	// the bundles of other blocks are selected as any transaction
+	bundles.set(11, []proposedBundle{{hashes: []common.Hash{txs[0].Hash(), txs[1].Hash()}}})
+	require.Equal(t, 1, selectTxs(2*txBytes-1, 0, memTxs[:2]...))
+}
+
+type mockEVMTx struct {
+	sdk.Tx
+	msg *evmtypes.MsgEthereumTx
+}
+
+func (tx *mockEVMTx) GetMsgs() []sdk.Msg { return []sdk.Msg{tx.msg} }
+
+func (tx *mockEVMTx) GetGas() uint64 { return tx.msg.GetGas() }
+
+type mockTx struct {
+	sdk.Tx
+	id int
+}
@@ -0,0 +1,113 @@
+package mempool
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// feeGrant is the fee granter of a sponsored EVM transaction along with its
+// signature of the transaction, which are set on the wrapping Cosmos tx.
+type feeGrant struct {
+	granter   sdk.AccAddress
+	signature signing.SignatureV2
+}
+
+// feeGranters tracks the fee grants of the sponsored EVM transactions of the
+// pool. The grant is set on the Cosmos transaction wrapping the EVM one, it's
+// kept so the transaction is still sponsored once selected for a block or
+// broadcast to the peers.
+type feeGranters struct {
+	mu     sync.RWMutex
+	grants map[common.Hash]feeGrant
+}
+
+func newFeeGranters() *feeGranters {
+	return &feeGranters{grants: make(map[common.Hash]feeGrant)}
+}
+
+// add records the fee grant of the transaction wrapped by the Cosmos
+// transaction, if any. The grant has been verified by the ante handler, it's
+// recorded before the transaction is added to the pool so the sender balance
+// isn't checked against the fees. The grant recorded first is kept, returns
+// true if the grant was recorded.
+func (g *feeGranters) add(hash common.Hash, tx sdk.Tx) bool {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) == 0 {
+		return false
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(sigs) != 1 {
+		return false
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, found := g.grants[hash]; found {
+		return false
+	}
+	g.grants[hash] = feeGrant{granter: feeTx.FeeGranter(), signature: sigs[0]}
+	return true
+}
+
+// has returns true if the fees of the transaction are paid by a fee granter.
+func (g *feeGranters) has(hash common.Hash) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	_, ok := g.grants[hash]
+	return ok
+}
+
+// remove stops tracking the fee grant of the transaction.
+func (g *feeGranters) remove(hash common.Hash) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.grants, hash)
+}
+
+// set sets the fee grant of the transaction on the Cosmos transaction wrapping
+// it, it's a no-op if the transaction is not sponsored.
+func (g *feeGranters) set(hash common.Hash, txBuilder client.TxBuilder) error {
+	g.mu.RLock()
+	grant, ok := g.grants[hash]
+	g.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	return evmtypes.SetFeeGrant(txBuilder, grant.granter, grant.signature)
+}
+
+// wrap returns the encoded Cosmos transaction wrapping the transaction with its
+// fee grant, nil if the transaction is not sponsored.
+func (g *feeGranters) wrap(txConfig client.TxConfig, ethTx *ethtypes.Transaction) []byte {
+	if !g.has(ethTx.Hash()) {
+		return nil
+	}
+	txBytes, err := wrapEVMTransaction(txConfig, ethTx, g.set)
+	if err != nil {
+		return nil
+	}
+	return txBytes
+}
+
+// prune stops tracking the transactions that are no longer in the pool.
+func (g *feeGranters) prune(inPool func(common.Hash) bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for hash := range g.grants {
+		if !inPool(hash) {
+			delete(g.grants, hash)
+		}
+	}
+}
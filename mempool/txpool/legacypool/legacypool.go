@@ -137,10 +137,11 @@ type BlockChain interface {
 
 // Config are the configuration parameters of the transaction pool.
 type Config struct {
-	Locals    []common.Address // Addresses that should be treated by default as local
-	NoLocals  bool             // Whether local transaction handling should be disabled
-	Journal   string           // Journal of local transactions to survive node restarts
-	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
+	Locals         []common.Address // Addresses that should be treated by default as local
+	NoLocals       bool             // Whether local transaction handling should be disabled
+	Journal        string           // Journal of local transactions to survive node restarts, disabled if empty
+	JournalRemotes bool             // Whether the remote transactions should be journaled too
+	Rejournal      time.Duration    // Time interval to regenerate the local transaction journal
 
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
@@ -155,7 +156,6 @@ type Config struct {
 
 // DefaultConfig contains the default configurations for the transaction pool.
 var DefaultConfig = Config{
-	Journal:   "transactions.rlp",
 	Rejournal: time.Hour,
 
 	PriceLimit: 1,
@@ -173,6 +173,10 @@ var DefaultConfig = Config{
 // unreasonable or unworkable.
 func (config *Config) sanitize() Config {
 	conf := *config
+	if conf.Rejournal < time.Second {
+		log.Warn("Sanitizing invalid txpool journal time", "provided", conf.Rejournal, "updated", time.Second)
+		conf.Rejournal = time.Second
+	}
 	if conf.PriceLimit < 1 {
 		log.Warn("Sanitizing invalid txpool price limit", "provided", conf.PriceLimit, "updated", DefaultConfig.PriceLimit)
 		conf.PriceLimit = DefaultConfig.PriceLimit
@@ -256,6 +260,10 @@ type LegacyPool struct {
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
 	BroadcastTxFn func(txs []*types.Transaction) error
+
+	// SponsoredTxFn reports whether the fees of the transaction are paid by a fee
+	// granter, the balance of the sender only covers its value then.
+	SponsoredTxFn func(tx *types.Transaction) bool
 }
 
 type txpoolResetRequest struct {
@@ -595,12 +603,13 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 		},
 		ExistingCost: func(addr common.Address, nonce uint64) *big.Int {
 			if list := pool.pending[addr]; list != nil {
-				if tx := list.txs.Get(nonce); tx != nil {
-					return tx.Cost()
+				if list.Contains(nonce) {
+					return list.Cost(nonce).ToBig()
 				}
 			}
 			return nil
 		},
+		Cost: pool.txCost,
 	}
 	if err := txpool.ValidateTransactionWithState(tx, pool.signer, opts); err != nil {
 		return err
@@ -608,6 +617,15 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 	return pool.validateAuth(tx)
 }
 
+// txCost returns the cost of the transaction charged to the balance of its
+// sender, the fees of the sponsored transactions are not.
+func (pool *LegacyPool) txCost(tx *types.Transaction) *big.Int {
+	if pool.SponsoredTxFn != nil && pool.SponsoredTxFn(tx) {
+		return new(big.Int).Set(tx.Value())
+	}
+	return tx.Cost()
+}
+
 // checkDelegationLimit determines if the tx sender is delegated or has a
 // pending delegation, and if so, ensures they have at most one in-flight
 // **executable** transaction, e.g. disallow stacked and gapped transactions
@@ -840,7 +858,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 	// Try to insert the transaction into the future queue
 	from, _ := types.Sender(pool.signer, tx) // already validated
 	if pool.queue[from] == nil {
-		pool.queue[from] = newList(false)
+		pool.queue[from] = newList(false, pool.txCost)
 	}
 	inserted, old := pool.queue[from].Add(tx, pool.config.PriceBump)
 	if !inserted {
@@ -880,7 +898,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *types.Transaction) bool {
 	// Try to insert the transaction into the pending queue
 	if pool.pending[addr] == nil {
-		pool.pending[addr] = newList(true)
+		pool.pending[addr] = newList(true, pool.txCost)
 	}
 	list := pool.pending[addr]
 
@@ -1856,6 +1874,12 @@ func numSlots(tx *types.Transaction) int {
 	return int((tx.Size() + txSlotSize - 1) / txSlotSize)
 }
 
+// WaitPromotions blocks until the promotion checks requested by the previous
+// asynchronous additions have run. Unlike Sync, it doesn't reset the pool.
+func (pool *LegacyPool) WaitPromotions() {
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer))
+}
+
 // Clear implements txpool.SubPool, removing all tracked txs from the pool
 // and rotating the journal.
 //
@@ -276,16 +276,25 @@ type list struct {
 	costcap   *uint256.Int // Price of the highest costing transaction (reset only if exceeds balance)
 	gascap    uint64       // Gas limit of the highest spending transaction (reset only if exceeds block limit)
 	totalcost *uint256.Int // Total cost of all transactions in the list
+
+	costFn func(*types.Transaction) *big.Int // Cost of a transaction charged to the sender balance
+	costs  map[uint64]*uint256.Int           // Cost of the transactions by nonce when they were added
 }
 
 // newList creates a new transaction list for maintaining nonce-indexable fast,
-// gapped, sortable transaction lists.
-func newList(strict bool) *list {
+// gapped, sortable transaction lists. The cost function returns the cost of a
+// transaction charged to the sender balance, the full cost if nil.
+func newList(strict bool, costFn func(*types.Transaction) *big.Int) *list {
+	if costFn == nil {
+		costFn = (*types.Transaction).Cost
+	}
 	return &list{
 		strict:    strict,
 		txs:       NewSortedMap(),
 		costcap:   new(uint256.Int),
 		totalcost: new(uint256.Int),
+		costFn:    costFn,
+		costs:     make(map[uint64]*uint256.Int),
 	}
 }
 
@@ -327,11 +336,12 @@ func (l *list) Add(tx *types.Transaction, priceBump uint64) (bool, *types.Transa
 		l.subTotalCost([]*types.Transaction{old})
 	}
 	// Add new tx cost to totalcost
-	cost, overflow := uint256.FromBig(tx.Cost())
+	cost, overflow := uint256.FromBig(l.costFn(tx))
 	if overflow {
 		return false, nil
 	}
 	l.totalcost.Add(l.totalcost, cost)
+	l.costs[tx.Nonce()] = cost
 
 	// Otherwise overwrite the old transaction with the current one
 	l.txs.Put(tx)
@@ -372,7 +382,7 @@ func (l *list) Filter(costLimit *uint256.Int, gasLimit uint64) (types.Transactio
 
 	// Filter out all the transactions above the account's funds
 	removed := l.txs.Filter(func(tx *types.Transaction) bool {
-		return tx.Gas() > gasLimit || tx.Cost().Cmp(costLimit.ToBig()) > 0
+		return tx.Gas() > gasLimit || l.Cost(tx.Nonce()).Cmp(costLimit) > 0
 	})
 
 	if len(removed) == 0 {
@@ -459,14 +469,24 @@ func (l *list) LastElement() *types.Transaction {
 	return l.txs.LastElement()
 }
 
+// Cost returns the cost of the transaction of the list with the given nonce,
+// as it was when the transaction was added.
+func (l *list) Cost(nonce uint64) *uint256.Int {
+	if cost, ok := l.costs[nonce]; ok {
+		return cost
+	}
+	return new(uint256.Int)
+}
+
 // subTotalCost subtracts the cost of the given transactions from the
 // total cost of all transactions.
 func (l *list) subTotalCost(txs []*types.Transaction) {
 	for _, tx := range txs {
-		_, underflow := l.totalcost.SubOverflow(l.totalcost, uint256.MustFromBig(tx.Cost()))
+		_, underflow := l.totalcost.SubOverflow(l.totalcost, l.Cost(tx.Nonce()))
 		if underflow {
 			panic("totalcost underflow")
 		}
+		delete(l.costs, tx.Nonce())
 	}
 }
 
@@ -0,0 +1,66 @@
+package locals_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool/txpool/locals"
+)
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "transactions.rlp")
+	journal := locals.NewJournal(path)
+
+	load := func() []uint64 {
+		var nonces []uint64
+		require.NoError(t, locals.NewJournal(path).Load(func(txs []*types.Transaction) []error {
+			for _, tx := range txs {
+				nonces = append(nonces, tx.Nonce())
+			}
+			return make([]error, len(txs))
+		}))
+		return nonces
+	}
+	newTx := func(nonce uint64) *types.Transaction {
+		return types.NewTx(&types.LegacyTx{Nonce: nonce})
+	}
+
+	// no journal file yet
+	require.Empty(t, load())
+	require.Error(t, journal.Insert(newTx(0)))
+
+	require.NoError(t, journal.Rotate(map[common.Address]types.Transactions{
+		{1}: {newTx(0), newTx(1)},
+	}))
+	require.NoError(t, journal.Insert(newTx(2)))
+	require.Equal(t, []uint64{0, 1, 2}, load())
+
+	// the rotation drops the transactions missing from the pool content
+	require.NoError(t, journal.Rotate(map[common.Address]types.Transactions{
+		{1}: {newTx(2)},
+	}))
+	require.NoError(t, journal.Close())
+	require.Equal(t, []uint64{2}, load())
+
+	// the transactions before a malformed entry are loaded
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0xff})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	var nonces []uint64
+	err = locals.NewJournal(path).Load(func(txs []*types.Transaction) []error {
+		for _, tx := range txs {
+			nonces = append(nonces, tx.Nonce())
+		}
+		return make([]error, len(txs))
+	})
+	require.Error(t, err)
+	require.Equal(t, []uint64{2}, nonces)
+}
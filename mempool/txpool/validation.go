@@ -206,6 +206,10 @@ type ValidationOptionsWithState struct {
 	// ExistingCost is a mandatory callback to retrieve an already pooled
 	// transaction's cost with the given nonce to check for overdrafts.
 	ExistingCost func(addr common.Address, nonce uint64) *big.Int
+
+	// Cost is an optional callback to retrieve the cost of the transaction
+	// charged to the balance of its sender, the full cost if not set.
+	Cost func(tx *types.Transaction) *big.Int
 }
 
 // ValidateTransactionWithState is a helper method to check whether a transaction
@@ -236,6 +240,9 @@ func ValidateTransactionWithState(tx *types.Transaction, signer types.Signer, op
 		balance = opts.State.GetBalance(from).ToBig()
 		cost    = tx.Cost()
 	)
+	if opts.Cost != nil {
+		cost = opts.Cost(tx)
+	}
 	if balance.Cmp(cost) < 0 {
 		return fmt.Errorf("%w: balance %v, tx cost %v, overshot %v", core.ErrInsufficientFunds, balance, cost, new(big.Int).Sub(cost, balance))
 	}
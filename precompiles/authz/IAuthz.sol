@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev StakeAuthorizationType defines the type of staking message a stake
+/// authorization is granted for.
+enum StakeAuthorizationType {
+    // Unspecified defines an invalid authorization type.
+    Unspecified,
+    // Delegate defines an authorization to delegate.
+    Delegate,
+    // Undelegate defines an authorization to undelegate.
+    Undelegate,
+    // Redelegate defines an authorization to redelegate.
+    Redelegate,
+    // CancelUnbondingDelegation defines an authorization to cancel an unbonding delegation.
+    CancelUnbondingDelegation
+}
+
+/// @dev GrantData represents a grant of an authorization from a granter to a grantee.
+struct GrantData {
+    /// granter defines the address of the account granting the authorization.
+    address granter;
+    /// grantee defines the address of the account receiving the authorization.
+    address grantee;
+    /// authorizationType defines the type URL of the authorization.
+    string authorizationType;
+    /// msgTypeUrl defines the type URL of the message the authorization is granted for.
+    string msgTypeUrl;
+    /// expiration defines the unix timestamp in seconds at which the grant expires,
+    /// zero if the grant does not expire.
+    int64 expiration;
+}
+
+/// @dev PrecompileCall defines a call to another precompile whose message is
+/// executed on behalf of a granter.
+struct PrecompileCall {
+    /// precompile defines the address of the precompile building the message.
+    address precompile;
+    /// input defines the ABI encoded call to the precompile method.
+    bytes input;
+}
+
+/// @author Cosmos EVM Core Team
+/// @title Authz Precompile Contract
+/// @dev The interface through which solidity contracts will grant, revoke and
+/// execute authorizations of the Cosmos SDK x/authz module.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IAuthz {
+    /// @dev Grant defines an Event emitted when an authorization is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message the authorization is granted for
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Revoke defines an Event emitted when an authorization is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message the authorization was granted for
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Exec defines an Event emitted for each message executed by a grantee.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message executed
+    event Exec(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// TRANSACTIONS
+
+    /// @dev grant defines a method to grant a generic authorization to execute
+    /// any message of the given type on behalf of the granter.
+    /// @param granter the address of the granter, it must be the caller
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message to authorize
+    /// @param expiration the unix timestamp in seconds at which the grant expires,
+    /// zero if the grant does not expire
+    /// @return success true if the grant was successful
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev grantSendAuthorization defines a method to grant an authorization to
+    /// send coins on behalf of the granter up to a spend limit.
+    /// @param granter the address of the granter, it must be the caller
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the maximum amount of coins the grantee can send
+    /// @param allowList the addresses the grantee can send to, any address if empty
+    /// @param expiration the unix timestamp in seconds at which the grant expires,
+    /// zero if the grant does not expire
+    /// @return success true if the grant was successful
+    function grantSendAuthorization(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev grantStakeAuthorization defines a method to grant an authorization to
+    /// perform a staking action on behalf of the granter. Only one of the allowed
+    /// and denied validators lists can be set.
+    /// @param granter the address of the granter, it must be the caller
+    /// @param grantee the address of the grantee
+    /// @param authorizationType the staking action to authorize
+    /// @param allowedValidators the validator addresses the grantee can act on
+    /// @param deniedValidators the validator addresses the grantee cannot act on
+    /// @param maxTokens the maximum amount of bond denom tokens, zero for no limit
+    /// @param expiration the unix timestamp in seconds at which the grant expires,
+    /// zero if the grant does not expire
+    /// @return success true if the grant was successful
+    function grantStakeAuthorization(
+        address granter,
+        address grantee,
+        StakeAuthorizationType authorizationType,
+        string[] calldata allowedValidators,
+        string[] calldata deniedValidators,
+        uint256 maxTokens,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev revoke defines a method to revoke the authorization granted for a
+    /// message type.
+    /// @param granter the address of the granter, it must be the caller
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message the authorization was granted for
+    /// @return success true if the revocation was successful
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev exec defines a method to execute messages on behalf of their granters.
+    /// Each message is built from a call to the staking, distribution or gov
+    /// precompile, whose delegator, voter or depositor is the granter.
+    /// Supported methods are staking delegate, undelegate, redelegate and
+    /// cancelUnbondingDelegation, distribution setWithdrawAddress and
+    /// withdrawDelegatorRewards, and gov vote, voteWeighted and deposit.
+    /// @param grantee the address of the grantee, it must be the caller
+    /// @param calls the precompile calls building the messages to execute
+    /// @return success true if all the messages were executed
+    function exec(
+        address grantee,
+        PrecompileCall[] calldata calls
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev grants returns the grants from a granter to a grantee.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the message type URL to filter by, all the grants if empty
+    /// @param pagination the pagination options
+    /// @return grants the grants from the granter to the grantee
+    /// @return pageResponse the pagination information
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev granterGrants returns the grants given by a granter.
+    /// @param granter the address of the granter
+    /// @param pagination the pagination options
+    /// @return grants the grants given by the granter
+    /// @return pageResponse the pagination information
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev granteeGrants returns the grants received by a grantee.
+    /// @param grantee the address of the grantee
+    /// @param pagination the pagination options
+    /// @return grants the grants received by the grantee
+    /// @return pageResponse the pagination information
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
@@ -0,0 +1,40 @@
+package authz
+
+import "errors"
+
+// Errors that have formatted information are defined here as a string.
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %v"
+	// ErrDisabledMsgTypeURL is raised when the message type URL is disabled within authz.
+	ErrDisabledMsgTypeURL = "disabled msg type url: %s"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit of a send authorization is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAllowList is raised when the allow list of a send authorization is not valid.
+	ErrInvalidAllowList = "invalid allow list: %v"
+	// ErrInvalidStakeAuthorizationType is raised when the stake authorization type is not valid.
+	ErrInvalidStakeAuthorizationType = "invalid stake authorization type: %v"
+	// ErrInvalidValidators is raised when a validators list of a stake authorization is not valid.
+	ErrInvalidValidators = "invalid validators: %v"
+	// ErrInvalidMaxTokens is raised when the max tokens of a stake authorization is not valid.
+	ErrInvalidMaxTokens = "invalid max tokens: %v"
+	// ErrUnsupportedPrecompile is raised when exec is called with a precompile that cannot build messages.
+	ErrUnsupportedPrecompile = "precompile %s is not supported by exec"
+	// ErrUnsupportedMethod is raised when exec is called with a precompile method that cannot be executed.
+	ErrUnsupportedMethod = "method %s of precompile %s is not supported by exec"
+	// ErrInvalidAuthorization is raised when a queried grant has an unknown authorization.
+	ErrInvalidAuthorization = "invalid authorization: %s"
+)
+
+var (
+	// ErrEmptyCalls is raised when exec is called without precompile calls.
+	ErrEmptyCalls = errors.New("exec requires at least one precompile call")
+	// ErrInvalidCallInput is raised when the input of a precompile call is too short to contain a method ID.
+	ErrInvalidCallInput = errors.New("precompile call input must contain a method ID")
+)
@@ -0,0 +1,110 @@
+package authz
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the grants from a granter to a grantee, filtered by message type
+// if the message type URL is not empty.
+func (p *Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.Grants(ctx, req)
+	if err != nil {
+		// NOTE: return an empty list instead of failing when there is no grant
+		// for the given message type.
+		if !errors.Is(err, authztypes.ErrNoAuthorizationFound) {
+			return nil, err
+		}
+		res = &authztypes.QueryGrantsResponse{}
+	}
+
+	granter, err := utils.HexAddressFromBech32String(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := utils.HexAddressFromBech32String(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantsResponse(granter, grantee, res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranterGrants returns the grants given by a granter.
+func (p *Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranteeGrants returns the grants received by a grantee.
+func (p *Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranteeGrantsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
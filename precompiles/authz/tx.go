@@ -0,0 +1,225 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction
+	// of a generic authorization.
+	GrantMethod = "grant"
+	// GrantSendAuthorizationMethod defines the ABI method name for the authz Grant
+	// transaction of a send authorization.
+	GrantSendAuthorizationMethod = "grantSendAuthorization"
+	// GrantStakeAuthorizationMethod defines the ABI method name for the authz Grant
+	// transaction of a stake authorization.
+	GrantStakeAuthorizationMethod = "grantStakeAuthorization"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization to execute any message of a type on behalf
+// of the granter.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrant(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// GrantSendAuthorization grants an authorization to send coins on behalf of the
+// granter up to a spend limit.
+func (p *Precompile) GrantSendAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantSendAuthorization(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// GrantStakeAuthorization grants an authorization to perform a staking action on
+// behalf of the granter.
+func (p *Precompile) GrantStakeAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, granter, grantee, err := NewMsgGrantStakeAuthorization(args, bondDenom, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// grant is a common function that handles the grant transactions. It checks that
+// the granter is the caller, executes the MsgGrant and emits the Grant event.
+func (p *Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authztypes.MsgGrant,
+	granter, grantee common.Address,
+) ([]byte, error) {
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, authorization: %s, expiration: %v }",
+			granter,
+			grantee,
+			msg.Grant.Authorization.TypeUrl,
+			msg.Grant.Expiration,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if _, err = p.authzMsgServer.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the authorization granted for a message type.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ granter: %s, grantee: %s, msg_type_url: %s }", granter, grantee, msg.MsgTypeUrl),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if _, err = p.authzMsgServer.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the messages built from the given precompile calls on behalf of
+// their granters. The grantee must be the caller.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, calls, err := ParseExecArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ grantee: %s, calls: %d }", grantee, len(calls)),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != grantee {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), grantee.String())
+	}
+
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(calls))
+	granters := make([]common.Address, len(calls))
+	for i, call := range calls {
+		msgs[i], granters[i], err = NewExecMsg(call, bondDenom, p.addrCdc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	granteeAddr, err := p.addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	// NOTE: the grantee is set afterwards to encode it with the address codec
+	msg := authztypes.NewMsgExec(nil, msgs)
+	msg.Grantee = granteeAddr
+
+	if _, err = p.authzMsgServer.Exec(ctx, &msg); err != nil {
+		return nil, err
+	}
+
+	for i, execMsg := range msgs {
+		if err = p.EmitExecEvent(ctx, stateDB, granters[i], grantee, sdk.MsgTypeURL(execMsg)); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,575 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cosmosante "github.com/cosmos/evm/ante/cosmos"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	govprecompile "github.com/cosmos/evm/precompiles/gov"
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/utils"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GrantData defines the data of a grant returned by the authz queries.
+type GrantData struct {
+	Granter           common.Address
+	Grantee           common.Address
+	AuthorizationType string
+	MsgTypeUrl        string //nolint:revive
+	Expiration        int64
+}
+
+// PrecompileCall defines a call to another precompile whose message is executed
+// on behalf of a granter.
+type PrecompileCall struct {
+	Precompile common.Address
+	Input      []byte
+}
+
+// ExecInput defines the input for the Exec transaction.
+type ExecInput struct {
+	Grantee common.Address
+	Calls   []PrecompileCall
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+	Pagination query.PageRequest
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// GrantsOutput defines the output for the authz grants queries.
+type GrantsOutput struct {
+	Grants       []GrantData
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrant creates a new MsgGrant of a generic authorization from the given arguments.
+func NewMsgGrant(args []interface{}, addrCdc address.Codec) (*authztypes.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, err := parseMsgTypeURL(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authztypes.NewGenericAuthorization(msgTypeURL), args[3], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantSendAuthorization creates a new MsgGrant of a send authorization from
+// the given arguments.
+func NewMsgGrantSendAuthorization(args []interface{}, addrCdc address.Codec) (*authztypes.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	coins, err := cmn.ToCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	allowList, ok := args[3].([]common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAllowList, args[3])
+	}
+
+	allowed := make([]sdk.AccAddress, len(allowList))
+	for i, addr := range allowList {
+		allowed[i] = addr.Bytes()
+	}
+
+	msg, err := newMsgGrant(granter, grantee, banktypes.NewSendAuthorization(spendLimit, allowed), args[4], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantStakeAuthorization creates a new MsgGrant of a stake authorization from
+// the given arguments. The max tokens are in the bond denomination.
+func NewMsgGrantStakeAuthorization(args []interface{}, bondDenom string, addrCdc address.Codec) (*authztypes.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	authorizationType, ok := args[2].(uint8)
+	if !ok ||
+		authorizationType == uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED) ||
+		authorizationType > uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION) {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidStakeAuthorizationType, args[2])
+	}
+
+	allowed, err := parseValidators(args[3])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	denied, err := parseValidators(args[4])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	maxTokens, ok := args[5].(*big.Int)
+	if !ok || maxTokens == nil || maxTokens.Sign() < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMaxTokens, args[5])
+	}
+
+	// NOTE: a zero amount means the authorization has no limit of tokens
+	var amount *sdk.Coin
+	if maxTokens.Sign() > 0 {
+		coin := sdk.Coin{Denom: bondDenom, Amount: math.NewIntFromBigInt(maxTokens)}
+		amount = &coin
+	}
+
+	authorization, err := stakingtypes.NewStakeAuthorization(allowed, denied, stakingtypes.AuthorizationType(authorizationType), amount)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authorization, args[6], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// parseMsgTypeURL returns the msg type url of a generic authorization, the msg
+// types disabled by the ante handler cannot be granted nor revoked.
+func parseMsgTypeURL(arg interface{}) (string, error) {
+	msgTypeURL, ok := arg.(string)
+	if !ok || msgTypeURL == "" {
+		return "", fmt.Errorf(ErrInvalidMsgTypeURL, arg)
+	}
+	if slices.Contains(cosmosante.DisabledAuthzMsgTypes, msgTypeURL) {
+		return "", fmt.Errorf(ErrDisabledMsgTypeURL, msgTypeURL)
+	}
+	return msgTypeURL, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke from the given arguments.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authztypes.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, err := parseMsgTypeURL(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := granterGranteeStrings(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &authztypes.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// ParseExecArgs parses the arguments for the Exec transaction.
+func ParseExecArgs(method *abi.Method, args []interface{}) (common.Address, []PrecompileCall, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input ExecInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return common.Address{}, nil, fmt.Errorf("error while unpacking args to ExecInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	if len(input.Calls) == 0 {
+		return common.Address{}, nil, ErrEmptyCalls
+	}
+
+	return input.Grantee, input.Calls, nil
+}
+
+// NewExecMsg builds the Cosmos message of a call to the staking, distribution or
+// gov precompile, using the same message constructors as the called precompile.
+// It returns the message and the address of its signer, which is the granter.
+func NewExecMsg(call PrecompileCall, bondDenom string, addrCdc address.Codec) (sdk.Msg, common.Address, error) {
+	if len(call.Input) < 4 {
+		return nil, common.Address{}, ErrInvalidCallInput
+	}
+
+	switch call.Precompile {
+	case common.HexToAddress(evmtypes.StakingPrecompileAddress):
+		method, args, err := unpackCall(stakingprecompile.ABI, call.Input)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+
+		switch method.Name {
+		case stakingprecompile.DelegateMethod:
+			return stakingprecompile.NewMsgDelegate(args, bondDenom, addrCdc)
+		case stakingprecompile.UndelegateMethod:
+			return stakingprecompile.NewMsgUndelegate(args, bondDenom, addrCdc)
+		case stakingprecompile.RedelegateMethod:
+			return stakingprecompile.NewMsgRedelegate(args, bondDenom, addrCdc)
+		case stakingprecompile.CancelUnbondingDelegationMethod:
+			return stakingprecompile.NewMsgCancelUnbondingDelegation(args, bondDenom, addrCdc)
+		}
+
+		return nil, common.Address{}, fmt.Errorf(ErrUnsupportedMethod, method.Name, call.Precompile)
+	case common.HexToAddress(evmtypes.DistributionPrecompileAddress):
+		method, args, err := unpackCall(distprecompile.ABI, call.Input)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+
+		switch method.Name {
+		case distprecompile.SetWithdrawAddressMethod:
+			return distprecompile.NewMsgSetWithdrawAddress(args, addrCdc)
+		case distprecompile.WithdrawDelegatorRewardMethod:
+			return distprecompile.NewMsgWithdrawDelegatorReward(args, addrCdc)
+		}
+
+		return nil, common.Address{}, fmt.Errorf(ErrUnsupportedMethod, method.Name, call.Precompile)
+	case common.HexToAddress(evmtypes.GovPrecompileAddress):
+		method, args, err := unpackCall(govprecompile.ABI, call.Input)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+
+		switch method.Name {
+		case govprecompile.VoteMethod:
+			return govprecompile.NewMsgVote(args, addrCdc)
+		case govprecompile.VoteWeightedMethod:
+			msg, voter, _, err := govprecompile.NewMsgVoteWeighted(method, args, addrCdc)
+			return msg, voter, err
+		case govprecompile.DepositMethod:
+			return govprecompile.NewMsgDeposit(args, addrCdc)
+		}
+
+		return nil, common.Address{}, fmt.Errorf(ErrUnsupportedMethod, method.Name, call.Precompile)
+	default:
+		return nil, common.Address{}, fmt.Errorf(ErrUnsupportedPrecompile, call.Precompile)
+	}
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest from the given arguments.
+func NewGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	granterAddr, granteeAddr, err := granterGranteeStrings(input.Granter, input.Grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authztypes.QueryGrantsRequest{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest from the given arguments.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	return &authztypes.QueryGranterGrantsRequest{
+		Granter:    granterAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGranteeGrantsRequest creates a new QueryGranteeGrantsRequest from the given arguments.
+func NewGranteeGrantsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authztypes.QueryGranteeGrantsRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrantsResponse populates the GrantsOutput from a QueryGrantsResponse. The
+// response does not contain the granter and grantee, so they are taken from the
+// request.
+func (o *GrantsOutput) FromGrantsResponse(granter, grantee common.Address, res *authztypes.QueryGrantsResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(res.Grants))
+	for i, g := range res.Grants {
+		grant, err := NewGrantData(granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(res.Pagination)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grant authorizations
+// returned by the GranterGrants and GranteeGrants queries.
+func (o *GrantsOutput) FromGrantAuthorizations(grants []*authztypes.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, g := range grants {
+		granter, err := utils.HexAddressFromBech32String(g.Granter)
+		if err != nil {
+			return nil, err
+		}
+
+		grantee, err := utils.HexAddressFromBech32String(g.Grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		grant, err := NewGrantData(granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// NewGrantData creates a new GrantData from the authorization and expiration of a grant.
+func NewGrantData(granter, grantee common.Address, authorizationAny *codectypes.Any, expiration *time.Time) (GrantData, error) {
+	if authorizationAny == nil {
+		return GrantData{}, fmt.Errorf(ErrInvalidAuthorization, "empty authorization")
+	}
+
+	authorization, ok := authorizationAny.GetCachedValue().(authztypes.Authorization)
+	if !ok {
+		return GrantData{}, fmt.Errorf(ErrInvalidAuthorization, authorizationAny.TypeUrl)
+	}
+
+	var expirationUnix int64
+	if expiration != nil {
+		expirationUnix = expiration.Unix()
+	}
+
+	return GrantData{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		Expiration:        expirationUnix,
+	}, nil
+}
+
+// newMsgGrant creates a new MsgGrant of the authorization from the granter to the
+// grantee, expiring at the given unix timestamp in seconds if it is not zero.
+func newMsgGrant(
+	granter, grantee common.Address,
+	authorization authztypes.Authorization,
+	expirationArg interface{},
+	addrCdc address.Codec,
+) (*authztypes.MsgGrant, error) {
+	expiration, ok := expirationArg.(int64)
+	if !ok || expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expirationArg)
+	}
+
+	var expirationTime *time.Time
+	if expiration > 0 {
+		t := time.Unix(expiration, 0).UTC()
+		expirationTime = &t
+	}
+
+	if err := authorization.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := granterGranteeStrings(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authztypes.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant: authztypes.Grant{
+			Authorization: authorizationAny,
+			Expiration:    expirationTime,
+		},
+	}, nil
+}
+
+// checkGranterGranteeArgs checks the granter and grantee addresses, which are the
+// first two arguments of the authz transactions.
+func checkGranterGranteeArgs(args []interface{}) (common.Address, common.Address, error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
+
+// granterGranteeStrings returns the granter and grantee addresses encoded with the
+// address codec.
+func granterGranteeStrings(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
+
+// parseValidators parses a list of bech32 validator operator addresses.
+func parseValidators(arg interface{}) ([]sdk.ValAddress, error) {
+	validators, ok := arg.([]string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidValidators, arg)
+	}
+
+	valAddrs := make([]sdk.ValAddress, len(validators))
+	for i, validator := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidValidators, err)
+		}
+		valAddrs[i] = valAddr
+	}
+
+	return valAddrs, nil
+}
+
+// unpackCall unpacks the method and arguments of a precompile call input.
+func unpackCall(precompileABI abi.ABI, input []byte) (*abi.Method, []interface{}, error) {
+	method, err := precompileABI.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return method, args, nil
+}
@@ -0,0 +1,430 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	govprecompile "github.com/cosmos/evm/precompiles/gov"
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	denom      = "stake"
+	msgTypeURL = "/cosmos.staking.v1beta1.MsgDelegate"
+)
+
+var (
+	granter       = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee       = common.HexToAddress("0x0987654321098765432109876543210987654321")
+	validatorAddr = sdk.ValAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+)
+
+func TestNewMsgGrant(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name           string
+		args           []interface{}
+		wantErr        bool
+		errMsg         string
+		wantExpiration *time.Time
+	}{
+		{
+			name: "valid without expiration",
+			args: []interface{}{granter, grantee, msgTypeURL, int64(0)},
+		},
+		{
+			name:           "valid with expiration",
+			args:           []interface{}{granter, grantee, msgTypeURL, expiration},
+			wantExpiration: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "invalid granter type",
+			args:    []interface{}{"not-an-address", grantee, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, "not-an-address"),
+		},
+		{
+			name:    "empty grantee address",
+			args:    []interface{}{granter, common.Address{}, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			name:    "empty msg type url",
+			args:    []interface{}{granter, grantee, "", int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgTypeURL, ""),
+		},
+		{
+			name:    "disabled msg type url",
+			args:    []interface{}{granter, grantee, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgTypeURL, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, msgTypeURL, int64(-1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, int64(-1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, returnGranter, returnGrantee, err := NewMsgGrant(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+				require.Equal(t, granter, returnGranter)
+				require.Equal(t, grantee, returnGrantee)
+				require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), msg.Granter)
+				require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), msg.Grantee)
+				require.Equal(t, tt.wantExpiration, msg.Grant.Expiration)
+
+				authorization, err := msg.GetAuthorization()
+				require.NoError(t, err)
+				require.Equal(t, authztypes.NewGenericAuthorization(msgTypeURL), authorization)
+			}
+		})
+	}
+}
+
+func TestNewMsgGrantSendAuthorization(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	spendLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1e18)}}
+	allowed := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	tests := []struct {
+		name      string
+		args      []interface{}
+		wantErr   bool
+		errMsg    string
+		wantAllow []string
+	}{
+		{
+			name:      "valid",
+			args:      []interface{}{granter, grantee, spendLimit, []common.Address{allowed}, int64(0)},
+			wantAllow: []string{sdk.AccAddress(allowed.Bytes()).String()},
+		},
+		{
+			name: "valid with empty allow list",
+			args: []interface{}{granter, grantee, spendLimit, []common.Address{}, int64(0)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "invalid spend limit type",
+			args:    []interface{}{granter, grantee, "not-coins", []common.Address{}, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid spend limit",
+		},
+		{
+			name:    "empty spend limit",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, []common.Address{}, int64(0)},
+			wantErr: true,
+			errMsg:  "spend limit cannot be nil",
+		},
+		{
+			name:    "invalid allow list type",
+			args:    []interface{}{granter, grantee, spendLimit, "not-addresses", int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAllowList, "not-addresses"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, _, err := NewMsgGrantSendAuthorization(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+
+				authorization, err := msg.GetAuthorization()
+				require.NoError(t, err)
+				sendAuthorization, ok := authorization.(*banktypes.SendAuthorization)
+				require.True(t, ok)
+				require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(spendLimit[0].Amount))), sendAuthorization.SpendLimit)
+				require.Equal(t, tt.wantAllow, sendAuthorization.AllowList)
+			}
+		})
+	}
+}
+
+func TestNewMsgGrantStakeAuthorization(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	delegate := uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE)
+	maxTokens := big.NewInt(1e18)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantMaxTokens *sdk.Coin
+	}{
+		{
+			name:          "valid with max tokens",
+			args:          []interface{}{granter, grantee, delegate, []string{validatorAddr}, []string{}, maxTokens, int64(0)},
+			wantMaxTokens: &sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(maxTokens)},
+		},
+		{
+			name: "valid without max tokens",
+			args: []interface{}{granter, grantee, delegate, []string{}, []string{validatorAddr}, big.NewInt(0), int64(0)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			name:    "unspecified authorization type",
+			args:    []interface{}{granter, grantee, uint8(0), []string{validatorAddr}, []string{}, maxTokens, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidStakeAuthorizationType, uint8(0)),
+		},
+		{
+			name:    "unknown authorization type",
+			args:    []interface{}{granter, grantee, uint8(5), []string{validatorAddr}, []string{}, maxTokens, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidStakeAuthorizationType, uint8(5)),
+		},
+		{
+			name:    "invalid validator address",
+			args:    []interface{}{granter, grantee, delegate, []string{"invalid"}, []string{}, maxTokens, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid validators",
+		},
+		{
+			name:    "allowed and denied validators",
+			args:    []interface{}{granter, grantee, delegate, []string{validatorAddr}, []string{validatorAddr}, maxTokens, int64(0)},
+			wantErr: true,
+			errMsg:  "cannot set both allowed & deny list",
+		},
+		{
+			name:    "negative max tokens",
+			args:    []interface{}{granter, grantee, delegate, []string{validatorAddr}, []string{}, big.NewInt(-1), int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMaxTokens, big.NewInt(-1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, _, _, err := NewMsgGrantStakeAuthorization(tt.args, denom, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+
+				authorization, err := msg.GetAuthorization()
+				require.NoError(t, err)
+				stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+				require.True(t, ok)
+				require.Equal(t, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, stakeAuthorization.AuthorizationType)
+				require.Equal(t, tt.wantMaxTokens, stakeAuthorization.MaxTokens)
+			}
+		})
+	}
+}
+
+func TestNewMsgRevoke(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granter, grantee, msgTypeURL},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:    "empty granter address",
+			args:    []interface{}{common.Address{}, grantee, msgTypeURL},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+		{
+			name:    "invalid msg type url type",
+			args:    []interface{}{granter, grantee, 0},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgTypeURL, 0),
+		},
+		{
+			name:    "disabled msg type url",
+			args:    []interface{}{granter, grantee, sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{})},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgTypeURL, sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, returnGranter, returnGrantee, err := NewMsgRevoke(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+				require.Equal(t, granter, returnGranter)
+				require.Equal(t, grantee, returnGrantee)
+				require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), msg.Granter)
+				require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), msg.Grantee)
+				require.Equal(t, msgTypeURL, msg.MsgTypeUrl)
+			}
+		})
+	}
+}
+
+func TestNewExecMsg(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	stakingAddr := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+	distributionAddr := common.HexToAddress(evmtypes.DistributionPrecompileAddress)
+	govAddr := common.HexToAddress(evmtypes.GovPrecompileAddress)
+	bankAddr := common.HexToAddress(evmtypes.BankPrecompileAddress)
+
+	pack := func(input []byte, err error) []byte {
+		require.NoError(t, err)
+		return input
+	}
+
+	tests := []struct {
+		name        string
+		call        PrecompileCall
+		wantErr     bool
+		errMsg      string
+		wantMsgType string
+	}{
+		{
+			name: "valid staking delegate",
+			call: PrecompileCall{
+				Precompile: stakingAddr,
+				Input:      pack(stakingprecompile.ABI.Pack(stakingprecompile.DelegateMethod, granter, validatorAddr, big.NewInt(1e18))),
+			},
+			wantMsgType: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		},
+		{
+			name: "valid distribution set withdraw address",
+			call: PrecompileCall{
+				Precompile: distributionAddr,
+				Input:      pack(distprecompile.ABI.Pack(distprecompile.SetWithdrawAddressMethod, granter, sdk.AccAddress(grantee.Bytes()).String())),
+			},
+			wantMsgType: sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+		},
+		{
+			name: "valid gov vote",
+			call: PrecompileCall{
+				Precompile: govAddr,
+				Input:      pack(govprecompile.ABI.Pack(govprecompile.VoteMethod, granter, uint64(1), uint8(govv1.OptionYes), "")),
+			},
+			wantMsgType: sdk.MsgTypeURL(&govv1.MsgVote{}),
+		},
+		{
+			name:    "empty input",
+			call:    PrecompileCall{Precompile: stakingAddr, Input: []byte{}},
+			wantErr: true,
+			errMsg:  ErrInvalidCallInput.Error(),
+		},
+		{
+			name: "unsupported precompile",
+			call: PrecompileCall{
+				Precompile: bankAddr,
+				Input:      pack(stakingprecompile.ABI.Pack(stakingprecompile.DelegateMethod, granter, validatorAddr, big.NewInt(1e18))),
+			},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrUnsupportedPrecompile, bankAddr),
+		},
+		{
+			name: "unsupported method",
+			call: PrecompileCall{
+				Precompile: stakingAddr,
+				Input:      pack(stakingprecompile.ABI.Pack(stakingprecompile.DelegationMethod, granter, validatorAddr)),
+			},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrUnsupportedMethod, stakingprecompile.DelegationMethod, stakingAddr),
+		},
+		{
+			name: "method of another precompile",
+			call: PrecompileCall{
+				Precompile: govAddr,
+				Input:      pack(stakingprecompile.ABI.Pack(stakingprecompile.DelegateMethod, granter, validatorAddr, big.NewInt(1e18))),
+			},
+			wantErr: true,
+			errMsg:  "no method with id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, signer, err := NewExecMsg(tt.call, denom, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+				require.Equal(t, granter, signer)
+				require.Equal(t, tt.wantMsgType, sdk.MsgTypeURL(msg))
+			}
+		})
+	}
+}
@@ -15,12 +15,56 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Output specifies the recipient and the native coin of a multiSend transfer.
+struct Output {
+    /// to defines the recipient address.
+    address to;
+    /// denom defines the native Cosmos denomination.
+    string denom;
+    /// amount of tokens
+    uint256 amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module,
+ * and sending native coins.
  */
 interface IBank {
+    /// @dev Emitted when native coins are sent through send or multiSend.
+    /// @param from the address of the sender.
+    /// @param to the address of the recipient.
+    /// @param denom the native Cosmos denomination sent.
+    /// @param amount the amount of tokens sent.
+    event Transfer(
+        address indexed from,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev send defines a method for sending native coins of a denomination from
+    /// the caller to a recipient. The denomination does not need a registered
+    /// ERC-20 token pair.
+    /// @param to the address of the recipient.
+    /// @param denom the native Cosmos denomination to send.
+    /// @param amount the amount of tokens to send.
+    /// @return success true if the transfer succeeded.
+    function send(
+        address to,
+        string calldata denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins from the caller to
+    /// several recipients at once. The transfers are all executed or all reverted.
+    /// @param outputs the recipients and the native coins to send to each of them.
+    /// @return success true if all the transfers succeeded.
+    function multiSend(
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
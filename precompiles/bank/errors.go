@@ -0,0 +1,16 @@
+package bank
+
+import "errors"
+
+// Errors that have formatted information are defined here as a string.
+const (
+	// ErrInvalidReceiver is raised when the recipient of a transfer is invalid.
+	ErrInvalidReceiver = "invalid to address: %s"
+	// ErrInvalidCoin is raised when the coin of a transfer is not valid or not positive.
+	ErrInvalidCoin = "invalid coin %s: %s"
+	// ErrBlockedAddress is raised when the recipient is not allowed to receive funds.
+	ErrBlockedAddress = "%s is not allowed to receive funds"
+)
+
+// ErrEmptyOutputs is raised when multiSend is called without outputs.
+var ErrEmptyOutputs = errors.New("multiSend requires at least one output")
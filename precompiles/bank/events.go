@@ -0,0 +1,106 @@
+package bank
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventTypeTransfer defines the event type for the bank Send and MultiSend transactions.
+const EventTypeTransfer = "Transfer"
+
+// EmitTransferEvent creates a new Transfer event emitted on send and multiSend
+// transactions. If the denomination has a registered token pair of a native coin,
+// the ERC-20 Transfer event is also emitted from the token contract address so
+// indexers tracking the token see the transfer.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, coin sdk.Coin) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeTransfer]
+	topics, err := makeTransferTopics(event.ID, from, to)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(coin.Denom, coin.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	tokenAddress, found := p.nativeCoinAddress(ctx, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	erc20Event := erc20.ABI.Events[erc20.EventTypeTransfer]
+	topics, err = makeTransferTopics(erc20Event.ID, from, to)
+	if err != nil {
+		return err
+	}
+
+	arguments = abi.Arguments{erc20Event.Inputs[2]}
+	packed, err = arguments.Pack(coin.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     tokenAddress,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
+
+// nativeCoinAddress returns the ERC-20 contract address of the token pair registered
+// for the given native coin denomination.
+func (p Precompile) nativeCoinAddress(ctx sdk.Context, denom string) (common.Address, bool) {
+	contractAddress, err := p.erc20Keeper.GetCoinAddress(ctx, denom)
+	if err != nil {
+		return common.Address{}, false
+	}
+
+	// NOTE: IBC vouchers have a derived address even if no token pair is registered
+	tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetERC20Map(ctx, contractAddress))
+	if !found || !tokenPair.IsNativeCoin() {
+		return common.Address{}, false
+	}
+
+	return contractAddress, true
+}
+
+// makeTransferTopics returns the topics of a Transfer event with the given signature.
+func makeTransferTopics(eventID common.Hash, from, to common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = eventID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(from)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,109 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send transfers native coins of the given denomination from the caller to the
+// recipient. The denomination does not need a registered ERC-20 token pair.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, coin, err := ParseSendArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	from := contract.Caller()
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ from: %s, to: %s, coin: %s }", from, to, coin),
+	)
+
+	if err := p.send(ctx, stateDB, from, to, coin); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend transfers native coins from the caller to each of the given outputs.
+// All the transfers are reverted if one of them fails.
+// This method charges the account the corresponding value of a send call for
+// each output.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	outputs, err := ParseMultiSendArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	from := contract.Caller()
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ from: %s, outputs: %d }", from, len(outputs)),
+	)
+
+	for i, output := range outputs {
+		// NOTE: we already charged for a single send so we don't
+		// need to charge on the first output
+		if i > 0 {
+			ctx.GasMeter().ConsumeGas(GasSend, "bank extension multiSend method")
+		}
+
+		if err := p.send(ctx, stateDB, from, output.To, output.Coin()); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// send checks the send enabled flags of the coin and the blocked addresses before
+// sending the coin from the sender to the recipient and emitting the Transfer event.
+// The balance changes of the EVM denomination are synced to the stateDB by the
+// balance handler of the precompile.
+func (p Precompile) send(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, coin sdk.Coin) error {
+	if !p.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+		return banktypes.ErrSendDisabled.Wrapf("%s transfers are currently disabled", coin.Denom)
+	}
+
+	if p.bankKeeper.BlockedAddr(to.Bytes()) {
+		return fmt.Errorf(ErrBlockedAddress, to)
+	}
+
+	if err := p.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, from, to, coin)
+}
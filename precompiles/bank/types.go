@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,6 +20,45 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// Output contains the recipient and the native coin of a multiSend transfer.
+type Output struct {
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// Coin returns the native coin of the output.
+func (o Output) Coin() sdk.Coin {
+	return sdk.Coin{Denom: o.Denom, Amount: math.NewIntFromBigInt(o.Amount)}
+}
+
+// Validate checks that the recipient is set and that the coin is valid and positive.
+func (o Output) Validate() error {
+	if o.To == (common.Address{}) {
+		return fmt.Errorf(ErrInvalidReceiver, o.To)
+	}
+
+	if o.Amount == nil {
+		return fmt.Errorf(cmn.ErrInvalidAmount, o.Amount)
+	}
+
+	coin := o.Coin()
+	if err := coin.Validate(); err != nil {
+		return fmt.Errorf(ErrInvalidCoin, coin, err)
+	}
+
+	if !coin.IsPositive() {
+		return fmt.Errorf(ErrInvalidCoin, coin, "amount must be positive")
+	}
+
+	return nil
+}
+
+// MultiSendInput defines the input of the bank MultiSend transaction.
+type MultiSendInput struct {
+	Outputs []Output
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +86,56 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseSendArgs parses the call arguments for the bank Send transaction.
+func ParseSendArgs(args []interface{}) (common.Address, sdk.Coin, error) {
+	if len(args) != 3 {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	output := Output{To: to, Denom: denom, Amount: amount}
+	if err := output.Validate(); err != nil {
+		return common.Address{}, sdk.Coin{}, err
+	}
+
+	return to, output.Coin(), nil
+}
+
+// ParseMultiSendArgs parses the call arguments for the bank MultiSend transaction.
+func ParseMultiSendArgs(method *abi.Method, args []interface{}) ([]Output, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to MultiSendInput struct: %s", err)
+	}
+
+	if len(input.Outputs) == 0 {
+		return nil, ErrEmptyOutputs
+	}
+
+	for _, output := range input.Outputs {
+		if err := output.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return input.Outputs, nil
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev AllowanceData represents a fee allowance granted by a granter to a grantee.
+struct AllowanceData {
+    /// granter defines the address of the account paying the fees.
+    address granter;
+    /// grantee defines the address of the account using the allowance.
+    address grantee;
+    /// allowanceType defines the type URL of the allowance, the basic or periodic
+    /// allowance wrapped by an allowed messages allowance.
+    string allowanceType;
+    /// spendLimit defines the maximum amount of coins the grantee can spend in fees,
+    /// empty if there is no limit.
+    Coin[] spendLimit;
+    /// expiration defines the unix timestamp in seconds at which the allowance expires,
+    /// zero if the allowance does not expire.
+    int64 expiration;
+    /// period defines the duration in seconds of a period of a periodic allowance.
+    int64 period;
+    /// periodSpendLimit defines the maximum amount of coins the grantee can spend
+    /// in fees during a period of a periodic allowance.
+    Coin[] periodSpendLimit;
+    /// periodCanSpend defines the amount of coins left to spend in the current period
+    /// of a periodic allowance.
+    Coin[] periodCanSpend;
+    /// periodReset defines the unix timestamp in seconds at which the current period
+    /// of a periodic allowance ends.
+    int64 periodReset;
+    /// allowedMessages defines the type URLs of the messages the allowance can pay
+    /// the fees of, any message if empty.
+    string[] allowedMessages;
+}
+
+/// @author Cosmos EVM Core Team
+/// @title Feegrant Precompile Contract
+/// @dev The interface through which solidity contracts will grant, revoke and
+/// query fee allowances of the Cosmos SDK x/feegrant module.
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IFeegrant {
+    /// @dev GrantAllowance defines an Event emitted when a fee allowance is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event GrantAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev RevokeAllowance defines an Event emitted when a fee allowance is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// TRANSACTIONS
+
+    /// @dev grantAllowance defines a method to grant a basic fee allowance, which
+    /// pays the fees of the grantee up to a spend limit.
+    /// @param granter the address of the granter, it must be the caller
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the maximum amount of coins the grantee can spend in fees,
+    /// no limit if empty
+    /// @param expiration the unix timestamp in seconds at which the allowance expires,
+    /// zero if the allowance does not expire
+    /// @param allowedMessages the type URLs of the messages the allowance can pay
+    /// the fees of, any message if empty
+    /// @return success true if the grant was successful
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev grantPeriodicAllowance defines a method to grant a periodic fee allowance,
+    /// which pays the fees of the grantee up to a spend limit per period.
+    /// @param granter the address of the granter, it must be the caller
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the maximum amount of coins the grantee can spend in fees
+    /// over all the periods, no limit if empty
+    /// @param expiration the unix timestamp in seconds at which the allowance expires,
+    /// zero if the allowance does not expire
+    /// @param period the duration in seconds of a period
+    /// @param periodSpendLimit the maximum amount of coins the grantee can spend in
+    /// fees during a period
+    /// @param allowedMessages the type URLs of the messages the allowance can pay
+    /// the fees of, any message if empty
+    /// @return success true if the grant was successful
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev revokeAllowance defines a method to revoke the fee allowance granted
+    /// to a grantee.
+    /// @param granter the address of the granter, it must be the caller
+    /// @param grantee the address of the grantee
+    /// @return success true if the revocation was successful
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev allowance returns the fee allowance granted by a granter to a grantee.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @return allowance the fee allowance, with an empty allowanceType if there is none
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (AllowanceData memory allowance);
+
+    /// @dev allowances returns the fee allowances granted to a grantee.
+    /// @param grantee the address of the grantee
+    /// @param pagination the pagination options
+    /// @return allowances the fee allowances granted to the grantee
+    /// @return pageResponse the pagination information
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            AllowanceData[] memory allowances,
+            PageResponse memory pageResponse
+        );
+}
@@ -0,0 +1,26 @@
+package feegrant
+
+// Errors that have formatted information are defined here as a string.
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit of an allowance is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidPeriod is raised when the period of a periodic allowance is not valid.
+	ErrInvalidPeriod = "invalid period: %v"
+	// ErrInvalidPeriodSpendLimit is raised when the period spend limit of a periodic allowance is not valid.
+	ErrInvalidPeriodSpendLimit = "invalid period spend limit: %v"
+	// ErrPeriodResetAfterExpiration is raised when the first period of a periodic allowance ends after its expiration.
+	ErrPeriodResetAfterExpiration = "period of %d seconds cannot reset after the expiration %d"
+	// ErrInvalidAllowedMessages is raised when the allowed messages of an allowance are not valid.
+	ErrInvalidAllowedMessages = "invalid allowed messages: %v"
+	// ErrInvalidAllowance is raised when a queried grant has an unknown allowance.
+	ErrInvalidAllowance = "invalid allowance: %s"
+	// ErrAllowanceNotFound is raised by the feegrant keeper when there is no allowance
+	// from the granter to the grantee.
+	ErrAllowanceNotFound = "fee-grant not found"
+)
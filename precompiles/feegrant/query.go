@@ -0,0 +1,69 @@
+package feegrant
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query.
+	AllowancesMethod = "allowances"
+)
+
+// Allowance returns the fee allowance granted by a granter to a grantee.
+func (p *Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, granter, grantee, err := NewAllowanceRequest(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantQuerier.Allowance(ctx, req)
+	if err != nil {
+		// return an empty allowance if there is none from the granter to the grantee
+		if strings.Contains(err.Error(), ErrAllowanceNotFound) {
+			return method.Outputs.Pack(AllowanceData{Granter: granter, Grantee: grantee})
+		}
+		return nil, err
+	}
+
+	allowance, err := NewAllowanceData(res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(allowance)
+}
+
+// Allowances returns the fee allowances granted to a grantee.
+func (p *Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllowancesRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantQuerier.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Allowances, output.PageResponse)
+}
@@ -0,0 +1,134 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction of a basic allowance.
+	GrantAllowanceMethod = "grantAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction of a periodic allowance.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantAllowance grants a basic fee allowance, which pays the fees of the grantee
+// up to a spend limit.
+func (p *Precompile) GrantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// GrantPeriodicAllowance grants a periodic fee allowance, which pays the fees of
+// the grantee up to a spend limit per period. The first period starts at the
+// current block time.
+func (p *Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantPeriodicAllowance(args, ctx.BlockTime(), p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// grantAllowance is a common function that handles the grant transactions. It checks
+// that the granter is the caller, executes the MsgGrantAllowance and emits the
+// GrantAllowance event.
+func (p *Precompile) grantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegranttypes.MsgGrantAllowance,
+	granter, grantee common.Address,
+) ([]byte, error) {
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, allowance: %s }",
+			granter,
+			grantee,
+			msg.Allowance.TypeUrl,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if _, err := p.feegrantMsgServer.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevokeAllowance revokes the fee allowance granted to a grantee.
+func (p *Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevokeAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ granter: %s, grantee: %s }", granter, grantee),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if _, err = p.feegrantMsgServer.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,383 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/core/address"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// AllowanceData defines the data of a fee allowance returned by the feegrant queries.
+type AllowanceData struct {
+	Granter          common.Address
+	Grantee          common.Address
+	AllowanceType    string
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+	PeriodCanSpend   []cmn.Coin
+	PeriodReset      int64
+	AllowedMessages  []string
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesOutput defines the output for the Allowances query.
+type AllowancesOutput struct {
+	Allowances   []AllowanceData
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance of a basic allowance from
+// the given arguments.
+func NewMsgGrantAllowance(args []interface{}, addrCdc address.Codec) (*feegranttypes.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, basic, args[4], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance of a periodic allowance
+// from the given arguments. The first period starts at the given block time.
+func NewMsgGrantPeriodicAllowance(args []interface{}, blockTime time.Time, addrCdc address.Codec) (*feegranttypes.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	periodSeconds, ok := args[4].(int64)
+	if !ok || periodSeconds <= 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriod, args[4])
+	}
+
+	period := time.Duration(periodSeconds) * time.Second
+	periodReset := blockTime.Add(period)
+	if basic.Expiration != nil && periodReset.After(*basic.Expiration) {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrPeriodResetAfterExpiration, periodSeconds, basic.Expiration.Unix())
+	}
+
+	periodSpendLimit, err := parseCoins(args[5])
+	if err != nil || len(periodSpendLimit) == 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriodSpendLimit, args[5])
+	}
+
+	periodic := &feegranttypes.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      periodReset,
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, periodic, args[6], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance from the given arguments.
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegranttypes.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := granterGranteeStrings(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegranttypes.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewAllowanceRequest creates a new QueryAllowanceRequest from the given arguments.
+func NewAllowanceRequest(args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowanceRequest, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := granterGranteeStrings(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	req := &feegranttypes.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	return req, granter, grantee, nil
+}
+
+// NewAllowancesRequest creates a new QueryAllowancesRequest from the given arguments.
+func NewAllowancesRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &feegranttypes.QueryAllowancesRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the AllowancesOutput from a QueryAllowancesResponse.
+func (o *AllowancesOutput) FromResponse(res *feegranttypes.QueryAllowancesResponse) (*AllowancesOutput, error) {
+	o.Allowances = make([]AllowanceData, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		allowance, err := NewAllowanceData(grant)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+
+	if res.Pagination != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return o, nil
+}
+
+// NewAllowanceData creates a new AllowanceData from a fee allowance grant. The
+// allowance of an allowed messages allowance is unwrapped, and its allowed
+// messages are returned alongside.
+func NewAllowanceData(grant *feegranttypes.Grant) (AllowanceData, error) {
+	if grant == nil || grant.Allowance == nil {
+		return AllowanceData{}, fmt.Errorf(ErrInvalidAllowance, "empty allowance")
+	}
+
+	granter, err := utils.HexAddressFromBech32String(grant.Granter)
+	if err != nil {
+		return AllowanceData{}, err
+	}
+
+	grantee, err := utils.HexAddressFromBech32String(grant.Grantee)
+	if err != nil {
+		return AllowanceData{}, err
+	}
+
+	data := AllowanceData{
+		Granter: granter,
+		Grantee: grantee,
+	}
+
+	allowanceAny := grant.Allowance
+	if allowed, ok := allowanceAny.GetCachedValue().(*feegranttypes.AllowedMsgAllowance); ok {
+		if allowed.Allowance == nil {
+			return AllowanceData{}, fmt.Errorf(ErrInvalidAllowance, allowanceAny.TypeUrl)
+		}
+		data.AllowedMessages = allowed.AllowedMessages
+		allowanceAny = allowed.Allowance
+	}
+
+	data.AllowanceType = allowanceAny.TypeUrl
+	switch allowance := allowanceAny.GetCachedValue().(type) {
+	case *feegranttypes.BasicAllowance:
+		data.setBasic(allowance)
+	case *feegranttypes.PeriodicAllowance:
+		data.setBasic(&allowance.Basic)
+		data.Period = int64(allowance.Period / time.Second)
+		data.PeriodSpendLimit = cmn.NewCoinsResponse(allowance.PeriodSpendLimit)
+		data.PeriodCanSpend = cmn.NewCoinsResponse(allowance.PeriodCanSpend)
+		data.PeriodReset = allowance.PeriodReset.Unix()
+	default:
+		return AllowanceData{}, fmt.Errorf(ErrInvalidAllowance, allowanceAny.TypeUrl)
+	}
+
+	return data, nil
+}
+
+// setBasic sets the spend limit and expiration of a basic allowance.
+func (d *AllowanceData) setBasic(basic *feegranttypes.BasicAllowance) {
+	d.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		d.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// newBasicAllowance creates a new BasicAllowance from the spend limit and the
+// expiration arguments. An empty spend limit means there is no limit, and a zero
+// expiration that the allowance does not expire.
+func newBasicAllowance(spendLimitArg, expirationArg interface{}) (*feegranttypes.BasicAllowance, error) {
+	spendLimit, err := parseCoins(spendLimitArg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	expiration, ok := expirationArg.(int64)
+	if !ok || expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expirationArg)
+	}
+
+	basic := &feegranttypes.BasicAllowance{}
+	// NOTE: the spend limit must be nil rather than empty for an unlimited allowance
+	if len(spendLimit) > 0 {
+		basic.SpendLimit = spendLimit
+	}
+	if expiration > 0 {
+		t := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &t
+	}
+
+	return basic, nil
+}
+
+// newMsgGrantAllowance creates a new MsgGrantAllowance of the allowance from the
+// granter to the grantee, restricted to the allowed messages if any.
+func newMsgGrantAllowance(
+	granter, grantee common.Address,
+	allowance feegranttypes.FeeAllowanceI,
+	allowedMessagesArg interface{},
+	addrCdc address.Codec,
+) (*feegranttypes.MsgGrantAllowance, error) {
+	allowedMessages, ok := allowedMessagesArg.([]string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidAllowedMessages, allowedMessagesArg)
+	}
+
+	if len(allowedMessages) > 0 {
+		var err error
+		allowance, err = feegranttypes.NewAllowedMsgAllowance(allowance, allowedMessages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidAllowance, fmt.Sprintf("%T", allowance))
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := granterGranteeStrings(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegranttypes.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}, nil
+}
+
+// parseCoins parses a list of coins argument into sorted sdk.Coins.
+func parseCoins(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmn.NewSdkCoinsFromCoins(coins)
+}
+
+// checkGranterGranteeArgs checks the granter and grantee addresses, which are the
+// first two arguments of the feegrant methods.
+func checkGranterGranteeArgs(args []interface{}) (common.Address, common.Address, error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
+
+// granterGranteeStrings returns the granter and grantee addresses encoded with the
+// address codec.
+func granterGranteeStrings(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
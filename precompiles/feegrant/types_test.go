@@ -0,0 +1,331 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	denom      = "stake"
+	msgTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+var (
+	granter = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee = common.HexToAddress("0x0987654321098765432109876543210987654321")
+)
+
+func TestNewMsgGrantAllowance(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	spendLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1e18)}}
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+		want    feegranttypes.FeeAllowanceI
+	}{
+		{
+			name: "valid without limit",
+			args: []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{}},
+			want: &feegranttypes.BasicAllowance{},
+		},
+		{
+			name: "valid with spend limit and expiration",
+			args: []interface{}{granter, grantee, spendLimit, expiration, []string{}},
+			want: &feegranttypes.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1e18))),
+				Expiration: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+			},
+		},
+		{
+			name: "valid with allowed messages",
+			args: []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{msgTypeURL}},
+			want: func() feegranttypes.FeeAllowanceI {
+				allowance, err := feegranttypes.NewAllowedMsgAllowance(&feegranttypes.BasicAllowance{}, []string{msgTypeURL})
+				require.NoError(t, err)
+				return allowance
+			}(),
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "invalid granter type",
+			args:    []interface{}{"not-an-address", grantee, []cmn.Coin{}, int64(0), []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, "not-an-address"),
+		},
+		{
+			name:    "empty grantee address",
+			args:    []interface{}{granter, common.Address{}, []cmn.Coin{}, int64(0), []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			name:    "invalid spend limit",
+			args:    []interface{}{granter, grantee, "not-coins", int64(0), []string{}},
+			wantErr: true,
+			errMsg:  "invalid spend limit",
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, int64(-1), []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, int64(-1)),
+		},
+		{
+			name:    "invalid allowed messages",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, int64(0), "not-a-list"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAllowedMessages, "not-a-list"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, returnGranter, returnGrantee, err := NewMsgGrantAllowance(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+				require.Equal(t, granter, returnGranter)
+				require.Equal(t, grantee, returnGrantee)
+				require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), msg.Granter)
+				require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), msg.Grantee)
+
+				allowance, err := msg.GetFeeAllowanceI()
+				require.NoError(t, err)
+				require.Equal(t, tt.want, allowance)
+			}
+		})
+	}
+}
+
+func TestNewMsgGrantPeriodicAllowance(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	periodLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1e18)}}
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granter, grantee, []cmn.Coin{}, int64(0), int64(3600), periodLimit, []string{}},
+		},
+		{
+			name: "valid with expiration after the first period",
+			args: []interface{}{granter, grantee, []cmn.Coin{}, blockTime.Add(2 * time.Hour).Unix(), int64(3600), periodLimit, []string{}},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			name:    "zero period",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, int64(0), int64(0), periodLimit, []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPeriod, int64(0)),
+		},
+		{
+			name:    "empty period spend limit",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, int64(0), int64(3600), []cmn.Coin{}, []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPeriodSpendLimit, []cmn.Coin{}),
+		},
+		{
+			name:    "first period ends after the expiration",
+			args:    []interface{}{granter, grantee, []cmn.Coin{}, blockTime.Add(time.Minute).Unix(), int64(3600), periodLimit, []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrPeriodResetAfterExpiration, int64(3600), blockTime.Add(time.Minute).Unix()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, returnGranter, returnGrantee, err := NewMsgGrantPeriodicAllowance(tt.args, blockTime, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+				require.Equal(t, granter, returnGranter)
+				require.Equal(t, grantee, returnGrantee)
+
+				allowance, err := msg.GetFeeAllowanceI()
+				require.NoError(t, err)
+				periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+				require.True(t, ok)
+				limit := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1e18)))
+				require.Equal(t, time.Hour, periodic.Period)
+				require.Equal(t, limit, periodic.PeriodSpendLimit)
+				require.Equal(t, limit, periodic.PeriodCanSpend)
+				require.Equal(t, blockTime.Add(time.Hour), periodic.PeriodReset)
+			}
+		})
+	}
+}
+
+func TestNewMsgRevokeAllowance(t *testing.T) {
+	addrCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granter, grantee},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "empty granter address",
+			args:    []interface{}{common.Address{}, grantee},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, returnGranter, returnGrantee, err := NewMsgRevokeAllowance(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, granter, returnGranter)
+				require.Equal(t, grantee, returnGrantee)
+				require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), msg.Granter)
+				require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), msg.Grantee)
+			}
+		})
+	}
+}
+
+func TestNewAllowanceData(t *testing.T) {
+	expiration := time.Unix(1_700_000_000, 0).UTC()
+	reset := expiration.Add(-time.Hour)
+	limit := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1e18)))
+	expLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1e18)}}
+
+	periodic := &feegranttypes.PeriodicAllowance{
+		Basic:            feegranttypes.BasicAllowance{Expiration: &expiration},
+		Period:           time.Hour,
+		PeriodSpendLimit: limit,
+		PeriodCanSpend:   limit,
+		PeriodReset:      reset,
+	}
+	allowed, err := feegranttypes.NewAllowedMsgAllowance(periodic, []string{msgTypeURL})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		allowance feegranttypes.FeeAllowanceI
+		want      AllowanceData
+	}{
+		{
+			name:      "basic allowance",
+			allowance: &feegranttypes.BasicAllowance{SpendLimit: limit},
+			want: AllowanceData{
+				AllowanceType: sdk.MsgTypeURL(&feegranttypes.BasicAllowance{}),
+				SpendLimit:    expLimit,
+			},
+		},
+		{
+			name:      "periodic allowance",
+			allowance: periodic,
+			want: AllowanceData{
+				AllowanceType:    sdk.MsgTypeURL(&feegranttypes.PeriodicAllowance{}),
+				SpendLimit:       []cmn.Coin{},
+				Expiration:       expiration.Unix(),
+				Period:           3600,
+				PeriodSpendLimit: expLimit,
+				PeriodCanSpend:   expLimit,
+				PeriodReset:      reset.Unix(),
+			},
+		},
+		{
+			name:      "allowed messages allowance",
+			allowance: allowed,
+			want: AllowanceData{
+				AllowanceType:    sdk.MsgTypeURL(&feegranttypes.PeriodicAllowance{}),
+				SpendLimit:       []cmn.Coin{},
+				Expiration:       expiration.Unix(),
+				Period:           3600,
+				PeriodSpendLimit: expLimit,
+				PeriodCanSpend:   expLimit,
+				PeriodReset:      reset.Unix(),
+				AllowedMessages:  []string{msgTypeURL},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant, err := feegranttypes.NewGrant(granter.Bytes(), grantee.Bytes(), tt.allowance)
+			require.NoError(t, err)
+
+			data, err := NewAllowanceData(&grant)
+			require.NoError(t, err)
+
+			tt.want.Granter = granter
+			tt.want.Grantee = grantee
+			require.Equal(t, tt.want, data)
+		})
+	}
+
+	t.Run("unknown allowance", func(t *testing.T) {
+		allowanceAny, err := codectypes.NewAnyWithValue(&feegranttypes.MsgRevokeAllowance{})
+		require.NoError(t, err)
+
+		grant := feegranttypes.Grant{
+			Granter:   sdk.AccAddress(granter.Bytes()).String(),
+			Grantee:   sdk.AccAddress(grantee.Bytes()).String(),
+			Allowance: allowanceAny,
+		}
+		_, err = NewAllowanceData(&grant)
+		require.ErrorContains(t, err, "invalid allowance")
+	})
+}
@@ -25,6 +25,36 @@ struct Hop {
     string channelId;
 }
 
+/// @dev TransferPayload defines a fungible token transfer carried by an IBC v2 packet.
+struct TransferPayload {
+    /// denomination of the Coin to be transferred to the receiver.
+    string denom;
+    /// amount of the Coin to be transferred to the receiver.
+    uint256 amount;
+    /// bech32 address of the receiver.
+    string receiver;
+    /// encoding of the payload value. Defaults to application/json when empty.
+    string encoding;
+}
+
+/// @dev ClientInfo contains the type, status and latest height of an IBC light client.
+struct ClientInfo {
+    /// type of the light client, e.g. 07-tendermint.
+    string clientType;
+    /// status of the light client, e.g. Active, Expired, Frozen or Unknown.
+    string status;
+    /// latest height the light client was updated to.
+    Height latestHeight;
+}
+
+/// @dev CounterpartyInfo contains the counterparty of an IBC v2 client.
+struct CounterpartyInfo {
+    /// identifier of the client on the counterparty chain.
+    string clientId;
+    /// merkle path prefix under which the counterparty stores IBC v2 commitments.
+    bytes[] merklePrefix;
+}
+
 /// @author Evmos Team
 /// @title ICS20 Transfer Precompiled Contract
 /// @dev The interface through which solidity contracts will interact with IBC Transfer (ICS20)
@@ -33,8 +63,8 @@ interface ICS20I {
     /// @dev Emitted when an ICS-20 transfer is executed.
     /// @param sender The address of the sender.
     /// @param receiver The address of the receiver.
-    /// @param sourcePort The source port of the IBC transaction, For v2 packets, leave it empty.
-    /// @param sourceChannel The source channel of the IBC transaction, For v2 packets, set the client ID.
+    /// @param sourcePort The source port of the IBC transaction, For v2 packets, it is empty.
+    /// @param sourceChannel The source channel of the IBC transaction, For v2 packets, it is the client ID.
     /// @param denom The denomination of the tokens transferred.
     /// @param amount The amount of tokens transferred.
     /// @param memo The IBC transaction memo.
@@ -61,6 +91,7 @@ interface ICS20I {
     /// The timeout is disabled when set to 0
     /// @param memo optional memo
     /// @return nextSequence sequence number of the transfer packet sent
+    /// @notice Setting an IBC v2 client ID as sourceChannel is deprecated, use transferV2 to send through an IBC v2 client.
     function transfer(
         string memory sourcePort,
         string memory sourceChannel,
@@ -73,6 +104,20 @@ interface ICS20I {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferV2 defines a method for performing an IBC v2 transfer.
+    /// The caller is the sender of every payload of the packet.
+    /// @param clientId the client by which the packet will be sent
+    /// @param payloads the tokens to be transferred, each one is sent in its own payload
+    /// @param timeoutTimestamp the timeout timestamp in absolute seconds since unix epoch
+    /// @param memo optional memo, set on every payload
+    /// @return sequence sequence number of the packet sent
+    function transferV2(
+        string memory clientId,
+        TransferPayload[] memory payloads,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 sequence);
+
     /// @dev denoms Defines a method for returning all denoms.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denoms(
@@ -95,4 +140,14 @@ interface ICS20I {
         string memory trace
     ) external view returns (string memory hash);
 
+    /// @dev ClientInfo defines a method for returning the type, status and latest height of a client.
+    function clientInfo(
+        string memory clientId
+    ) external view returns (ClientInfo memory clientInfo);
+
+    /// @dev CounterpartyInfo defines a method for returning the counterparty of an IBC v2 client.
+    function counterpartyInfo(
+        string memory clientId
+    ) external view returns (CounterpartyInfo memory counterpartyInfo);
+
 }
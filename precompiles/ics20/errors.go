@@ -21,4 +21,10 @@ const (
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
 	// ErrDenomNotFound is raised when the denom for the specified request does not exist.
 	ErrDenomNotFound = "denomination not found"
+	// ErrInvalidClientID is raised when the client ID is invalid.
+	ErrInvalidClientID = "invalid client ID: %v"
+	// ErrEmptyPayloads is raised when an IBC v2 transfer has no payloads.
+	ErrEmptyPayloads = "payloads cannot be empty"
+	// ErrTokensPayloadsMismatch is raised when the number of tokens does not match the number of payloads.
+	ErrTokensPayloadsMismatch = "got %d tokens for %d payloads"
 )
@@ -40,10 +40,13 @@ type Precompile struct {
 	cmn.Precompile
 
 	abi.ABI
-	bankKeeper     cmn.BankKeeper
-	stakingKeeper  cmn.StakingKeeper
-	transferKeeper cmn.TransferKeeper
-	channelKeeper  cmn.ChannelKeeper
+	bankKeeper      cmn.BankKeeper
+	stakingKeeper   cmn.StakingKeeper
+	transferKeeper  cmn.TransferKeeper
+	channelKeeper   cmn.ChannelKeeper
+	channelKeeperV2 cmn.ChannelKeeperV2
+	clientKeeper    cmn.ClientKeeper
+	clientKeeperV2  cmn.ClientKeeperV2
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -53,6 +56,9 @@ func NewPrecompile(
 	stakingKeeper cmn.StakingKeeper,
 	transferKeeper cmn.TransferKeeper,
 	channelKeeper cmn.ChannelKeeper,
+	channelKeeperV2 cmn.ChannelKeeperV2,
+	clientKeeper cmn.ClientKeeper,
+	clientKeeperV2 cmn.ClientKeeperV2,
 ) *Precompile {
 	return &Precompile{
 		Precompile: cmn.Precompile{
@@ -61,11 +67,14 @@ func NewPrecompile(
 			ContractAddress:       common.HexToAddress(evmtypes.ICS20PrecompileAddress),
 			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
 		},
-		ABI:            ABI,
-		bankKeeper:     bankKeeper,
-		transferKeeper: transferKeeper,
-		channelKeeper:  channelKeeper,
-		stakingKeeper:  stakingKeeper,
+		ABI:             ABI,
+		bankKeeper:      bankKeeper,
+		transferKeeper:  transferKeeper,
+		channelKeeper:   channelKeeper,
+		channelKeeperV2: channelKeeperV2,
+		clientKeeper:    clientKeeper,
+		clientKeeperV2:  clientKeeperV2,
+		stakingKeeper:   stakingKeeper,
 	}
 }
 
@@ -105,6 +114,8 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, contract, stateDB, method, args)
+	case TransferV2Method:
+		bz, err = p.TransferV2(ctx, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomMethod:
 		bz, err = p.Denom(ctx, contract, method, args)
@@ -112,6 +123,10 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 		bz, err = p.Denoms(ctx, contract, method, args)
 	case DenomHashMethod:
 		bz, err = p.DenomHash(ctx, contract, method, args)
+	case ClientInfoMethod:
+		bz, err = p.ClientInfo(ctx, contract, method, args)
+	case CounterpartyInfoMethod:
+		bz, err = p.CounterpartyInfo(ctx, contract, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -123,9 +138,10 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferV2
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case TransferMethod:
+	case TransferMethod, TransferV2Method:
 		return true
 	default:
 		return false
@@ -1,12 +1,14 @@
 package ics20
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,6 +23,12 @@ const (
 	// DenomHashMethod defines the ABI method name for the ICS20 DenomHash
 	// query.
 	DenomHashMethod = "denomHash"
+	// ClientInfoMethod defines the ABI method name for the ICS20 ClientInfo
+	// query.
+	ClientInfoMethod = "clientInfo"
+	// CounterpartyInfoMethod defines the ABI method name for the ICS20
+	// CounterpartyInfo query.
+	CounterpartyInfoMethod = "counterpartyInfo"
 )
 
 // Denom returns the requested denomination information.
@@ -90,3 +98,47 @@ func (p Precompile) DenomHash(
 
 	return method.Outputs.Pack(res.Hash)
 }
+
+// ClientInfo returns the type, status and latest height of the requested client.
+func (p Precompile) ClientInfo(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := NewClientIDArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidClientID, err)
+	}
+
+	info := ClientInfo{
+		ClientType:   clientType,
+		Status:       p.clientKeeper.GetClientStatus(ctx, clientID).String(),
+		LatestHeight: p.clientKeeper.GetClientLatestHeight(ctx, clientID),
+	}
+
+	return method.Outputs.Pack(info)
+}
+
+// CounterpartyInfo returns the counterparty of the requested IBC v2 client.
+func (p Precompile) CounterpartyInfo(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := NewClientIDArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// if the client has no counterparty, return an empty struct
+	info, _ := p.clientKeeperV2.GetClientCounterparty(ctx, clientID)
+
+	return method.Outputs.Pack(NewCounterpartyInfo(info))
+}
@@ -23,6 +23,9 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferV2Method defines the ABI method name for the ICS20 TransferV2
+	// transaction.
+	TransferV2Method = "transferV2"
 )
 
 // validateV1TransferChannel does the following validation on an ibc v1 channel specified in a MsgTransfer:
@@ -105,7 +108,9 @@ func (p *Precompile) Transfer(
 		if err := p.validateV1TransferChannel(ctx, msg); err != nil {
 			return nil, err
 		}
-		// otherwise, it’s a v2 packet, so perform client ID validation
+		// otherwise, it’s a v2 packet, so perform client ID validation.
+		// Deprecated: the v2 transfers should be sent by TransferV2, this path is
+		// kept for the deployed contracts.
 	} else if v2ClientIDErr := host.ClientIdentifierValidator(msg.SourceChannel); v2ClientIDErr != nil {
 		return nil, errorsmod.Wrapf(
 			channeltypes.ErrInvalidChannel,
@@ -141,3 +146,73 @@ func (p *Precompile) Transfer(
 
 	return method.Outputs.Pack(res.Sequence)
 }
+
+// TransferV2 implements the ICS20 transfer transactions over IBC v2. The caller
+// sends each token in its own payload of a single packet.
+func (p *Precompile) TransferV2(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := NewTransferV2Input(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := contract.Caller()
+	senderAddress := sdk.AccAddress(sender.Bytes()).String()
+
+	coins := make([]sdk.Coin, len(input.Payloads))
+	tokens := make([]transfertypes.Token, len(input.Payloads))
+	for i, payload := range input.Payloads {
+		coins[i], err = payload.Coin()
+		if err != nil {
+			return nil, err
+		}
+
+		// Using transfertypes.UnboundedSpendLimit sends the entire spendable balance of the denom.
+		if coins[i].Amount.Equal(transfertypes.UnboundedSpendLimit()) {
+			coins[i].Amount = p.bankKeeper.SpendableCoin(ctx, sender.Bytes(), coins[i].Denom).Amount
+			if coins[i].Amount.IsZero() {
+				return nil, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "empty spendable balance for %s", coins[i].Denom)
+			}
+		}
+
+		// resolve the trace of IBC vouchers, which is sent in the packet data
+		tokens[i], err = p.transferKeeper.TokenFromCoin(ctx, coins[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	msg, err := NewMsgSendPacket(input, tokens, senderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.channelKeeperV2.SendPacket(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, payload := range input.Payloads {
+		if err = EmitIBCTransferEvent(
+			ctx,
+			stateDB,
+			p.Events[EventTypeIBCTransfer],
+			p.Address(),
+			sender,
+			payload.Receiver,
+			"",
+			msg.SourceClient,
+			coins[i],
+			input.Memo,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
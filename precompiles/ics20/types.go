@@ -12,6 +12,8 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	clienttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -68,6 +70,47 @@ type DenomsResponse struct {
 	PageResponse query.PageResponse
 }
 
+// TransferPayload defines the token transferred by a payload of an IBC v2 packet.
+type TransferPayload struct {
+	Denom    string
+	Amount   *big.Int
+	Receiver string
+	Encoding string
+}
+
+// TransferV2Input defines the input arguments of the transferV2 method.
+type TransferV2Input struct {
+	ClientID         string `abi:"clientId"`
+	Payloads         []TransferPayload
+	TimeoutTimestamp uint64
+	Memo             string
+}
+
+// ClientInfo defines the data for the client info response.
+type ClientInfo struct {
+	ClientType   string
+	Status       string
+	LatestHeight clienttypes.Height
+}
+
+// CounterpartyInfo defines the data for the counterparty info response.
+type CounterpartyInfo struct {
+	ClientID     string `abi:"clientId"`
+	MerklePrefix [][]byte
+}
+
+// NewCounterpartyInfo returns the counterparty info response of an IBC v2 client.
+func NewCounterpartyInfo(info clienttypesv2.CounterpartyInfo) CounterpartyInfo {
+	merklePrefix := info.MerklePrefix
+	if merklePrefix == nil {
+		merklePrefix = [][]byte{}
+	}
+	return CounterpartyInfo{
+		ClientID:     info.ClientId,
+		MerklePrefix: merklePrefix,
+	}
+}
+
 // height is a struct used to parse the TimeoutHeight parameter
 // used as input in the transfer method
 type height struct {
@@ -166,6 +209,104 @@ func CreateAndValidateMsgTransfer(
 	return msg, nil
 }
 
+// NewTransferV2Input returns the input arguments of the transferV2 method.
+func NewTransferV2Input(method *abi.Method, args []interface{}) (*TransferV2Input, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input TransferV2Input
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to TransferV2Input struct: %s", err)
+	}
+
+	if len(input.Payloads) == 0 {
+		return nil, errors.New(ErrEmptyPayloads)
+	}
+
+	return &input, nil
+}
+
+// Coin returns the coin transferred by the payload.
+func (tp TransferPayload) Coin() (sdk.Coin, error) {
+	if tp.Amount == nil {
+		return sdk.Coin{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, tp.Amount)
+	}
+
+	// Use instance to prevent errors on denom or amount
+	coin := sdk.Coin{
+		Denom:  tp.Denom,
+		Amount: math.NewIntFromBigInt(tp.Amount),
+	}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(transfertypes.ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	return coin, nil
+}
+
+// NewMsgSendPacket returns a new IBC v2 send packet message with a transfer payload
+// for each of the given tokens, and run validate basic.
+func NewMsgSendPacket(
+	input *TransferV2Input,
+	tokens []transfertypes.Token,
+	senderAddress string,
+) (*channeltypesv2.MsgSendPacket, error) {
+	if len(tokens) != len(input.Payloads) {
+		return nil, fmt.Errorf(ErrTokensPayloadsMismatch, len(tokens), len(input.Payloads))
+	}
+
+	payloads := make([]channeltypesv2.Payload, len(tokens))
+	for i, token := range tokens {
+		packetData := transfertypes.NewFungibleTokenPacketData(
+			token.Denom.Path(),
+			token.Amount,
+			senderAddress,
+			input.Payloads[i].Receiver,
+			input.Memo,
+		)
+		if err := packetData.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		encoding := input.Payloads[i].Encoding
+		if encoding == "" {
+			encoding = transfertypes.EncodingJSON
+		}
+
+		data, err := transfertypes.MarshalPacketData(packetData, transfertypes.V1, encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		payloads[i] = channeltypesv2.NewPayload(
+			transfertypes.PortID, transfertypes.PortID,
+			transfertypes.V1, encoding, data,
+		)
+	}
+
+	msg := channeltypesv2.NewMsgSendPacket(input.ClientID, input.TimeoutTimestamp, senderAddress, payloads...)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewClientIDArg returns the client ID passed to the client queries.
+func NewClientIDArg(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	clientID, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidClientID, args[0])
+	}
+
+	return clientID, nil
+}
+
 // NewDenomRequest returns a new denom request from the given arguments.
 func NewDenomRequest(args []interface{}) (*transfertypes.QueryDenomRequest, error) {
 	if len(args) != 1 {
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The ICS27I contract's address.
+address constant ICS27_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The ICS27 contract's instance.
+ICS27I constant ICS27_CONTRACT = ICS27I(ICS27_PRECOMPILE_ADDRESS);
+
+/// @author CosmosLabs
+/// @title ICS27 Interchain Accounts Controller Precompiled Contract
+/// @dev The interface through which solidity contracts will register and drive
+/// interchain accounts (ICS27) on counterparty chains.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface ICS27I {
+    /// @dev Emitted when the registration of an interchain account is initiated.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection ID on the controller chain.
+    /// @param portId The controller port ID of the owner.
+    /// @param channelId The ID of the channel opened for the interchain account.
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string portId,
+        string channelId
+    );
+
+    /// @dev Emitted when a transaction is sent to be executed by an interchain account.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection ID on the controller chain.
+    /// @param sequence The sequence of the packet sent.
+    /// @param memo The memo of the packet.
+    event SendTx(
+        address indexed owner,
+        string connectionId,
+        uint64 sequence,
+        string memo
+    );
+
+    /// @dev registerInterchainAccount initiates the registration of an interchain account
+    /// on the host chain of the connection. The account address is available once the
+    /// channel handshake is completed by the relayers.
+    /// @param owner The address of the owner of the interchain account, it must be the caller.
+    /// @param connectionId The connection ID on the controller chain.
+    /// @param version The ICS27 channel version, the default metadata is used when empty.
+    /// @param ordered Whether the channel is ORDERED, it is UNORDERED otherwise.
+    /// @return channelId The ID of the channel opened for the interchain account.
+    function registerInterchainAccount(
+        address owner,
+        string memory connectionId,
+        string memory version,
+        bool ordered
+    ) external returns (string memory channelId);
+
+    /// @dev sendTx sends a CosmosTx to be executed by the interchain account of the
+    /// owner on the host chain. The acknowledgement and timeout of the packet can be
+    /// received through the ICallbacks interface with a `src_callback` memo.
+    /// @param owner The address of the owner of the interchain account, it must be the caller.
+    /// @param connectionId The connection ID on the controller chain.
+    /// @param data The CosmosTx encoded with the encoding of the channel.
+    /// @param memo The memo of the packet.
+    /// @param relativeTimeout The timeout of the packet relative to the block time, in nanoseconds.
+    /// @return sequence The sequence of the packet sent.
+    function sendTx(
+        address owner,
+        string memory connectionId,
+        bytes memory data,
+        string memory memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev getInterchainAccountAddress returns the address of the interchain account of
+    /// the owner on the host chain.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection ID on the controller chain.
+    /// @return accountAddress The address of the interchain account on the host chain,
+    /// empty if the account is not registered.
+    function getInterchainAccountAddress(
+        address owner,
+        string memory connectionId
+    ) external view returns (string memory accountAddress);
+}
@@ -0,0 +1,18 @@
+package ics27
+
+const (
+	// ErrInvalidOwner is raised when the owner is invalid.
+	ErrInvalidOwner = "invalid owner: %v"
+	// ErrInvalidConnectionID is raised when the connection ID is invalid.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrInvalidVersion is raised when the channel version is invalid.
+	ErrInvalidVersion = "invalid version: %v"
+	// ErrInvalidOrdering is raised when the channel ordering is invalid.
+	ErrInvalidOrdering = "invalid ordering: %v"
+	// ErrInvalidPacketData is raised when the packet data is invalid.
+	ErrInvalidPacketData = "invalid packet data: %v"
+	// ErrInvalidMemo is raised when the memo is invalid.
+	ErrInvalidMemo = "invalid memo: %v"
+	// ErrInvalidTimeout is raised when the relative timeout is invalid.
+	ErrInvalidTimeout = "invalid relative timeout: %v"
+)
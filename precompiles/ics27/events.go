@@ -0,0 +1,83 @@
+package ics27
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the ICS27
+	// RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the ICS27 SendTx transaction.
+	EventTypeSendTx = "SendTx"
+)
+
+// EmitRegisterInterchainAccountEvent creates a new event emitted on a
+// RegisterInterchainAccount transaction.
+func (p Precompile) EmitRegisterInterchainAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, portID, channelID string,
+) error {
+	event := p.Events[EventTypeRegisterInterchainAccount]
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	return p.emitEvent(ctx, stateDB, event, owner, arguments, connectionID, portID, channelID)
+}
+
+// EmitSendTxEvent creates a new event emitted on a SendTx transaction.
+func (p Precompile) EmitSendTxEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID string,
+	sequence uint64,
+	memo string,
+) error {
+	event := p.Events[EventTypeSendTx]
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	return p.emitEvent(ctx, stateDB, event, owner, arguments, connectionID, sequence, memo)
+}
+
+// emitEvent adds the log of an event whose only indexed input is the owner.
+func (p Precompile) emitEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	event abi.Event,
+	owner common.Address,
+	arguments abi.Arguments,
+	values ...interface{},
+) error {
+	// Prepare the event topics
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	packed, err := arguments.Pack(values...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package ics27
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GetInterchainAccountAddressMethod defines the ABI method name for the ICS27
+	// GetInterchainAccountAddress query.
+	GetInterchainAccountAddressMethod = "getInterchainAccountAddress"
+)
+
+// GetInterchainAccountAddress returns the address of the interchain account of the
+// owner on the host chain of the connection, empty if it is not registered.
+func (p Precompile) GetInterchainAccountAddress(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewInterchainAccountRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.controllerQuerier.InterchainAccount(ctx, req)
+	if err != nil {
+		// if the account is not registered, return an empty address
+		if status.Code(err) == codes.NotFound {
+			return method.Outputs.Pack("")
+		}
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Address)
+}
@@ -0,0 +1,100 @@
+package ics27
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICS27
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICS27 SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the registration of an interchain account
+// owned by the caller on the host chain of the connection.
+func (p *Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgRegisterInterchainAccount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ owner: %s, connection_id: %s, version: %s, ordering: %s }",
+			owner, msg.ConnectionId, msg.Version, msg.Ordering,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	res, err := p.controllerMsgServer.RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.PortId, res.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId)
+}
+
+// SendTx sends a transaction to be executed by the interchain account of the caller
+// on the host chain of the connection.
+func (p *Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgSendTx(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ owner: %s, connection_id: %s, relative_timeout: %d }",
+			owner, msg.ConnectionId, msg.RelativeTimeout,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	res, err := p.controllerMsgServer.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendTxEvent(ctx, stateDB, owner, msg.ConnectionId, res.Sequence, msg.PacketData.Memo); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
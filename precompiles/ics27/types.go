@@ -0,0 +1,139 @@
+package ics27
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventRegisterInterchainAccount is the event type emitted when the registration of
+// an interchain account is initiated.
+type EventRegisterInterchainAccount struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive
+	PortId       string //nolint:revive
+	ChannelId    string //nolint:revive
+}
+
+// EventSendTx is the event type emitted when a transaction is sent to an interchain
+// account.
+type EventSendTx struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive
+	Sequence     uint64
+	Memo         string
+}
+
+// NewMsgRegisterInterchainAccount returns a new register interchain account message
+// from the given arguments.
+func NewMsgRegisterInterchainAccount(args []interface{}) (*icacontrollertypes.MsgRegisterInterchainAccount, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	version, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVersion, args[2])
+	}
+
+	ordered, ok := args[3].(bool)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidOrdering, args[3])
+	}
+	ordering := channeltypes.UNORDERED
+	if ordered {
+		ordering = channeltypes.ORDERED
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, sdk.AccAddress(owner.Bytes()).String(), version, ordering)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewMsgSendTx returns a new send tx message from the given arguments.
+func NewMsgSendTx(args []interface{}) (*icacontrollertypes.MsgSendTx, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	data, ok := args[2].([]byte)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidPacketData, args[2])
+	}
+
+	memo, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[3])
+	}
+
+	relativeTimeout, ok := args[4].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeout, args[4])
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(sdk.AccAddress(owner.Bytes()).String(), connectionID, relativeTimeout, packetData)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewInterchainAccountRequest returns a new interchain account query request from
+// the given arguments.
+func NewInterchainAccountRequest(args []interface{}) (*icacontrollertypes.QueryInterchainAccountRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	return &icacontrollertypes.QueryInterchainAccountRequest{
+		Owner:        sdk.AccAddress(owner.Bytes()).String(),
+		ConnectionId: connectionID,
+	}, nil
+}
@@ -0,0 +1,184 @@
+package ics27
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	connectionID := "connection-0"
+
+	tests := []struct {
+		name         string
+		args         []interface{}
+		wantErr      bool
+		errMsg       string
+		wantOrdering channeltypes.Order
+	}{
+		{
+			name:         "valid unordered",
+			args:         []interface{}{owner, connectionID, "", false},
+			wantOrdering: channeltypes.UNORDERED,
+		},
+		{
+			name:         "valid ordered",
+			args:         []interface{}{owner, connectionID, "", true},
+			wantOrdering: channeltypes.ORDERED,
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "invalid owner type",
+			args:    []interface{}{"not-an-address", connectionID, "", false},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidOwner, "not-an-address"),
+		},
+		{
+			name:    "empty owner address",
+			args:    []interface{}{common.Address{}, connectionID, "", false},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidOwner, common.Address{}),
+		},
+		{
+			name:    "invalid connection ID type",
+			args:    []interface{}{owner, 0, "", false},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidConnectionID, 0),
+		},
+		{
+			name:    "invalid version type",
+			args:    []interface{}{owner, connectionID, 0, false},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidVersion, 0),
+		},
+		{
+			name:    "invalid ordering type",
+			args:    []interface{}{owner, connectionID, "", "ordered"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidOrdering, "ordered"),
+		},
+		{
+			name:    "invalid connection ID",
+			args:    []interface{}{owner, "", "", false},
+			wantErr: true,
+			errMsg:  "invalid connection ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, returnAddr, err := NewMsgRegisterInterchainAccount(tt.args)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+				require.Equal(t, owner, returnAddr)
+				require.Equal(t, sdk.AccAddress(owner.Bytes()).String(), msg.Owner)
+				require.Equal(t, connectionID, msg.ConnectionId)
+				require.Equal(t, tt.wantOrdering, msg.Ordering)
+			}
+		})
+	}
+}
+
+func TestNewMsgSendTx(t *testing.T) {
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	connectionID := "connection-0"
+	data := []byte("cosmos tx")
+	memo := "memo"
+	relativeTimeout := uint64(600_000_000_000)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{owner, connectionID, data, memo, relativeTimeout},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "empty owner address",
+			args:    []interface{}{common.Address{}, connectionID, data, memo, relativeTimeout},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidOwner, common.Address{}),
+		},
+		{
+			name:    "invalid data type",
+			args:    []interface{}{owner, connectionID, "data", memo, relativeTimeout},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPacketData, "data"),
+		},
+		{
+			name:    "invalid memo type",
+			args:    []interface{}{owner, connectionID, data, 0, relativeTimeout},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMemo, 0),
+		},
+		{
+			name:    "invalid timeout type",
+			args:    []interface{}{owner, connectionID, data, memo, "timeout"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidTimeout, "timeout"),
+		},
+		{
+			name:    "empty data",
+			args:    []interface{}{owner, connectionID, []byte{}, memo, relativeTimeout},
+			wantErr: true,
+			errMsg:  "invalid interchain account packet data",
+		},
+		{
+			name:    "zero timeout",
+			args:    []interface{}{owner, connectionID, data, memo, uint64(0)},
+			wantErr: true,
+			errMsg:  "relative timeout cannot be zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, returnAddr, err := NewMsgSendTx(tt.args)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, msg)
+				require.Equal(t, owner, returnAddr)
+				require.Equal(t, sdk.AccAddress(owner.Bytes()).String(), msg.Owner)
+				require.Equal(t, connectionID, msg.ConnectionId)
+				require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type)
+				require.Equal(t, data, msg.PacketData.Data)
+				require.Equal(t, memo, msg.PacketData.Memo)
+				require.Equal(t, relativeTimeout, msg.RelativeTimeout)
+			}
+		})
+	}
+}
@@ -9,12 +9,17 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	clientkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
+	channelkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/keeper"
 
 	"cosmossdk.io/core/address"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -25,7 +30,7 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
+	AddressCodec       address.Codec // used by gov/staking/authz/feegrant
 	ValidatorAddrCodec address.Codec // used by slashing
 	ConsensusAddrCodec address.Codec // used by slashing
 }
@@ -70,9 +75,14 @@ func DefaultStaticPrecompiles(
 	erc20Keeper *erc20Keeper.Keeper,
 	transferKeeper *transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
+	channelKeeperV2 *channelkeeperv2.Keeper,
 	clientKeeper ibcutils.ClientKeeper,
+	clientKeeperV2 *clientkeeperv2.Keeper,
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -83,10 +93,13 @@ func DefaultStaticPrecompiles(
 		WithStakingPrecompile(stakingKeeper, bankKeeper, opts...).
 		WithDistributionPrecompile(distributionKeeper, stakingKeeper, bankKeeper, opts...).
 		WithICS02Precompile(codec, clientKeeper).
-		WithICS20Precompile(bankKeeper, stakingKeeper, transferKeeper, channelKeeper).
+		WithICS20Precompile(bankKeeper, stakingKeeper, transferKeeper, channelKeeper, channelKeeperV2, clientKeeper, clientKeeperV2).
+		WithICS27Precompile(icaControllerKeeper, bankKeeper).
 		WithBankPrecompile(bankKeeper, erc20Keeper).
 		WithGovPrecompile(govKeeper, bankKeeper, codec, opts...).
-		WithSlashingPrecompile(slashingKeeper, bankKeeper, opts...)
+		WithSlashingPrecompile(slashingKeeper, bankKeeper, opts...).
+		WithAuthzPrecompile(authzKeeper, stakingKeeper, bankKeeper, opts...).
+		WithFeegrantPrecompile(feegrantKeeper, bankKeeper, opts...)
 
 	return map[common.Address]vm.PrecompiledContract(precompiles)
 }
@@ -8,21 +8,30 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	ibcutils "github.com/cosmos/evm/ibc"
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
 	ics02precompile "github.com/cosmos/evm/precompiles/ics02"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
+	ics27precompile "github.com/cosmos/evm/precompiles/ics27"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	clientkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
+	channelkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/keeper"
+
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -119,18 +128,38 @@ func (s StaticPrecompiles) WithICS20Precompile(
 	stakingKeeper stakingkeeper.Keeper,
 	transferKeeper *transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
+	channelKeeperV2 *channelkeeperv2.Keeper,
+	clientKeeper ibcutils.ClientKeeper,
+	clientKeeperV2 *clientkeeperv2.Keeper,
 ) StaticPrecompiles {
 	ibcTransferPrecompile := ics20precompile.NewPrecompile(
 		bankKeeper,
 		stakingKeeper,
 		transferKeeper,
 		channelKeeper,
+		channelKeeperV2,
+		clientKeeper,
+		clientKeeperV2,
 	)
 
 	s[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	return s
 }
 
+func (s StaticPrecompiles) WithICS27Precompile(
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+) StaticPrecompiles {
+	icaPrecompile := ics27precompile.NewPrecompile(
+		icacontrollerkeeper.NewMsgServerImpl(icaControllerKeeper),
+		icaControllerKeeper,
+		bankKeeper,
+	)
+
+	s[icaPrecompile.Address()] = icaPrecompile
+	return s
+}
+
 func (s StaticPrecompiles) WithBankPrecompile(
 	bankKeeper cmn.BankKeeper,
 	erc20Keeper *erc20Keeper.Keeper,
@@ -184,3 +213,47 @@ func (s StaticPrecompiles) WithSlashingPrecompile(
 	s[slashingPrecompile.Address()] = slashingPrecompile
 	return s
 }
+
+func (s StaticPrecompiles) WithAuthzPrecompile(
+	authzKeeper authzkeeper.Keeper,
+	stakingKeeper stakingkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	authzPrecompile := authzprecompile.NewPrecompile(
+		authzKeeper,
+		authzKeeper,
+		stakingKeeper,
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[authzPrecompile.Address()] = authzPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithFeegrantPrecompile(
+	feegrantKeeper feegrantkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	feegrantPrecompile := feegrantprecompile.NewPrecompile(
+		feegrantkeeper.NewMsgServerImpl(feegrantKeeper),
+		feegrantKeeper,
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[feegrantPrecompile.Address()] = feegrantPrecompile
+	return s
+}
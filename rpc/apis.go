@@ -12,7 +12,9 @@ import (
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/ots"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
 	"github.com/cosmos/evm/rpc/stream"
@@ -30,13 +32,16 @@ const (
 
 	// Ethereum namespaces
 
-	Web3Namespace     = "web3"
-	EthNamespace      = "eth"
-	PersonalNamespace = "personal"
-	NetNamespace      = "net"
-	TxPoolNamespace   = "txpool"
-	DebugNamespace    = "debug"
-	MinerNamespace    = "miner"
+	Web3Namespace        = "web3"
+	EthNamespace         = "eth"
+	PersonalNamespace    = "personal"
+	NetNamespace         = "net"
+	TxPoolNamespace      = "txpool"
+	TxPoolAdminNamespace = "txpooladmin"
+	DebugNamespace       = "debug"
+	MinerNamespace       = "miner"
+	TraceNamespace       = "trace"
+	OtsNamespace         = "ots"
 
 	apiVersion = "1.0"
 )
@@ -133,6 +138,23 @@ func init() {
 				},
 			}
 		},
+		TxPoolAdminNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			return []rpc.API{
+				{
+					Namespace: TxPoolAdminNamespace,
+					Version:   apiVersion,
+					Service:   txpool.NewAdminAPI(ctx.Logger, evmBackend),
+					Public:    false,
+				},
+			}
+		},
 		DebugNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *stream.RPCStream,
@@ -167,6 +189,40 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, evmBackend, indexer, evmBackend.GetConfig().JSONRPC.BlockRangeCap),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx.Logger, evmBackend, indexer),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -160,7 +160,13 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
-	res, err := b.QueryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	height := blockNum.Int64()
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		// the pending state is built on top of the latest one
+		req.Pending, height = true, 0
+	}
+
+	res, err := b.QueryClient.Balance(rpctypes.ContextWithHeight(height), req)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +72,7 @@ type EVMBackend interface {
 	GetBlockTransactionCountByNumber(blockNum types.BlockNumber) *hexutil.Uint
 	CometBlockByNumber(blockNum types.BlockNumber) (*tmrpctypes.ResultBlock, error)
 	CometBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error)
+	CometBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error)
 	BlockNumberFromComet(blockNrOrHash types.BlockNumberOrHash) (types.BlockNumber, error)
 	BlockNumberFromCometByHash(blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromCometBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
@@ -115,14 +116,18 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendPrivateTransaction(args types.PrivateTransactionArgs) (common.Hash, error)
+	SendBundle(args types.BundleArgs) (*types.BundleResult, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	PendingLogs() ([]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -131,10 +136,16 @@ type EVMBackend interface {
 	Inspect() (map[string]map[string]map[string]string, error)
 	Status() (map[string]hexutil.Uint, error)
 
+	// TxPool admin API
+	MempoolContent() (*types.MempoolContent, error)
+	RemovePoolTransaction(hash common.Hash) (bool, error)
+	RemovePoolSender(sender common.Address) (hexutil.Uint, error)
+
 	// Tracing
 	TraceTransaction(hash common.Hash, config *types.TraceConfig) (interface{}, error)
 	TraceBlock(height types.BlockNumber, config *types.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceConfig) (interface{}, error)
+	TraceCallMany(calls []evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceConfig) ([]interface{}, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -173,6 +184,8 @@ type Backend struct {
 	Indexer             servertypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             *evmmempool.ExperimentalEVMMempool
+
+	gasPriceOracle *gasPriceOracle
 }
 
 func (b *Backend) GetConfig() config.Config {
@@ -209,6 +222,7 @@ func NewBackend(
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
 		Mempool:             mempool,
+		gasPriceOracle:      &gasPriceOracle{},
 	}
 	b.ProcessBlocker = b.ProcessBlock
 	return b
@@ -202,6 +202,8 @@ func (b *Backend) GetBlockReceipts(
 		return nil, fmt.Errorf("failed to get receipts from comet block: %w, ", err)
 	}
 
+	feeGranters := b.FeeGrantersFromCometBlock(resBlock)
+
 	result := make([]map[string]interface{}, len(msgs))
 	for i, msg := range msgs {
 		var signer ethtypes.Signer
@@ -216,7 +218,12 @@ func (b *Backend) GetBlockReceipts(
 			return nil, fmt.Errorf("failed to get sender: %w", err)
 		}
 
-		result[i], err = types.RPCMarshalReceipt(receipts[i], tx, from)
+		feePayer := from
+		if granter, ok := feeGranters[tx.Hash()]; ok {
+			feePayer = granter
+		}
+
+		result[i], err = types.RPCMarshalReceipt(receipts[i], tx, from, feePayer)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal receipt")
 		}
@@ -101,24 +101,11 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.Logger.Error("transaction decoding failed", "error", err.Error())
+	tx, err := b.decodeRawTransaction(data)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() {
-		if !tx.Protected() {
-			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-		}
-		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
-			return common.Hash{}, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
-		}
-	}
-
 	ethereumTx := &evmtypes.MsgEthereumTx{}
 	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
 	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
@@ -158,6 +145,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		if b.Mempool != nil && strings.Contains(err.Error(), mempool.ErrNonceGap.Error()) {
 			// Transaction was successfully queued due to nonce gap, return success to client
 			b.Logger.Debug("transaction queued due to nonce gap", "hash", txHash.Hex())
+			b.Mempool.TrackLocalTx(tx)
 			return txHash, nil
 		}
 		if b.Mempool != nil && strings.Contains(err.Error(), mempool.ErrNonceLow.Error()) {
@@ -183,9 +171,91 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return txHash, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
 
+	if b.Mempool != nil {
+		b.Mempool.TrackLocalTx(tx)
+	}
 	return txHash, nil
 }
 
+// SendPrivateTransaction adds a raw Ethereum transaction to the local mempool
+// without broadcasting it to the peers, it's only included in the blocks
+// proposed by the node up to the max block number.
+func (b *Backend) SendPrivateTransaction(args rpctypes.PrivateTransactionArgs) (common.Hash, error) {
+	if b.Mempool == nil {
+		return common.Hash{}, errors.New("private transactions require the EVM mempool")
+	}
+	tx, err := b.decodeRawTransaction(args.Tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var maxBlockNumber uint64
+	if args.MaxBlockNumber != nil {
+		maxBlockNumber = uint64(*args.MaxBlockNumber)
+	}
+	if err := b.Mempool.InsertPrivate(tx, maxBlockNumber); err != nil {
+		b.Logger.Debug("failed to insert private transaction", "hash", tx.Hash().Hex(), "error", err.Error())
+		return common.Hash{}, fmt.Errorf("failed to insert private transaction: %w", err)
+	}
+	return tx.Hash(), nil
+}
+
+// SendBundle stores an ordered set of raw Ethereum transactions in the local
+// mempool, to be included together in the target block if it's proposed by
+// the node. The inclusion is best-effort, see mempool.Bundle.
+func (b *Backend) SendBundle(args rpctypes.BundleArgs) (*rpctypes.BundleResult, error) {
+	if b.Mempool == nil {
+		return nil, errors.New("bundles require the EVM mempool")
+	}
+	bundle := &mempool.Bundle{
+		Txs:         make([]*ethtypes.Transaction, 0, len(args.Txs)),
+		BlockNumber: uint64(args.BlockNumber),
+	}
+	for _, data := range args.Txs {
+		tx, err := b.decodeRawTransaction(data)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Txs = append(bundle.Txs, tx)
+	}
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = *args.MinTimestamp
+	}
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = *args.MaxTimestamp
+	}
+
+	hash, err := b.Mempool.InsertBundle(bundle)
+	if err != nil {
+		b.Logger.Debug("failed to insert bundle", "error", err.Error())
+		return nil, fmt.Errorf("failed to insert bundle: %w", err)
+	}
+	return &rpctypes.BundleResult{BundleHash: hash}, nil
+}
+
+// decodeRawTransaction decodes a raw Ethereum transaction, checking its replay
+// protection against the local node config.
+func (b *Backend) decodeRawTransaction(data hexutil.Bytes) (*ethtypes.Transaction, error) {
+	// RLP decode raw transaction bytes
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		b.Logger.Error("transaction decoding failed", "error", err.Error())
+		return nil, err
+	}
+
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() {
+		if !tx.Protected() {
+			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+			return nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
+		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
+			return nil, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
+		}
+	}
+	return tx, nil
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
@@ -397,10 +467,16 @@ func (b *Backend) DoCall(
 		Overrides:       bzOverrides,
 	}
 
+	height := blockNr.Int64()
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		// the pending state is built on top of the latest one
+		req.Pending, height = true, 0
+	}
+
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	ctx := rpctypes.ContextWithHeight(height)
 	timeout := b.RPCEVMTimeout()
 
 	// Setup context so it may be canceled the call has completed
@@ -428,6 +504,109 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 executes series of blocks of calls on top of the given block and
+// returns the simulated blocks, including the results of the calls.
+func (b *Backend) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) ([]map[string]interface{}, error) {
+	blockNr := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNr, err = b.BlockNumberFromComet(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CometHeaderByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockHash:       common.Bytes2Hex(header.Header.Hash()),
+		BlockTime:       header.Header.Time,
+	}
+
+	// query the state of the resolved header so that the simulated blocks
+	// are built on top of it
+	ctx := rpctypes.ContextWithHeight(header.Header.Height)
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, &rpctypes.SimError{Code: int(res.ErrorCode), Message: res.Error}
+	}
+
+	var results []rpctypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		blocks = append(blocks, b.formatSimBlock(result, opts.ReturnFullTransactions))
+	}
+	return blocks, nil
+}
+
+// formatSimBlock returns the ethereum block representation of a simulated
+// block, together with the results of its calls.
+func (b *Backend) formatSimBlock(result rpctypes.SimBlockResult, fullTx bool) map[string]interface{} {
+	header := result.Header
+	blockHash := header.Hash()
+	block := ethtypes.NewBlockWithHeader(header).WithBody(ethtypes.Body{Transactions: result.Transactions})
+
+	fields := rpctypes.RPCMarshalHeader(header, blockHash.Bytes())
+	fields["size"] = hexutil.Uint64(block.Size())
+	fields["uncles"] = []common.Hash{}
+
+	txs := make([]interface{}, len(result.Transactions))
+	for i, tx := range result.Transactions {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		rpcTx := rpctypes.NewRPCTransaction(
+			tx,
+			blockHash,
+			header.Number.Uint64(),
+			header.Time,
+			uint64(i),
+			header.BaseFee,
+			b.ChainConfig(),
+		)
+		// the simulated transactions are not signed
+		rpcTx.From = result.Senders[i]
+		txs[i] = rpcTx
+	}
+	fields["transactions"] = txs
+	fields["calls"] = result.Calls
+	return fields
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -435,17 +614,17 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 		err    error
 	)
 
-	head, err := b.CurrentHeader()
+	baseFee, err := b.latestBaseFee()
 	if err != nil {
 		return nil, err
 	}
 
-	if head.BaseFee != nil {
-		result, err = b.SuggestGasTipCap(head.BaseFee)
+	if baseFee != nil {
+		result, err = b.SuggestGasTipCap(baseFee)
 		if err != nil {
 			return nil, err
 		}
-		result = result.Add(result, head.BaseFee)
+		result = result.Add(result, baseFee)
 	} else {
 		result = b.RPCMinGasPrice()
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -147,6 +148,10 @@ func (b *Backend) GetCoinbase() (sdk.AccAddress, error) {
 	return address, nil
 }
 
+// maxBlockFetchers bounds the number of blocks fetched concurrently by the fee
+// history.
+const maxBlockFetchers = 4
+
 var (
 	errInvalidPercentile = fmt.Errorf("invalid reward percentile")
 	errRequestBeyondHead = fmt.Errorf("request beyond head block")
@@ -201,6 +206,12 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	// the base fees and gas used ratios are served from the fee market history
+	// when it covers the range, the blocks are then only fetched for the rewards
+	if feeHistory, ok, err := b.feeHistoryFromState(blockStart, blockEnd, blockNumber, rewardPercentiles); ok {
+		return feeHistory, err
+	}
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
 	rewardCount := len(rewardPercentiles)
@@ -215,7 +226,6 @@ func (b *Backend) FeeHistory(
 
 	// rewards should only be calculated if reward percentiles were included
 	calculateRewards := rewardCount != 0
-	const maxBlockFetchers = 4
 	for blockID := blockStart; blockID <= blockEnd; blockID += maxBlockFetchers {
 		wg := sync.WaitGroup{}
 		wgDone := make(chan bool)
@@ -317,15 +327,198 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// feeHistoryFromState returns the fee history of the given block range from the
+// history kept by the fee market module, false if a block of the range is not in
+// the history. The blocks of the range are only fetched for the rewards, if
+// reward percentiles are given.
+func (b *Backend) feeHistoryFromState(blockStart, blockEnd, latest int64, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, bool, error) {
+	// the base fee of the block following the range is in the history, unless
+	// the range ends with the latest block
+	toHeight := blockEnd
+	if blockEnd < latest {
+		toHeight++
+	}
+	entries, ok := b.feeHistoryEntries(blockStart, toHeight)
+	if !ok {
+		return nil, false, nil
+	}
+
+	blocks := blockEnd - blockStart + 1
+	baseFees := make([]*hexutil.Big, blocks+1)
+	gasUsedRatios := make([]float64, blocks)
+	for i, entry := range entries[:blocks] {
+		baseFees[i] = (*hexutil.Big)(feeHistoryBaseFee(entry))
+		gasUsedRatios[i] = safeRatio(entry.GasUsed, entry.GasLimit)
+	}
+
+	if int64(len(entries)) > blocks {
+		baseFees[blocks] = (*hexutil.Big)(feeHistoryBaseFee(entries[blocks]))
+	} else {
+		last := entries[blocks-1]
+		nextBaseFee, err := b.calcNextBaseFee(&ethtypes.Header{
+			Number:   big.NewInt(last.Height),
+			GasLimit: last.GasLimit,
+			GasUsed:  last.GasWanted,
+			BaseFee:  feeHistoryBaseFee(last),
+		})
+		if err != nil {
+			b.Logger.Debug("failed to calculate the next base fee", "height", last.Height, "error", err.Error())
+			return nil, false, nil
+		}
+		baseFees[blocks] = (*hexutil.Big)(nextBaseFee)
+	}
+
+	var rewards [][]*hexutil.Big
+	if len(rewardPercentiles) > 0 {
+		rewards = make([][]*hexutil.Big, blocks)
+		var g errgroup.Group
+		g.SetLimit(maxBlockFetchers)
+		for i, entry := range entries[:blocks] {
+			g.Go(func() error {
+				blockRewards, err := b.fetchBlockRewards(entry.Height, feeHistoryBaseFee(entry), entry.GasUsed, rewardPercentiles)
+				if err != nil {
+					return err
+				}
+				rewards[i] = make([]*hexutil.Big, len(blockRewards))
+				for j, reward := range blockRewards {
+					rewards[i][j] = (*hexutil.Big)(reward)
+				}
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, true, err
+		}
+	}
+
+	// blobs are not supported, so the blob base fee is the minimum one
+	blobBaseFee := new(big.Int)
+	if cfg := b.ChainConfig(); cfg.CancunTime != nil {
+		blobBaseFee = big.NewInt(params.BlobTxMinBlobGasprice)
+	}
+	blobBaseFees := make([]*hexutil.Big, blocks+1)
+	for i := range blobBaseFees {
+		blobBaseFees[i] = (*hexutil.Big)(blobBaseFee)
+	}
+
+	return &rpctypes.FeeHistoryResult{
+		OldestBlock:      (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:          baseFees,
+		GasUsedRatio:     gasUsedRatios,
+		Reward:           rewards,
+		BlobBaseFee:      blobBaseFees,
+		BlobGasUsedRatio: make([]float64, blocks),
+	}, true, nil
+}
+
+// fetchBlockRewards fetches the block at the given height and its results to
+// return the rewards of the block at the given percentiles.
+func (b *Backend) fetchBlockRewards(height int64, baseFee *big.Int, gasUsed uint64, rewardPercentiles []float64) ([]*big.Int, error) {
+	cometBlock, err := b.CometBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if cometBlock == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	cometBlockResult, err := b.CometBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+	return b.blockRewards(cometBlock, cometBlockResult, baseFee, float64(gasUsed), rewardPercentiles), nil
+}
+
+// feeHistoryEntries returns the fee market history of the given block range,
+// false if a block of the range is not in the history.
+func (b *Backend) feeHistoryEntries(fromHeight, toHeight int64) ([]feemarkettypes.FeeHistoryEntry, bool) {
+	if fromHeight <= 0 {
+		return nil, false
+	}
+
+	res, err := b.QueryClient.FeeMarket.FeeHistory(b.Ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	if err != nil {
+		b.Logger.Debug("failed to query the fee history", "from", fromHeight, "to", toHeight, "error", err.Error())
+		return nil, false
+	}
+	if int64(len(res.Entries)) != toHeight-fromHeight+1 {
+		return nil, false
+	}
+	return res.Entries, true
+}
+
+// feeHistoryBaseFee returns the base fee of a fee market history entry in 18
+// decimals, zero if the base fee is disabled.
+func feeHistoryBaseFee(entry feemarkettypes.FeeHistoryEntry) *big.Int {
+	if entry.BaseFee == nil || entry.BaseFee.IsNil() {
+		return new(big.Int)
+	}
+	return evmtypes.ConvertAmountTo18DecimalsLegacy(*entry.BaseFee).TruncateInt().BigInt()
+}
+
+// latestBaseFee returns the base fee of the latest block from the fee market
+// history, or from the latest block header if it is not in the history. It
+// returns nil if the base fee is disabled.
+func (b *Backend) latestBaseFee() (*big.Int, error) {
+	if bn, err := b.BlockNumber(); err == nil {
+		height := int64(bn) //#nosec G115 -- checked for int overflow already
+		if entries, ok := b.feeHistoryEntries(height, height); ok {
+			if entries[0].BaseFee == nil {
+				return nil, nil
+			}
+			return feeHistoryBaseFee(entries[0]), nil
+		}
+	}
+
+	head, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+	return head.BaseFee, nil
+}
+
+// SuggestGasTipCap returns the suggested tip cap.
+// The gas price oracle suggests the percentile of the effective tips paid in the last
+// blocks, raised to the one of the pending mempool transactions when the mempool is
+// under pressure. The blocks without transactions count as paying the maximum base fee
+// change, so that the tip helps the clients to mitigate the base fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
 		return big.NewInt(0), nil
 	}
 
+	tip, err := b.maxBaseFeeDelta(baseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := b.Cfg.JSONRPC.GasPriceOracle
+	sampleMempool := b.Mempool != nil && cfg.MempoolPressure > 0
+	if cfg.Blocks == 0 && !sampleMempool {
+		return tip, nil
+	}
+
+	blockNumber, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	head := int64(blockNumber) //#nosec G115 -- checked for int overflow already
+	if cfg.Blocks > 0 {
+		tip = b.suggestTipFromBlocks(head, tip)
+	}
+	if sampleMempool {
+		if mempoolTip := b.mempoolTip(head, baseFee); mempoolTip != nil && mempoolTip.Cmp(tip) > 0 {
+			tip = mempoolTip
+		}
+	}
+	return tip, nil
+}
+
+// maxBaseFeeDelta returns the maximum change of the given base fee in the next block.
+func (b *Backend) maxBaseFeeDelta(baseFee *big.Int) (*big.Int, error) {
 	params, err := b.QueryClient.FeeMarket.Params(b.Ctx, &feemarkettypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
@@ -122,6 +122,28 @@ func (b *Backend) EthMsgsFromCometBlock(
 	return result
 }
 
+// FeeGrantersFromCometBlock returns the fee granters of the sponsored Ethereum
+// transactions of the CometBFT block, keyed by transaction hash.
+func (b *Backend) FeeGrantersFromCometBlock(resBlock *cmtrpctypes.ResultBlock) map[common.Hash]common.Address {
+	granters := make(map[common.Hash]common.Address)
+	for _, txBz := range resBlock.Block.Txs {
+		tx, err := b.ClientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			continue
+		}
+		granter := rpctypes.FeeGranter(tx)
+		if granter == nil {
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				granters[ethMsg.Hash()] = common.BytesToAddress(granter)
+			}
+		}
+	}
+	return granters
+}
+
 // RPCBlockFromCometBlock returns a JSON-RPC compatible Ethereum block from a
 // given CometBFT block and its block result.
 func (b *Backend) EthBlockFromCometBlock(
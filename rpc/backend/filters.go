@@ -4,6 +4,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -29,6 +32,43 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetLogsFromIndex returns the logs matching the addresses and topics within the
+// block range from the log index of the EVM indexer. It returns false if the
+// log index is disabled or doesn't cover the whole range.
+func (b *Backend) GetLogsFromIndex(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, bool, error) {
+	logIndexer, ok := b.Indexer.(servertypes.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+	first, last, err := logIndexer.LogIndexRange()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || from < first || to > last {
+		return nil, false, nil
+	}
+	logs, err := logIndexer.GetLogs(from, to, addresses, topics, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	return logs, true, nil
+}
+
+// PendingLogs returns the logs emitted by the pending transactions of the
+// mempool when executed on top of the latest state.
+func (b *Backend) PendingLogs() ([]*ethtypes.Log, error) {
+	res, err := b.QueryClient.PendingLogs(b.Ctx, &evmtypes.QueryPendingLogsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return evmtypes.LogsToEthereum(res.Logs), nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -0,0 +1,187 @@
+package backend
+
+import (
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// sampleNumber is the number of the lowest tips sampled from each block, as the
+// go-ethereum gas price oracle.
+const sampleNumber = 3
+
+// gasPriceOracle caches the tips suggested from the blocks and from the pending
+// mempool transactions up to a head, they are only sampled again once the head
+// changes.
+type gasPriceOracle struct {
+	mu       sync.Mutex
+	lastHead int64
+	lastTip  *big.Int
+
+	lastMempoolHead int64
+	lastMempoolTip  *big.Int
+	mempoolSampled  bool
+}
+
+func (o *gasPriceOracle) cached(head int64) (*big.Int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.lastTip == nil || o.lastHead != head {
+		return nil, false
+	}
+	return new(big.Int).Set(o.lastTip), true
+}
+
+func (o *gasPriceOracle) store(head int64, tip *big.Int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastHead = head
+	o.lastTip = new(big.Int).Set(tip)
+}
+
+// cachedMempool returns the mempool tip sampled at the head, the tip is nil if
+// the mempool wasn't under pressure.
+func (o *gasPriceOracle) cachedMempool(head int64) (*big.Int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !o.mempoolSampled || o.lastMempoolHead != head {
+		return nil, false
+	}
+	if o.lastMempoolTip == nil {
+		return nil, true
+	}
+	return new(big.Int).Set(o.lastMempoolTip), true
+}
+
+func (o *gasPriceOracle) storeMempool(head int64, tip *big.Int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastMempoolHead = head
+	o.lastMempoolTip = nil
+	if tip != nil {
+		o.lastMempoolTip = new(big.Int).Set(tip)
+	}
+	o.mempoolSampled = true
+}
+
+// suggestTipFromBlocks returns the percentile of the effective tips paid by the
+// transactions of the last blocks up to the head. The blocks without any sampled
+// transaction count as paying the default tip.
+func (b *Backend) suggestTipFromBlocks(head int64, defaultTip *big.Int) *big.Int {
+	if tip, ok := b.gasPriceOracle.cached(head); ok {
+		return tip
+	}
+
+	cfg := b.Cfg.JSONRPC.GasPriceOracle
+	oldest := max(head-int64(cfg.Blocks), 0) //#nosec G115 -- blocks is bounded by the config validation
+	var tips []*big.Int
+	for height := head; height > oldest; height-- {
+		blockTips, err := b.blockTips(height, new(big.Int).SetUint64(cfg.IgnorePrice))
+		if err != nil {
+			b.Logger.Debug("failed to sample the block tips", "height", height, "error", err.Error())
+		}
+		if len(blockTips) == 0 {
+			blockTips = []*big.Int{defaultTip}
+		}
+		tips = append(tips, blockTips...)
+	}
+
+	tip := defaultTip
+	if len(tips) > 0 {
+		tip = percentileTip(tips, cfg.Percentile)
+	}
+	tip = capTip(tip, cfg.MaxPrice)
+	b.gasPriceOracle.store(head, tip)
+	return tip
+}
+
+// blockTips returns the lowest effective tips paid by the transactions of the
+// block, ignoring the ones below the ignore price.
+func (b *Backend) blockTips(height int64, ignorePrice *big.Int) ([]*big.Int, error) {
+	// the tx indexer tells if the block has any EVM transaction without fetching it
+	if _, err := b.GetTxByTxIndex(height, 0); err != nil {
+		return nil, nil
+	}
+
+	resBlock, err := b.CometBlockByNumber(rpctypes.BlockNumber(height))
+	if resBlock == nil {
+		return nil, err
+	}
+	blockRes, err := b.CometBlockResultByNumber(&height)
+	if blockRes == nil {
+		return nil, err
+	}
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	var tips []*big.Int
+	for _, msg := range b.EthMsgsFromCometBlock(resBlock, blockRes) {
+		tip, err := msg.AsTransaction().EffectiveGasTip(baseFee)
+		if err != nil || tip.Cmp(ignorePrice) < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+
+	slices.SortFunc(tips, (*big.Int).Cmp)
+	return tips[:min(len(tips), sampleNumber)], nil
+}
+
+// mempoolTip returns the percentile of the effective tips of the pending mempool
+// transactions when there are more of them than the mempool pressure, nil otherwise.
+// The mempool is sampled once per head.
+func (b *Backend) mempoolTip(head int64, baseFee *big.Int) *big.Int {
+	if tip, ok := b.gasPriceOracle.cachedMempool(head); ok {
+		return tip
+	}
+
+	cfg := b.Cfg.JSONRPC.GasPriceOracle
+	var tip *big.Int
+	txPool := b.Mempool.GetTxPool()
+	if pending, _ := txPool.Stats(); uint64(pending) >= cfg.MempoolPressure { //#nosec G115 -- pending is never negative
+		ignorePrice := new(big.Int).SetUint64(cfg.IgnorePrice)
+		var tips []*big.Int
+		for _, txs := range txPool.Pending(txpool.PendingFilter{}) {
+			for _, tx := range txs {
+				txTip := tx.GasTipCap.ToBig()
+				if feeCapTip := new(big.Int).Sub(tx.GasFeeCap.ToBig(), baseFee); feeCapTip.Cmp(txTip) < 0 {
+					txTip = feeCapTip
+				}
+				if txTip.Cmp(ignorePrice) < 0 {
+					continue
+				}
+				tips = append(tips, txTip)
+			}
+		}
+		if len(tips) > 0 {
+			tip = capTip(percentileTip(tips, cfg.Percentile), cfg.MaxPrice)
+		}
+	}
+
+	b.gasPriceOracle.storeMempool(head, tip)
+	return tip
+}
+
+// percentileTip returns the given percentile of the tips, the tips are sorted in
+// place.
+func percentileTip(tips []*big.Int, percentile uint64) *big.Int {
+	slices.SortFunc(tips, (*big.Int).Cmp)
+	return tips[uint64(len(tips)-1)*percentile/100] //#nosec G115 -- len is never negative
+}
+
+// capTip returns the tip capped to the max price, the tip is not capped if the max
+// price is 0.
+func capTip(tip *big.Int, maxPrice uint64) *big.Int {
+	if maxPrice == 0 {
+		return tip
+	}
+	if limit := new(big.Int).SetUint64(maxPrice); tip.Cmp(limit) > 0 {
+		return limit
+	}
+	return tip
+}
@@ -0,0 +1,30 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasPriceOracleMempoolCache(t *testing.T) {
+	oracle := &gasPriceOracle{}
+
+	_, ok := oracle.cachedMempool(1)
+	require.False(t, ok)
+
+	// the mempool without pressure is cached as well
+	oracle.storeMempool(1, nil)
+	tip, ok := oracle.cachedMempool(1)
+	require.True(t, ok)
+	require.Nil(t, tip)
+
+	oracle.storeMempool(2, big.NewInt(100))
+	tip, ok = oracle.cachedMempool(2)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(100), tip)
+
+	// the mempool is sampled again once the head changes
+	_, ok = oracle.cachedMempool(3)
+	require.False(t, ok)
+}
@@ -238,12 +238,74 @@ func (b *Backend) TraceCall(
 	blockNrOrHash rpctypes.BlockNumberOrHash,
 	config *rpctypes.TraceConfig,
 ) (interface{}, error) {
+	data, err := b.traceCall(args, nil, false, blockNrOrHash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(data, &decodedResult); err != nil {
+		return nil, err
+	}
+	return decodedResult, nil
+}
+
+// TraceCallMany executes the given calls in order on top of the provided block,
+// each call seeing the state changes of the previous ones, and returns the
+// tracer output of each call as a JSON object.
+func (b *Backend) TraceCallMany(
+	calls []evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceConfig,
+) ([]interface{}, error) {
+	if len(calls) == 0 {
+		return []interface{}{}, nil
+	}
+	// the calls are traced in a single execution, the last one being the
+	// traced call of the request
+	last := len(calls) - 1
+	data, err := b.traceCall(calls[last], calls[:last], true, blockNrOrHash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedResults []interface{}
+	if err := json.Unmarshal(data, &decodedResults); err != nil {
+		return nil, err
+	}
+	if len(decodedResults) != len(calls) {
+		return nil, fmt.Errorf("expected %d traces, got %d", len(calls), len(decodedResults))
+	}
+	return decodedResults, nil
+}
+
+// traceCall executes the predecessor calls in order on top of the provided
+// block and traces the given call on the resulting state, along with the
+// predecessors if requested. It returns the raw tracer output.
+func (b *Backend) traceCall(
+	args evmtypes.TransactionArgs,
+	predecessors []evmtypes.TransactionArgs,
+	tracePredecessors bool,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceConfig,
+) ([]byte, error) {
 	// Marshal tx args
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
 
+	var predecessorsBz [][]byte
+	for i := range predecessors {
+		predecessorBz, err := json.Marshal(&predecessors[i])
+		if err != nil {
+			return nil, err
+		}
+		predecessorsBz = append(predecessorsBz, predecessorBz)
+	}
+
 	// Get block number from blockNrOrHash
 	blockNr, err := b.BlockNumberFromComet(blockNrOrHash)
 	if err != nil {
@@ -258,13 +320,15 @@ func (b *Backend) TraceCall(
 	}
 
 	traceCallRequest := evmtypes.QueryTraceCallRequest{
-		Args:            bz,
-		GasCap:          b.RPCGasCap(),
-		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
-		BlockNumber:     header.Header.Height,
-		BlockHash:       common.Bytes2Hex(header.Header.Hash()),
-		BlockTime:       header.Header.Time,
-		ChainId:         b.EvmChainID.Int64(),
+		Args:              bz,
+		GasCap:            b.RPCGasCap(),
+		ProposerAddress:   sdk.ConsAddress(header.Header.ProposerAddress),
+		BlockNumber:       header.Header.Height,
+		BlockHash:         common.Bytes2Hex(header.Header.Hash()),
+		BlockTime:         header.Header.Time,
+		ChainId:           b.EvmChainID.Int64(),
+		Predecessors:      predecessorsBz,
+		TracePredecessors: tracePredecessors,
 	}
 
 	if config != nil {
@@ -285,14 +349,5 @@ func (b *Backend) TraceCall(
 	if err != nil {
 		return nil, err
 	}
-
-	// Response format is unknown due to custom tracer config param
-	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
-	var decodedResult interface{}
-	err = json.Unmarshal(traceResult.Data, &decodedResult)
-	if err != nil {
-		return nil, err
-	}
-
-	return decodedResult, nil
+	return traceResult.Data, nil
 }
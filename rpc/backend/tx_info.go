@@ -210,7 +210,12 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, fmt.Errorf("failed to get sender: %w", err)
 	}
 
-	return rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	feePayer := from
+	if granter := rpctypes.FeeGranter(tx); granter != nil {
+		feePayer = common.BytesToAddress(granter)
+	}
+
+	return rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from, feePayer)
 }
 
 // GetTransactionLogs returns the transaction logs identified by hash.
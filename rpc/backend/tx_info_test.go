@@ -431,6 +431,22 @@ func (m *MockIndexer) LastIndexedBlock() (int64, error) {
 	return 0, nil
 }
 
+func (m *MockIndexer) FirstIndexedBlock() (int64, error) {
+	return 0, nil
+}
+
+func (m *MockIndexer) Rewind(height int64) error {
+	return nil
+}
+
+func (m *MockIndexer) Prune(retainHeight int64) error {
+	return nil
+}
+
+func (m *MockIndexer) DeleteBlock(height int64, txHashes []common.Hash) error {
+	return nil
+}
+
 func (m *MockIndexer) IndexBlock(block *tmtypes.Block, txResults []*abcitypes.ExecTxResult) error {
 	return nil
 }
@@ -446,6 +462,18 @@ func (m *MockIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ser
 	return nil, nil
 }
 
+func (m *MockIndexer) GetBySender(common.Address, servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	return nil, nil
+}
+
+func (m *MockIndexer) GetByRecipient(common.Address, servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	return nil, nil
+}
+
+func (m *MockIndexer) GetContractCreation(common.Address) (*servertypes.IndexedTx, error) {
+	return nil, nil
+}
+
 func TestReceiptsFromCometBlock(t *testing.T) {
 	backend := setupMockBackend(t)
 	height := int64(100)
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -9,6 +10,8 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/cosmos/evm/rpc/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -37,8 +40,9 @@ func (b *Backend) Content() (map[string]map[string]map[string]*types.RPCTransact
 		return content, nil
 	}
 
-	// Get pending (runnable) and queued (blocked) transactions from the mempool
-	pending, queued := evmMempool.GetTxPool().Content()
+	// Get pending (runnable) and queued (blocked) transactions from the mempool,
+	// the private transactions are left out
+	pending, queued := evmMempool.Content()
 
 	// Convert pending (pending) transactions
 	for addr, txList := range pending {
@@ -85,8 +89,8 @@ func (b *Backend) ContentFrom(addr common.Address) (map[string]map[string]*types
 		return content, nil
 	}
 
-	// Get transactions for the specific address
-	pending, queue := evmMempool.GetTxPool().ContentFrom(addr)
+	// Get transactions for the specific address, the private transactions are left out
+	pending, queue := evmMempool.ContentFrom(addr)
 
 	// Build the pending transactions
 	dump := make(map[string]*types.RPCTransaction, len(pending)) // variable name comes from go-ethereum: https://github.com/ethereum/go-ethereum/blob/0dacfef8ac42e7be5db26c2956f2b238ba7c75e8/internal/ethapi/api.go#L221
@@ -120,8 +124,9 @@ func (b *Backend) Inspect() (map[string]map[string]map[string]string, error) {
 		return inspect, nil
 	}
 
-	// Get pending (runnable) and queued (blocked) transactions from the mempool
-	pending, queued := evmMempool.GetTxPool().Content()
+	// Get pending (runnable) and queued (blocked) transactions from the mempool,
+	// the private transactions are left out
+	pending, queued := evmMempool.Content()
 
 	// Helper function to format transaction for inspection
 	format := func(tx *ethtypes.Transaction) string {
@@ -171,3 +176,93 @@ func (b *Backend) Status() (map[string]hexutil.Uint, error) {
 		StatusQueued:  hexutil.Uint(queued),  // #nosec G115 -- overflow not a concern for tx counts, as the mempool will limit far before this number is hit. This is taken directly from Geth.
 	}, nil
 }
+
+// MempoolContent returns the content of the unified mempool: the EVM
+// transactions with their effective tip and why the queued ones are not
+// executable, and the Cosmos transactions.
+func (b *Backend) MempoolContent() (*types.MempoolContent, error) {
+	if b.Mempool == nil {
+		return nil, errors.New("the mempool introspection requires the EVM mempool")
+	}
+
+	curHeader, err := b.CurrentHeader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current header: %w", err)
+	}
+
+	evmTxs, err := b.Mempool.InspectEVMTxs()
+	if err != nil {
+		return nil, err
+	}
+	cosmosTxs, err := b.Mempool.InspectCosmosTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.MempoolContent{
+		Pending: make(map[string]map[string]*types.PoolTransaction),
+		Queued:  make(map[string]map[string]*types.PoolTransaction),
+		Cosmos:  make([]*types.CosmosPoolTransaction, 0, len(cosmosTxs)),
+	}
+	if curHeader.BaseFee != nil {
+		content.BaseFee = (*hexutil.Big)(curHeader.BaseFee)
+	}
+
+	for _, info := range evmTxs {
+		txs := content.Queued
+		if info.Pending {
+			txs = content.Pending
+		}
+		addrStr := info.From.Hex()
+		if txs[addrStr] == nil {
+			txs[addrStr] = make(map[string]*types.PoolTransaction)
+		}
+		txs[addrStr][strconv.FormatUint(info.Tx.Nonce(), 10)] = &types.PoolTransaction{
+			RPCTransaction: types.NewRPCPendingTransaction(info.Tx, curHeader, b.ChainConfig()),
+			EffectiveTip:   (*hexutil.Big)(info.EffectiveTip),
+			QueuedReason:   info.QueuedReason,
+			Private:        info.Private,
+		}
+	}
+
+	for _, info := range cosmosTxs {
+		msgs := make([]string, 0, len(info.Tx.GetMsgs()))
+		for _, msg := range info.Tx.GetMsgs() {
+			msgs = append(msgs, sdk.MsgTypeURL(msg))
+		}
+		rpcTx := &types.CosmosPoolTransaction{
+			Hash:         fmt.Sprintf("%X", info.Hash),
+			Signer:       info.Signer.String(),
+			Sequence:     hexutil.Uint64(info.Sequence),
+			Messages:     msgs,
+			Gas:          hexutil.Uint64(info.Gas),
+			GasPrice:     (*hexutil.Big)(info.GasPrice),
+			EffectiveTip: (*hexutil.Big)(info.EffectiveTip),
+		}
+		if !info.Time.IsZero() {
+			rpcTx.Time = &info.Time
+		}
+		content.Cosmos = append(content.Cosmos, rpcTx)
+	}
+
+	return content, nil
+}
+
+// RemovePoolTransaction removes the EVM or Cosmos transaction of the given hash
+// from the mempool, and reports whether it was found.
+func (b *Backend) RemovePoolTransaction(hash common.Hash) (bool, error) {
+	if b.Mempool == nil {
+		return false, errors.New("the mempool eviction requires the EVM mempool")
+	}
+	return b.Mempool.RemoveTxByHash(hash)
+}
+
+// RemovePoolSender removes all the transactions of the sender from the mempool,
+// and returns the number of removed transactions.
+func (b *Backend) RemovePoolSender(sender common.Address) (hexutil.Uint, error) {
+	if b.Mempool == nil {
+		return 0, errors.New("the mempool eviction requires the EVM mempool")
+	}
+	removed, err := b.Mempool.RemoveSenderTxs(sender)
+	return hexutil.Uint(removed), err // #nosec G115 -- the tx count is never negative
+}
@@ -113,6 +113,33 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// calcNextBaseFee returns the base fee of the block following the given parent
+// header, with the fee market params and state at the parent height.
+func (b *Backend) calcNextBaseFee(parent *ethtypes.Header) (*big.Int, error) {
+	cfg := b.ChainConfig()
+	if !cfg.IsLondon(new(big.Int).Add(parent.Number, big.NewInt(1))) {
+		return new(big.Int), nil
+	}
+
+	ctx := types.ContextWithHeight(parent.Number.Int64())
+	params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the exponential and moving window algorithms depend on the fee market
+	// state after the parent block
+	var excessGas, windowGas uint64
+	if params.Params.BaseFeeAlgorithm != feemarkettypes.BaseFeeAlgorithmEIP1559 {
+		blockGas, err := b.QueryClient.FeeMarket.BlockGas(ctx, &feemarkettypes.QueryBlockGasRequest{})
+		if err != nil {
+			return nil, err
+		}
+		excessGas, windowGas = blockGas.ExcessGas, blockGas.WindowGas
+	}
+	return types.CalcNextBaseFee(cfg, parent, params.Params, excessGas, windowGas)
+}
+
 // ProcessBlock processes a CometBFT block and calculates fee history data for eth_feeHistory RPC.
 // It extracts gas usage, base fees, and transaction reward percentiles from the block data.
 //
@@ -175,20 +202,11 @@ func (b *Backend) ProcessBlock(
 	targetOneFeeHistory.NextBlobBaseFee = big.NewInt(0)
 	targetOneFeeHistory.BlobGasUsedRatio = 0
 
-	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		ctx := types.ContextWithHeight(blockHeight)
-		params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
-		if err != nil {
-			return err
-		}
-		nextBaseFee, err := types.CalcBaseFee(cfg, &header, params.Params)
-		if err != nil {
-			return err
-		}
-		targetOneFeeHistory.NextBaseFee = nextBaseFee
-	} else {
-		targetOneFeeHistory.NextBaseFee = new(big.Int)
+	nextBaseFee, err := b.calcNextBaseFee(&header)
+	if err != nil {
+		return err
 	}
+	targetOneFeeHistory.NextBaseFee = nextBaseFee
 	if cfg.IsCancun(header.Number, header.Time) {
 		blobGasUsed := uint64(0)
 		if val, ok := (*ethBlock)["blobGasUsed"].(hexutil.Uint64); ok {
@@ -221,12 +239,25 @@ func (b *Backend) ProcessBlock(
 
 	gasUsedUint64 := gasUsedInt.Uint64()
 	targetOneFeeHistory.GasUsedRatio = safeRatio(gasUsedUint64, uint64(gasLimitUint64))
-	blockGasUsed := float64(gasUsedUint64)
+	targetOneFeeHistory.Reward = b.blockRewards(cometBlock, cometBlockResult, blockBaseFee, float64(gasUsedUint64), rewardPercentiles)
+	return nil
+}
 
+// blockRewards returns the effective gas tips of the eth txs of the block at
+// the given percentiles of the gas used by the block, weighted by the gas used
+// by the txs. The rewards are zero if the block has no eth txs.
+func (b *Backend) blockRewards(
+	cometBlock *cmtrpctypes.ResultBlock,
+	cometBlockResult *cmtrpctypes.ResultBlockResults,
+	blockBaseFee *big.Int,
+	blockGasUsed float64,
+	rewardPercentiles []float64,
+) []*big.Int {
+	blockHeight := cometBlock.Block.Height
 	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
+	rewards := make([]*big.Int, rewardCount)
 	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
+		rewards[i] = big.NewInt(0)
 	}
 
 	// check cometTxs
@@ -266,7 +297,7 @@ func (b *Backend) ProcessBlock(
 	// return an all zero row if there are no transactions to gather data from
 	ethTxCount := len(sorter)
 	if ethTxCount == 0 {
-		return nil
+		return rewards
 	}
 
 	sort.Sort(sorter)
@@ -280,10 +311,10 @@ func (b *Backend) ProcessBlock(
 			txIndex++
 			sumGasUsed += sorter[txIndex].gasUsed
 		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
+		rewards[i] = sorter[txIndex].reward
 	}
 
-	return nil
+	return rewards
 }
 
 func safeRatio(num, denom uint64) float64 {
@@ -51,8 +51,9 @@ type EthereumAPI interface {
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
-	// eth_sendPrivateTransaction
-	// eth_cancel	PrivateTransaction
+	SendPrivateTransaction(args rpctypes.PrivateTransactionArgs) (common.Hash, error)
+	SendBundle(args rpctypes.BundleArgs) (*rpctypes.BundleResult, error)
+	// eth_cancelPrivateTransaction
 
 	// Account Information
 	//
@@ -68,6 +69,7 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Chain Information
 	//
@@ -239,6 +241,22 @@ func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash,
 	return e.backend.SendTransaction(args)
 }
 
+// SendPrivateTransaction sends a raw Ethereum transaction which is not
+// broadcast to the peers, it's only included in the blocks proposed by the
+// node until its max block number.
+func (e *PublicAPI) SendPrivateTransaction(args rpctypes.PrivateTransactionArgs) (common.Hash, error) {
+	e.logger.Debug("eth_sendPrivateTransaction", "length", len(args.Tx))
+	return e.backend.SendPrivateTransaction(args)
+}
+
+// SendBundle sends an ordered set of raw Ethereum transactions to be included
+// together in the target block, on a best-effort basis, if it's proposed by the
+// node.
+func (e *PublicAPI) SendBundle(args rpctypes.BundleArgs) (*rpctypes.BundleResult, error) {
+	e.logger.Debug("eth_sendBundle", "tx_count", len(args.Txs), "block_number", uint64(args.BlockNumber))
+	return e.backend.SendBundle(args)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Account Information				                    ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -300,6 +318,15 @@ func (e *PublicAPI) Call(
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of transactions on top of a base state. The
+// transactions are packed into blocks. For each block, block header fields
+// can be overridden. The state can also be overridden prior to the execution
+// of each block.
+func (e *PublicAPI) SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_simulateV1", "block number or hash", blockNrOrHash)
+	return e.backend.SimulateV1(opts, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
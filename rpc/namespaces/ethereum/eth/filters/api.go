@@ -23,8 +23,7 @@ import (
 )
 
 var (
-	errInvalidBlockRange      = errors.New("invalid block range params")
-	errPendingLogsUnsupported = errors.New("pending logs are not supported")
+	errInvalidBlockRange = errors.New("invalid block range params")
 )
 
 // FilterAPI gathers
@@ -48,6 +47,8 @@ type Backend interface {
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
 	BlockBloomFromCometBlock(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
+	GetLogsFromIndex(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error)
+	PendingLogs() ([]*ethtypes.Log, error)
 
 	BloomStatus() (uint64, uint64)
 
@@ -66,6 +67,8 @@ type filter struct {
 	deadline *time.Timer // filter is inactive when deadline triggers
 	crit     filters.FilterCriteria
 	offset   int // offset for stream subscription
+	// pendingTxs are the pending txs whose logs were returned by the last poll
+	pendingTxs map[common.Hash]struct{}
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -351,16 +354,48 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 			logs  []*ethtypes.Log
 			chunk []*ethtypes.Log
 		)
+		// filters starting at the pending block only return pending logs
+		pendingOnly := isPendingBlock(f.crit.FromBlock)
 		for {
 			chunk, f.offset = api.events.LogStream().ReadNonBlocking(f.offset)
 			if len(chunk) == 0 {
 				break
 			}
+			if pendingOnly {
+				continue
+			}
 			chunk = FilterLogs(chunk, f.crit.FromBlock, f.crit.ToBlock, f.crit.Addresses, f.crit.Topics)
 			logs = append(logs, chunk...)
 		}
+		if isPendingBlock(f.crit.ToBlock) {
+			pendingLogs, err := api.pendingLogChanges(f)
+			if err != nil {
+				return nil, err
+			}
+			logs = append(logs, pendingLogs...)
+		}
 		return returnLogs(logs), nil
 	default:
 		return nil, fmt.Errorf("invalid filter %s type %d", id, f.typ)
 	}
 }
+
+// pendingLogChanges returns the logs of the pending transactions matching the
+// filter criteria that were not returned by the previous poll of the filter.
+func (api *PublicFilterAPI) pendingLogChanges(f *filter) ([]*ethtypes.Log, error) {
+	unfiltered, err := api.backend.PendingLogs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending logs: %w", err)
+	}
+
+	var logs []*ethtypes.Log
+	pendingTxs := make(map[common.Hash]struct{})
+	for _, log := range FilterLogs(unfiltered, nil, nil, f.crit.Addresses, f.crit.Topics) {
+		pendingTxs[log.TxHash] = struct{}{}
+		if _, ok := f.pendingTxs[log.TxHash]; !ok {
+			logs = append(logs, log)
+		}
+	}
+	f.pendingTxs = pendingTxs
+	return logs, nil
+}
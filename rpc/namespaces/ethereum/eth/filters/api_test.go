@@ -1,13 +1,18 @@
 package filters
 
 import (
+	"math/big"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
+
+	filtermocks "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters/mocks"
 )
 
 func TestTimeoutLoop_PanicOnNilCancel(t *testing.T) {
@@ -38,3 +43,33 @@ func TestTimeoutLoop_PanicOnNilCancel(t *testing.T) {
 	}
 	require.False(t, panicked)
 }
+
+func TestPendingLogChanges(t *testing.T) {
+	backend := filtermocks.NewBackend(t)
+	api := &PublicFilterAPI{backend: backend}
+	f := &filter{
+		typ:  filters.LogsSubscription,
+		crit: filters.FilterCriteria{FromBlock: big.NewInt(-1), ToBlock: big.NewInt(-1), Addresses: []common.Address{{1}}},
+	}
+
+	log1 := &ethtypes.Log{Address: common.Address{1}, TxHash: common.Hash{1}}
+	log2 := &ethtypes.Log{Address: common.Address{1}, TxHash: common.Hash{2}}
+	other := &ethtypes.Log{Address: common.Address{2}, TxHash: common.Hash{3}}
+
+	backend.EXPECT().PendingLogs().Return([]*ethtypes.Log{log1, other}, nil).Once()
+	logs, err := api.pendingLogChanges(f)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{log1}, logs)
+
+	// only the logs of the new pending txs are returned
+	backend.EXPECT().PendingLogs().Return([]*ethtypes.Log{log1, log2, other}, nil).Once()
+	logs, err = api.pendingLogChanges(f)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{log2}, logs)
+
+	backend.EXPECT().PendingLogs().Return(nil, nil).Once()
+	logs, err = api.pendingLogChanges(f)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+	require.Empty(t, f.pendingTxs)
+}
@@ -116,9 +116,13 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 		return f.blockLogs(blockRes, bloom)
 	}
 
-	// Disallow pending logs.
-	if f.criteria.FromBlock.Int64() == rpc.PendingBlockNumber.Int64() || f.criteria.ToBlock.Int64() == rpc.PendingBlockNumber.Int64() {
-		return nil, errPendingLogsUnsupported
+	// Short-cut if all we care about is pending logs
+	pending := f.criteria.ToBlock.Int64() == rpc.PendingBlockNumber.Int64()
+	if f.criteria.FromBlock.Int64() == rpc.PendingBlockNumber.Int64() {
+		if !pending {
+			return nil, errInvalidBlockRange
+		}
+		return f.pendingLogs(0, logLimit)
 	}
 
 	// Figure out the limits of the filter range
@@ -135,7 +139,9 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 	head := header.Number.Uint64()
 	resolveSpecial := func(number int64) (uint64, error) {
 		switch number {
-		case rpc.LatestBlockNumber.Int64(), rpc.FinalizedBlockNumber.Int64(), rpc.SafeBlockNumber.Int64():
+		case rpc.LatestBlockNumber.Int64(), rpc.FinalizedBlockNumber.Int64(), rpc.SafeBlockNumber.Int64(),
+			rpc.PendingBlockNumber.Int64():
+			// the pending logs are appended to the ones of the mined blocks
 			return head, nil
 		case rpc.EarliestBlockNumber.Int64():
 			return 1, nil
@@ -169,6 +175,33 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
+	logs, err = f.rangeLogs(from, to, logLimit)
+	if err != nil || !pending {
+		return logs, err
+	}
+	pendingLogs, err := f.pendingLogs(len(logs), logLimit)
+	if err != nil {
+		return nil, err
+	}
+	return append(logs, pendingLogs...), nil
+}
+
+// rangeLogs returns the logs matching the filter criteria within the range of
+// mined blocks.
+func (f *Filter) rangeLogs(from, to uint64, logLimit int) (logs []*ethtypes.Log, err error) {
+	// use the log index of the EVM indexer if it covers the range, fetching
+	// one more log than the limit to detect when it's exceeded
+	indexed, ok, err := f.backend.GetLogsFromIndex(int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics, logLimit+1) //#nosec G115
+	switch {
+	case err != nil:
+		f.logger.Error("failed to query the log index, scanning the blocks", "from", from, "to", to, "error", err.Error())
+	case ok:
+		if len(indexed) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		return indexed, nil
+	}
+
 	for height := from; height <= to; height++ {
 		h := int64(height) //#nosec G115
 		blockRes, err := f.backend.CometBlockResultByNumber(&h)
@@ -196,6 +229,21 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 	return logs, nil
 }
 
+// pendingLogs returns the logs of the pending transactions matching the filter
+// criteria. count is the number of logs already returned by the query.
+func (f *Filter) pendingLogs(count, logLimit int) ([]*ethtypes.Log, error) {
+	unfiltered, err := f.backend.PendingLogs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending logs: %w", err)
+	}
+
+	logs := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+	if count+len(logs) > logLimit {
+		return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	return logs, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *cmtrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -41,6 +41,15 @@ func (m *MockBackend) GetLogsByHeight(i *int64) ([][]*ethtypes.Log, error) {
 	panic("implement me")
 }
 
+func (m *MockBackend) GetLogsFromIndex(int64, int64, []common.Address, [][]common.Hash, int) ([]*ethtypes.Log, bool, error) {
+	// the log index is disabled
+	return nil, false, nil
+}
+
+func (m *MockBackend) PendingLogs() ([]*ethtypes.Log, error) {
+	panic("implement me")
+}
+
 func (m *MockBackend) BloomStatus() (uint64, uint64) {
 	panic("implement me")
 }
@@ -202,6 +211,62 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "logs from the log index",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(10), Addresses: []common.Address{{1}}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(10)}, nil)
+				b.EXPECT().GetLogsFromIndex(int64(1), int64(10), []common.Address{{1}}, [][]common.Hash(nil), 16).
+					Return([]*ethtypes.Log{{Address: common.Address{1}, BlockNumber: 2}}, true, nil)
+			},
+			expLogs: []*ethtypes.Log{{Address: common.Address{1}, BlockNumber: 2}},
+		},
+		{
+			name:   "logs from the log index exceed the limit",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(10)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(10)}, nil)
+				b.EXPECT().GetLogsFromIndex(int64(1), int64(10), []common.Address(nil), [][]common.Hash(nil), 16).
+					Return(make([]*ethtypes.Log, 16), true, nil)
+			},
+			expErr: "query returned more than 15 results",
+		},
+		{
+			name:   "pending logs",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(-1), ToBlock: big.NewInt(-1), Addresses: []common.Address{{1}}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().PendingLogs().Return([]*ethtypes.Log{{Address: common.Address{1}, BlockNumber: 11}, {Address: common.Address{2}, BlockNumber: 11}}, nil)
+			},
+			expLogs: []*ethtypes.Log{{Address: common.Address{1}, BlockNumber: 11}},
+		},
+		{
+			name:   "mined and pending logs",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(-1)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(10)}, nil)
+				b.EXPECT().GetLogsFromIndex(int64(1), int64(10), []common.Address(nil), [][]common.Hash(nil), 16).
+					Return([]*ethtypes.Log{{BlockNumber: 2}}, true, nil)
+				b.EXPECT().PendingLogs().Return([]*ethtypes.Log{{BlockNumber: 11}}, nil)
+			},
+			expLogs: []*ethtypes.Log{{BlockNumber: 2}, {BlockNumber: 11}},
+		},
+		{
+			name:   "mined and pending logs exceed the limit",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(-1)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(10)}, nil)
+				b.EXPECT().GetLogsFromIndex(int64(1), int64(10), []common.Address(nil), [][]common.Hash(nil), 16).
+					Return(make([]*ethtypes.Log, 10), true, nil)
+				b.EXPECT().PendingLogs().Return([]*ethtypes.Log{{}, {}, {}, {}, {}, {}}, nil)
+			},
+			expErr: "query returned more than 15 results",
+		},
+		{
+			name:         "pending from block with mined to block returns error",
+			filter:       filters.FilterCriteria{FromBlock: big.NewInt(-1), ToBlock: big.NewInt(10)},
+			expectations: func(*filtermocks.Backend) {},
+			expErr:       "invalid block range params",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -430,6 +430,75 @@ func (_c *Backend_GetLogsByHeight_Call) RunAndReturn(run func(*int64) ([][]*type
 	return _c
 }
 
+// GetLogsFromIndex provides a mock function with given fields: from, to, addresses, topics, limit
+func (_m *Backend) GetLogsFromIndex(from int64, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*types.Log, bool, error) {
+	ret := _m.Called(from, to, addresses, topics, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogsFromIndex")
+	}
+
+	var r0 []*types.Log
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Address, [][]common.Hash, int) ([]*types.Log, bool, error)); ok {
+		return rf(from, to, addresses, topics, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Address, [][]common.Hash, int) []*types.Log); ok {
+		r0 = rf(from, to, addresses, topics, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, []common.Address, [][]common.Hash, int) bool); ok {
+		r1 = rf(from, to, addresses, topics, limit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(int64, int64, []common.Address, [][]common.Hash, int) error); ok {
+		r2 = rf(from, to, addresses, topics, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backend_GetLogsFromIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogsFromIndex'
+type Backend_GetLogsFromIndex_Call struct {
+	*mock.Call
+}
+
+// GetLogsFromIndex is a helper method to define mock.On call
+//   - from int64
+//   - to int64
+//   - addresses []common.Address
+//   - topics [][]common.Hash
+//   - limit int
+func (_e *Backend_Expecter) GetLogsFromIndex(from interface{}, to interface{}, addresses interface{}, topics interface{}, limit interface{}) *Backend_GetLogsFromIndex_Call {
+	return &Backend_GetLogsFromIndex_Call{Call: _e.mock.On("GetLogsFromIndex", from, to, addresses, topics, limit)}
+}
+
+func (_c *Backend_GetLogsFromIndex_Call) Run(run func(from int64, to int64, addresses []common.Address, topics [][]common.Hash, limit int)) *Backend_GetLogsFromIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].([]common.Address), args[3].([][]common.Hash), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *Backend_GetLogsFromIndex_Call) Return(_a0 []*types.Log, _a1 bool, _a2 error) *Backend_GetLogsFromIndex_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backend_GetLogsFromIndex_Call) RunAndReturn(run func(int64, int64, []common.Address, [][]common.Hash, int) ([]*types.Log, bool, error)) *Backend_GetLogsFromIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HeaderByHash provides a mock function with given fields: blockHash
 func (_m *Backend) HeaderByHash(blockHash common.Hash) (*types.Header, error) {
 	ret := _m.Called(blockHash)
@@ -546,6 +615,63 @@ func (_c *Backend_HeaderByNumber_Call) RunAndReturn(run func(rpctypes.BlockNumbe
 	return _c
 }
 
+// PendingLogs provides a mock function with no fields
+func (_m *Backend) PendingLogs() ([]*types.Log, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingLogs")
+	}
+
+	var r0 []*types.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*types.Log, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*types.Log); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_PendingLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingLogs'
+type Backend_PendingLogs_Call struct {
+	*mock.Call
+}
+
+// PendingLogs is a helper method to define mock.On call
+func (_e *Backend_Expecter) PendingLogs() *Backend_PendingLogs_Call {
+	return &Backend_PendingLogs_Call{Call: _e.mock.On("PendingLogs")}
+}
+
+func (_c *Backend_PendingLogs_Call) Run(run func()) *Backend_PendingLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_PendingLogs_Call) Return(_a0 []*types.Log, _a1 error) *Backend_PendingLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_PendingLogs_Call) RunAndReturn(run func() ([]*types.Log, error)) *Backend_PendingLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RPCBlockRangeCap provides a mock function with no fields
 func (_m *Backend) RPCBlockRangeCap() int32 {
 	ret := _m.Called()
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // FilterLogs creates a slice of logs matching the given criteria.
@@ -95,3 +96,9 @@ func returnLogs(logs []*ethtypes.Log) []*ethtypes.Log {
 	}
 	return logs
 }
+
+// isPendingBlock returns true if the filter criteria block number refers to the
+// pending block.
+func isPendingBlock(number *big.Int) bool {
+	return number != nil && number.Int64() == rpc.PendingBlockNumber.Int64()
+}
@@ -0,0 +1,532 @@
+package ots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// apiLevel is the version of the Otterscan API implemented by the namespace.
+const apiLevel = 8
+
+// callTraceConfig traces the call frames of a transaction.
+var callTraceConfig = &rpctypes.TraceConfig{
+	TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"},
+}
+
+// errNoAddressIndex is returned by the address search methods when the node
+// doesn't run the EVM tx indexer.
+var errNoAddressIndex = errors.New("address search requires the EVM tx indexer, enable it with json-rpc.enable-indexer")
+
+// API is the collection of Otterscan APIs used by the Otterscan block
+// explorer on top of the standard eth namespace.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+	indexer servertypes.EVMTxIndexer
+}
+
+// NewAPI creates a new API definition for the Otterscan methods. The address
+// search methods are only available if the EVM tx indexer is enabled.
+func NewAPI(logger log.Logger, backend backend.EVMBackend, indexer servertypes.EVMTxIndexer) *API {
+	return &API{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+		indexer: indexer,
+	}
+}
+
+// GetApiLevel returns the version of the Otterscan API implemented by the node.
+func (api *API) GetApiLevel() uint64 { //nolint:revive // the method name is defined by the Otterscan API
+	api.logger.Debug("ots_getApiLevel")
+	return apiLevel
+}
+
+// HasCode returns true if the address has code at the given block.
+func (api *API) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (bool, error) {
+	api.logger.Debug("ots_hasCode", "address", address, "block number or hash", blockNrOrHash)
+	code, err := api.backend.GetCode(address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetInternalOperations returns the ETH transfers, contract creations and
+// self-destructs that happened within the transaction calls.
+func (api *API) GetInternalOperations(hash common.Hash) ([]*InternalOperation, error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash)
+	root, err := api.callTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []*InternalOperation{}
+	var walk func(frame *callFrame, depth int)
+	walk = func(frame *callFrame, depth int) {
+		// the effects of failed calls are reverted
+		if frame.Error != "" {
+			return
+		}
+		op := &InternalOperation{From: frame.From, Value: frame.Value}
+		if frame.To != nil {
+			op.To = *frame.To
+		}
+		switch {
+		case frame.Type == "SELFDESTRUCT":
+			op.Type = OpSelfDestruct
+			ops = append(ops, op)
+		case depth > 0 && frame.Type == "CREATE":
+			op.Type = OpCreate
+			ops = append(ops, op)
+		case depth > 0 && frame.Type == "CREATE2":
+			op.Type = OpCreate2
+			ops = append(ops, op)
+		case depth > 0 && frame.Type == "CALL" && frame.Value != nil && frame.Value.ToInt().Sign() > 0:
+			op.Type = OpTransfer
+			ops = append(ops, op)
+		}
+		for _, call := range frame.Calls {
+			walk(call, depth+1)
+		}
+	}
+	walk(root, 0)
+	return ops, nil
+}
+
+// GetTransactionError returns the revert data of the transaction, or empty
+// bytes if the transaction succeeded.
+func (api *API) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	api.logger.Debug("ots_getTransactionError", "hash", hash)
+	root, err := api.callTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+	if root.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return root.Output, nil
+}
+
+// TraceTransaction returns the call frames of the transaction in execution
+// order.
+func (api *API) TraceTransaction(hash common.Hash) ([]*TraceEntry, error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash)
+	root, err := api.callTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*TraceEntry{}
+	var walk func(frame *callFrame, depth int)
+	walk = func(frame *callFrame, depth int) {
+		entry := &TraceEntry{
+			Type:   frame.Type,
+			Depth:  depth,
+			From:   frame.From,
+			To:     frame.To,
+			Value:  frame.Value,
+			Input:  frame.Input,
+			Output: frame.Output,
+		}
+		// these calls don't transfer any value
+		if frame.Type == "STATICCALL" || frame.Type == "DELEGATECALL" {
+			entry.Value = nil
+		}
+		entries = append(entries, entry)
+		for _, call := range frame.Calls {
+			walk(call, depth+1)
+		}
+	}
+	walk(root, 0)
+	return entries, nil
+}
+
+// GetBlockDetails returns the block without its transactions along with the
+// fees paid by its transactions.
+func (api *API) GetBlockDetails(number rpctypes.BlockNumber) (*BlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetails", "number", number)
+	block, err := api.backend.GetBlockByNumber(number, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return api.blockDetails(block)
+}
+
+// GetBlockDetailsByHash returns the block without its transactions along with
+// the fees paid by its transactions.
+func (api *API) GetBlockDetailsByHash(hash common.Hash) (*BlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	block, err := api.backend.GetBlockByHash(hash, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return api.blockDetails(block)
+}
+
+// GetBlockTransactions returns a page of the transactions of the block along
+// with their receipts. The pages are counted from the end of the block and
+// the transaction inputs are truncated to the method selector.
+func (api *API) GetBlockTransactions(number rpctypes.BlockNumber, pageNumber uint8, pageSize uint8) (*BlockTransactions, error) {
+	api.logger.Debug("ots_getBlockTransactions", "number", number, "page", pageNumber, "size", pageSize)
+	block, err := api.backend.GetBlockByNumber(number, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	pageEnd := max(len(txs)-int(pageNumber)*int(pageSize), 0)
+	pageStart := max(pageEnd-int(pageSize), 0)
+	page := txs[pageStart:pageEnd]
+
+	receipts := make([]map[string]interface{}, 0, len(page))
+	for _, tx := range page {
+		rpcTx, ok := tx.(*rpctypes.RPCTransaction)
+		if !ok {
+			return nil, fmt.Errorf("invalid transaction type %T", tx)
+		}
+		if len(rpcTx.Input) > 4 {
+			rpcTx.Input = rpcTx.Input[:4]
+		}
+		receipt, err := api.backend.GetTransactionReceipt(rpcTx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt of transaction %s not found", rpcTx.Hash)
+		}
+		receipt["logs"] = nil
+		receipt["logsBloom"] = nil
+		receipts = append(receipts, receipt)
+	}
+
+	fullBlock := prunedBlock(block)
+	fullBlock["transactions"] = page
+	return &BlockTransactions{FullBlock: fullBlock, Receipts: receipts}, nil
+}
+
+// SearchTransactionsBefore returns the most recent transactions involving the
+// address before the given block, the most recent ones if the block is 0.
+// The page size is a soft limit since a page always holds all the
+// transactions of its blocks.
+//
+// NOTE: the transactions are found through the per-address index of the EVM
+// tx indexer, which only holds the senders, the recipients and the created
+// contracts of the transactions, not the addresses involved in internal
+// calls.
+func (api *API) SearchTransactionsBefore(addr common.Address, blockNum uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", addr, "block", blockNum, "size", pageSize)
+	toBlock := int64(math.MaxInt64)
+	if blockNum > 0 {
+		toBlock = int64(blockNum) - 1 //nolint:gosec // G115 // block heights fit in int64
+	}
+	txs, hasMore, err := api.searchTransactions(addr, 0, toBlock, true, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.transactionsWithReceipts(txs)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = blockNum == 0
+	res.LastPage = !hasMore
+	return res, nil
+}
+
+// SearchTransactionsAfter returns the oldest transactions involving the
+// address after the given block, the oldest ones if the block is 0. The
+// transactions are ordered from the most recent, as for
+// SearchTransactionsBefore.
+func (api *API) SearchTransactionsAfter(addr common.Address, blockNum uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", addr, "block", blockNum, "size", pageSize)
+	fromBlock := int64(blockNum) + 1 //nolint:gosec // G115 // block heights fit in int64
+	txs, hasMore, err := api.searchTransactions(addr, fromBlock, math.MaxInt64, false, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(txs)
+	res, err := api.transactionsWithReceipts(txs)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = !hasMore
+	res.LastPage = blockNum == 0
+	return res, nil
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the given nonce, nil if there is none.
+func (api *API) GetTransactionBySenderAndNonce(addr common.Address, nonce uint64) (*common.Hash, error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "address", addr, "nonce", nonce)
+	idx, err := api.addressIndexer()
+	if err != nil {
+		return nil, err
+	}
+	sent, err := idx.GetBySender(addr, servertypes.AddressTxsQuery{ToBlock: math.MaxInt64})
+	if err != nil {
+		return nil, err
+	}
+
+	// the nonces of the sent transactions are increasing, but not contiguous
+	// since cosmos transactions use the same sequence
+	var searchErr error
+	nonceAt := func(i int) uint64 {
+		tx, err := api.backend.GetTransactionByHash(sent[i].Hash)
+		if err != nil || tx == nil {
+			searchErr = fmt.Errorf("transaction %s not found: %w", sent[i].Hash, err)
+			return math.MaxUint64
+		}
+		return uint64(tx.Nonce)
+	}
+	i := sort.Search(len(sent), func(i int) bool { return nonceAt(i) >= nonce })
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if i == len(sent) || nonceAt(i) != nonce {
+		return nil, searchErr
+	}
+	return &sent[i].Hash, nil
+}
+
+// GetContractCreator returns the transaction that created the contract and
+// its sender, nil if the address is not a contract created by a transaction.
+//
+// NOTE: contracts created by other contracts are not indexed.
+func (api *API) GetContractCreator(addr common.Address) (*ContractCreator, error) {
+	api.logger.Debug("ots_getContractCreator", "address", addr)
+	idx, err := api.addressIndexer()
+	if err != nil {
+		return nil, err
+	}
+	creation, err := idx.GetContractCreation(addr)
+	if err != nil || creation == nil {
+		return nil, err
+	}
+	tx, err := api.backend.GetTransactionByHash(creation.Hash)
+	if err != nil || tx == nil {
+		return nil, fmt.Errorf("transaction %s not found: %w", creation.Hash, err)
+	}
+	return &ContractCreator{Tx: creation.Hash, Creator: tx.From}, nil
+}
+
+// callTrace returns the call frames of the transaction.
+func (api *API) callTrace(hash common.Hash) (*callFrame, error) {
+	res, err := api.backend.TraceTransaction(hash, callTraceConfig)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	var root callFrame
+	if err := json.Unmarshal(bz, &root); err != nil {
+		return nil, err
+	}
+	return &root, nil
+}
+
+// blockDetails prunes the block and computes its fees.
+func (api *API) blockDetails(block map[string]interface{}) (*BlockDetails, error) {
+	number, ok := block["number"].(*hexutil.Big)
+	if !ok {
+		return nil, fmt.Errorf("invalid block number type %T", block["number"])
+	}
+	blockNr := rpctypes.NewBlockNumber(number.ToInt())
+	receipts, err := api.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	if err != nil {
+		return nil, err
+	}
+
+	totalFees := new(big.Int)
+	for _, receipt := range receipts {
+		gasUsed, _ := receipt["gasUsed"].(hexutil.Uint64)
+		gasPrice, _ := receipt["effectiveGasPrice"].(*hexutil.Big)
+		if gasPrice == nil {
+			continue
+		}
+		totalFees.Add(totalFees, new(big.Int).Mul(new(big.Int).SetUint64(uint64(gasUsed)), gasPrice.ToInt()))
+	}
+
+	zero := (*hexutil.Big)(new(big.Int))
+	return &BlockDetails{
+		Block:     prunedBlock(block),
+		Issuance:  Issuance{BlockReward: zero, UncleReward: zero, Issuance: zero},
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// searchTransactions returns a page of the transactions involving the address
+// within the block range along with whether there are more transactions past
+// the page.
+func (api *API) searchTransactions(
+	addr common.Address,
+	fromBlock, toBlock int64,
+	reverse bool,
+	pageSize int,
+) ([]servertypes.IndexedTx, bool, error) {
+	if pageSize == 0 {
+		return nil, false, errors.New("page size must be greater than 0")
+	}
+	idx, err := api.addressIndexer()
+	if err != nil {
+		return nil, false, err
+	}
+
+	query := servertypes.AddressTxsQuery{FromBlock: fromBlock, ToBlock: toBlock, Reverse: reverse, Limit: pageSize}
+	txs, err := addressTxs(idx, addr, query)
+	if err != nil || len(txs) < pageSize {
+		return txs, false, err
+	}
+
+	// complete the last block of the page so that a block is never split
+	// across pages
+	last := txs[len(txs)-1]
+	lastBlockTxs, err := addressTxs(idx, addr, servertypes.AddressTxsQuery{
+		FromBlock: last.Height,
+		ToBlock:   last.Height,
+		After:     &last,
+		Reverse:   reverse,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	txs = append(txs, lastBlockTxs...)
+
+	query.After = &txs[len(txs)-1]
+	query.Limit = 1
+	more, err := addressTxs(idx, addr, query)
+	if err != nil {
+		return nil, false, err
+	}
+	return txs, len(more) > 0, nil
+}
+
+// transactionsWithReceipts loads the transactions and their receipts. The
+// receipts also hold the timestamp of their block.
+func (api *API) transactionsWithReceipts(txs []servertypes.IndexedTx) (*TransactionsWithReceipts, error) {
+	res := &TransactionsWithReceipts{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(txs)),
+		Receipts: make([]map[string]interface{}, 0, len(txs)),
+	}
+	timestamps := make(map[int64]uint64)
+	for _, indexed := range txs {
+		tx, err := api.backend.GetTransactionByHash(indexed.Hash)
+		if err != nil || tx == nil {
+			return nil, fmt.Errorf("transaction %s not found: %w", indexed.Hash, err)
+		}
+		receipt, err := api.backend.GetTransactionReceipt(indexed.Hash)
+		if err != nil || receipt == nil {
+			return nil, fmt.Errorf("receipt of transaction %s not found: %w", indexed.Hash, err)
+		}
+
+		timestamp, ok := timestamps[indexed.Height]
+		if !ok {
+			header, err := api.backend.HeaderByNumber(rpctypes.BlockNumber(indexed.Height))
+			if err != nil {
+				return nil, err
+			}
+			timestamp = header.Time
+			timestamps[indexed.Height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+func (api *API) addressIndexer() (servertypes.EVMTxIndexer, error) {
+	if api.indexer == nil {
+		return nil, errNoAddressIndex
+	}
+	return api.indexer, nil
+}
+
+// addressTxs returns a page of the transactions sent by, sent to or creating
+// the address, ordered by position.
+func addressTxs(idx servertypes.EVMTxIndexer, addr common.Address, query servertypes.AddressTxsQuery) ([]servertypes.IndexedTx, error) {
+	sent, err := idx.GetBySender(addr, query)
+	if err != nil {
+		return nil, err
+	}
+	received, err := idx.GetByRecipient(addr, query)
+	if err != nil {
+		return nil, err
+	}
+	txs := append(sent, received...)
+
+	creation, err := idx.GetContractCreation(addr)
+	if err != nil {
+		return nil, err
+	}
+	if creation != nil && inQuery(*creation, query) {
+		txs = append(txs, *creation)
+	}
+
+	slices.SortFunc(txs, func(a, b servertypes.IndexedTx) int {
+		c := compareIndexedTx(a, b)
+		if query.Reverse {
+			return -c
+		}
+		return c
+	})
+	// self transfers are both sent and received
+	txs = slices.CompactFunc(txs, func(a, b servertypes.IndexedTx) bool { return a.Hash == b.Hash })
+	if query.Limit > 0 && len(txs) > query.Limit {
+		txs = txs[:query.Limit]
+	}
+	return txs, nil
+}
+
+// inQuery returns true if the transaction is within the block range of the
+// query and past its cursor.
+func inQuery(tx servertypes.IndexedTx, query servertypes.AddressTxsQuery) bool {
+	if tx.Height < query.FromBlock || tx.Height > query.ToBlock {
+		return false
+	}
+	if query.After == nil {
+		return true
+	}
+	c := compareIndexedTx(tx, *query.After)
+	return (!query.Reverse && c > 0) || (query.Reverse && c < 0)
+}
+
+func compareIndexedTx(a, b servertypes.IndexedTx) int {
+	if a.Height != b.Height {
+		if a.Height < b.Height {
+			return -1
+		}
+		return 1
+	}
+	return int(a.EthTxIndex) - int(b.EthTxIndex)
+}
+
+// prunedBlock returns a copy of the block without its transactions and logs
+// bloom, but with its transaction count.
+func prunedBlock(block map[string]interface{}) map[string]interface{} {
+	pruned := make(map[string]interface{}, len(block))
+	for k, v := range block {
+		pruned[k] = v
+	}
+	txs, _ := block["transactions"].([]interface{})
+	pruned["transactionCount"] = len(txs)
+	pruned["logsBloom"] = nil
+	delete(pruned, "transactions")
+	return pruned
+}
@@ -0,0 +1,142 @@
+package ots
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func indexedTx(height int64, index int32) servertypes.IndexedTx {
+	return servertypes.IndexedTx{
+		Hash:       common.BytesToHash([]byte{byte(height), byte(index)}),
+		Height:     height,
+		EthTxIndex: index,
+	}
+}
+
+// setupIndexer indexes the txs of the address into a kv indexer.
+func setupIndexer(t *testing.T, addr common.Address, sent, received []servertypes.IndexedTx, creation *servertypes.IndexedTx) servertypes.EVMTxIndexer {
+	t.Helper()
+	db := dbm.NewMemDB()
+	for prefix, txs := range map[byte][]servertypes.IndexedTx{
+		indexer.KeyPrefixSender:    sent,
+		indexer.KeyPrefixRecipient: received,
+	} {
+		for _, tx := range txs {
+			require.NoError(t, db.Set(indexer.AddressTxKey(prefix, addr, tx.Height, tx.EthTxIndex), tx.Hash.Bytes()))
+		}
+	}
+	if creation != nil {
+		require.NoError(t, db.Set(indexer.AddressTxKey(indexer.KeyPrefixContract, addr, creation.Height, creation.EthTxIndex), creation.Hash.Bytes()))
+	}
+	return indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+}
+
+func TestAddressTxs(t *testing.T) {
+	addr := common.Address{1}
+	creation := indexedTx(3, 0)
+	idx := setupIndexer(t, addr,
+		[]servertypes.IndexedTx{indexedTx(1, 0), indexedTx(3, 1)},
+		[]servertypes.IndexedTx{indexedTx(2, 0), indexedTx(3, 1)},
+		&creation,
+	)
+
+	testCases := []struct {
+		name   string
+		query  servertypes.AddressTxsQuery
+		expect []servertypes.IndexedTx
+	}{
+		{
+			"ascending",
+			servertypes.AddressTxsQuery{ToBlock: 10},
+			[]servertypes.IndexedTx{indexedTx(1, 0), indexedTx(2, 0), indexedTx(3, 0), indexedTx(3, 1)},
+		},
+		{
+			"descending",
+			servertypes.AddressTxsQuery{ToBlock: 10, Reverse: true},
+			[]servertypes.IndexedTx{indexedTx(3, 1), indexedTx(3, 0), indexedTx(2, 0), indexedTx(1, 0)},
+		},
+		{
+			"limit",
+			servertypes.AddressTxsQuery{ToBlock: 10, Reverse: true, Limit: 2},
+			[]servertypes.IndexedTx{indexedTx(3, 1), indexedTx(3, 0)},
+		},
+		{
+			"after",
+			servertypes.AddressTxsQuery{ToBlock: 10, After: &creation},
+			[]servertypes.IndexedTx{indexedTx(3, 1)},
+		},
+		{
+			"after, descending",
+			servertypes.AddressTxsQuery{ToBlock: 10, After: &creation, Reverse: true},
+			[]servertypes.IndexedTx{indexedTx(2, 0), indexedTx(1, 0)},
+		},
+		{
+			"block range",
+			servertypes.AddressTxsQuery{FromBlock: 2, ToBlock: 2},
+			[]servertypes.IndexedTx{indexedTx(2, 0)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txs, err := addressTxs(idx, addr, tc.query)
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, txs)
+		})
+	}
+}
+
+func TestSearchTransactions(t *testing.T) {
+	addr := common.Address{1}
+	api := &API{indexer: setupIndexer(t, addr,
+		[]servertypes.IndexedTx{indexedTx(1, 0), indexedTx(2, 0), indexedTx(2, 1), indexedTx(2, 2), indexedTx(4, 0)},
+		nil, nil,
+	)}
+
+	// the page is extended to the whole last block
+	txs, hasMore, err := api.searchTransactions(addr, 0, 10, false, 2)
+	require.NoError(t, err)
+	require.Equal(t, []servertypes.IndexedTx{indexedTx(1, 0), indexedTx(2, 0), indexedTx(2, 1), indexedTx(2, 2)}, txs)
+	require.True(t, hasMore)
+
+	txs, hasMore, err = api.searchTransactions(addr, 3, 10, false, 2)
+	require.NoError(t, err)
+	require.Equal(t, []servertypes.IndexedTx{indexedTx(4, 0)}, txs)
+	require.False(t, hasMore)
+
+	txs, hasMore, err = api.searchTransactions(addr, 0, 3, true, 1)
+	require.NoError(t, err)
+	require.Equal(t, []servertypes.IndexedTx{indexedTx(2, 2), indexedTx(2, 1), indexedTx(2, 0)}, txs)
+	require.True(t, hasMore)
+
+	_, _, err = api.searchTransactions(addr, 0, 3, true, 0)
+	require.Error(t, err)
+
+	_, _, err = (&API{}).searchTransactions(addr, 0, 3, true, 1)
+	require.ErrorIs(t, err, errNoAddressIndex)
+}
+
+func TestPrunedBlock(t *testing.T) {
+	block := map[string]interface{}{
+		"hash":         common.Hash{1},
+		"logsBloom":    "0x00",
+		"transactions": []interface{}{common.Hash{2}, common.Hash{3}},
+	}
+	pruned := prunedBlock(block)
+	require.Equal(t, map[string]interface{}{
+		"hash":             common.Hash{1},
+		"logsBloom":        nil,
+		"transactionCount": 2,
+	}, pruned)
+	// the block is left untouched
+	require.Len(t, block["transactions"], 2)
+}
@@ -0,0 +1,85 @@
+package ots
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Types of the internal operations.
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// InternalOperation is an ETH transfer, contract creation or self-destruct
+// that happened within a transaction.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a call frame of a transaction.
+type TraceEntry struct {
+	Type   string          `json:"type"`
+	Depth  int             `json:"depth"`
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to"`
+	Value  *hexutil.Big    `json:"value"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output"`
+}
+
+// BlockDetails is a block without its transactions, along with its fees.
+type BlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  Issuance               `json:"issuance"`
+	TotalFees *hexutil.Big           `json:"totalFees"`
+}
+
+// Issuance is the amount of tokens minted by a block. There are no block
+// rewards for the EVM, so it is always zero.
+type Issuance struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// BlockTransactions is a page of the transactions of a block along with
+// their receipts.
+type BlockTransactions struct {
+	FullBlock map[string]interface{}   `json:"fullblock"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+}
+
+// TransactionsWithReceipts is a page of the transactions of an address along
+// with their receipts, ordered from the most recent.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// ContractCreator is the transaction that created a contract and its sender.
+type ContractCreator struct {
+	Tx      common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// callFrame is a call frame as returned by the callTracer.
+type callFrame struct {
+	Type   string          `json:"type"`
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to"`
+	Value  *hexutil.Big    `json:"value"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output"`
+	Error  string          `json:"error"`
+	Calls  []*callFrame    `json:"calls"`
+}
@@ -0,0 +1,504 @@
+package trace
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+var (
+	// flatTraceConfig traces the calls in the Parity flat format.
+	flatTraceConfig = &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: "flatCallTracer"},
+		TracerConfig: json.RawMessage(`{"convertParityErrors":true}`),
+	}
+
+	// vmTraceConfig records the struct logs needed to build the vm traces.
+	vmTraceConfig = &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{EnableMemory: true, DisableStorage: true},
+	}
+)
+
+// API is the collection of Parity/OpenEthereum compatible tracing APIs. The
+// traces are produced by the native flatCallTracer and prestateTracer through
+// the EVM module trace queries.
+type API struct {
+	logger        log.Logger
+	backend       backend.EVMBackend
+	indexer       servertypes.EVMTxIndexer
+	blockRangeCap int32
+}
+
+// NewAPI creates a new API definition for the trace methods.
+func NewAPI(logger log.Logger, backend backend.EVMBackend, indexer servertypes.EVMTxIndexer, blockRangeCap int32) *API {
+	return &API{
+		logger:        logger.With("module", "trace"),
+		backend:       backend,
+		indexer:       indexer,
+		blockRangeCap: blockRangeCap,
+	}
+}
+
+// Block returns the traces of all the transactions in the given block.
+func (api *API) Block(blockNr rpctypes.BlockNumber) ([]*FlatTrace, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+	return api.blockTraces(blockNr)
+}
+
+// Transaction returns the traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) ([]*FlatTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	res, err := api.backend.TraceTransaction(hash, flatTraceConfig)
+	if err != nil {
+		return nil, err
+	}
+	var traces []*FlatTrace
+	if err := decodeResult(res, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// Get returns the trace of the given transaction at the given trace address.
+func (api *API) Get(hash common.Hash, indices []hexutil.Uint64) (*FlatTrace, error) {
+	api.logger.Debug("trace_get", "hash", hash, "indices", indices)
+	traces, err := api.Transaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	for _, trace := range traces {
+		if slices.EqualFunc(trace.TraceAddress, indices, func(a int, b hexutil.Uint64) bool {
+			return uint64(a) == uint64(b) //nolint:gosec // G115 // trace addresses are never negative
+		}) {
+			return trace, nil
+		}
+	}
+	return nil, nil
+}
+
+// Filter returns the traces of the given block range matching the sender and
+// recipient addresses. With address filters and the EVM transaction indexer,
+// only the transactions sent by, sent to or creating the addresses are traced,
+// so the internal calls of the other transactions are not matched. Otherwise
+// the blocks are traced, skipping the ones without any EVM transaction
+// according to the indexer.
+func (api *API) Filter(args FilterArgs) ([]*FlatTrace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := int64(latest), int64(latest) //nolint:gosec // G115 // block heights fit in int64
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d is greater than to %d", from, to)
+	}
+	if to-from+1 > int64(api.blockRangeCap) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", api.blockRangeCap)
+	}
+
+	var skipped uint64
+	traces := []*FlatTrace{}
+	// appendMatches appends the matching traces, it returns true once the
+	// requested count is reached
+	appendMatches := func(candidates []*FlatTrace) bool {
+		for _, trace := range candidates {
+			if !args.matches(trace) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return true
+			}
+		}
+		return false
+	}
+
+	if api.indexer != nil && (len(args.FromAddress) > 0 || len(args.ToAddress) > 0) {
+		txs, err := api.addressTxs(args, max(from, 1), to)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range txs {
+			txTraces, err := api.Transaction(tx.Hash)
+			if err != nil {
+				return nil, err
+			}
+			if appendMatches(txTraces) {
+				return traces, nil
+			}
+		}
+		return traces, nil
+	}
+
+	for height := max(from, 1); height <= to; height++ {
+		if res, err := api.backend.GetTxByTxIndex(height, 0); err != nil || res == nil {
+			continue
+		}
+		blockTraces, err := api.blockTraces(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if appendMatches(blockTraces) {
+			return traces, nil
+		}
+	}
+	return traces, nil
+}
+
+// addressTxs returns the transactions of the block range sent by the from
+// addresses, or sent to or creating the to addresses, ordered by position.
+func (api *API) addressTxs(args FilterArgs, from, to int64) ([]servertypes.IndexedTx, error) {
+	query := servertypes.AddressTxsQuery{FromBlock: from, ToBlock: to}
+	var txs []servertypes.IndexedTx
+	for _, addr := range args.FromAddress {
+		sent, err := api.indexer.GetBySender(addr, query)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, sent...)
+	}
+	for _, addr := range args.ToAddress {
+		received, err := api.indexer.GetByRecipient(addr, query)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, received...)
+
+		creation, err := api.indexer.GetContractCreation(addr)
+		if err != nil {
+			return nil, err
+		}
+		if creation != nil && creation.Height >= from && creation.Height <= to {
+			txs = append(txs, *creation)
+		}
+	}
+
+	slices.SortFunc(txs, func(a, b servertypes.IndexedTx) int {
+		if c := cmp.Compare(a.Height, b.Height); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.EthTxIndex, b.EthTxIndex)
+	})
+	// a transaction can match several addresses
+	return slices.CompactFunc(txs, func(a, b servertypes.IndexedTx) bool { return a.Hash == b.Hash }), nil
+}
+
+// Call executes the given call on top of the given block and returns the
+// requested traces.
+func (api *API) Call(args evmtypes.TransactionArgs, traceTypes []string, blockNrOrHash *rpctypes.BlockNumberOrHash) (*TraceResults, error) {
+	api.logger.Debug("trace_call", "args", args, "trace types", traceTypes, "block number or hash", blockNrOrHash)
+	opts, err := parseTraceTypes(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceCall(args, opts, blockNrOrHashOrLatest(blockNrOrHash))
+}
+
+// CallMany executes the given calls sequentially on top of the given block,
+// each call seeing the state changes of the previous ones, and returns the
+// requested traces of each call. The calls are traced in a single execution,
+// with the union of the requested trace types.
+func (api *API) CallMany(calls []CallRequest, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]*TraceResults, error) {
+	api.logger.Debug("trace_callMany", "calls", len(calls), "block number or hash", blockNrOrHash)
+	bnh := blockNrOrHashOrLatest(blockNrOrHash)
+	var (
+		allOpts   traceOptions
+		callsOpts = make([]traceOptions, len(calls))
+		args      = make([]evmtypes.TransactionArgs, len(calls))
+	)
+	for i, call := range calls {
+		opts, err := parseTraceTypes(call.TraceTypes)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		callsOpts[i], args[i] = opts, call.Args
+		allOpts.stateDiff = allOpts.stateDiff || opts.stateDiff
+		allOpts.vmTrace = allOpts.vmTrace || opts.vmTrace
+	}
+
+	res, err := api.backend.TraceCallMany(args, bnh, allOpts.tracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	var vmRes []interface{}
+	if allOpts.vmTrace {
+		if vmRes, err = api.backend.TraceCallMany(args, bnh, vmTraceConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	codeAt := api.codeGetter(bnh)
+	results := make([]*TraceResults, len(calls))
+	for i, opts := range callsOpts {
+		var callVMRes interface{}
+		if opts.vmTrace {
+			callVMRes = vmRes[i]
+		}
+		if results[i], err = opts.results(res[i], callVMRes, callCode(args[i], codeAt), codeAt); err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+	}
+	return results, nil
+}
+
+// ReplayBlockTransactions replays all the transactions in the given block and
+// returns the requested traces of each transaction.
+func (api *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*TraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "trace types", traceTypes)
+	opts, err := parseTraceTypes(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	block, err := api.backend.CometBlockByNumber(blockNr)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	height := block.Block.Height
+	blockRes, err := api.backend.CometBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+	msgs := api.backend.EthMsgsFromCometBlock(block, blockRes)
+
+	results, err := api.backend.TraceBlock(rpctypes.BlockNumber(height), opts.tracerConfig(), block)
+	if err != nil {
+		return nil, err
+	}
+	var vmResults []*evmtypes.TxTraceResult
+	if opts.vmTrace {
+		if vmResults, err = api.backend.TraceBlock(rpctypes.BlockNumber(height), vmTraceConfig, block); err != nil {
+			return nil, err
+		}
+	}
+	if len(results) != len(msgs) || (opts.vmTrace && len(vmResults) != len(msgs)) {
+		return nil, fmt.Errorf("unexpected number of traces for block %d", height)
+	}
+
+	// the code of the contracts is taken from the state at the beginning of the block
+	parent := rpctypes.BlockNumber(height - 1)
+	codeAt := api.codeGetter(rpctypes.BlockNumberOrHash{BlockNumber: &parent})
+
+	replays := make([]*TraceResults, len(msgs))
+	for i, msg := range msgs {
+		if results[i].Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", msg.Hash(), results[i].Error)
+		}
+		var vmRes interface{}
+		if opts.vmTrace {
+			if vmResults[i].Error != "" {
+				return nil, fmt.Errorf("failed to trace transaction %s: %s", msg.Hash(), vmResults[i].Error)
+			}
+			vmRes = vmResults[i].Result
+		}
+
+		tx := msg.AsTransaction()
+		code := tx.Data()
+		if tx.To() != nil {
+			code = codeAt(*tx.To())
+		}
+		if replays[i], err = opts.results(results[i].Result, vmRes, code, codeAt); err != nil {
+			return nil, err
+		}
+		hash := tx.Hash()
+		replays[i].TransactionHash = &hash
+	}
+	return replays, nil
+}
+
+// blockTraces returns the flat traces of all the transactions in a block.
+func (api *API) blockTraces(blockNr rpctypes.BlockNumber) ([]*FlatTrace, error) {
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	block, err := api.backend.CometBlockByNumber(blockNr)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	results, err := api.backend.TraceBlock(rpctypes.BlockNumber(block.Block.Height), flatTraceConfig, block)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*FlatTrace{}
+	for _, res := range results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("failed to trace block %d: %s", block.Block.Height, res.Error)
+		}
+		var txTraces []*FlatTrace
+		if err := decodeResult(res.Result, &txTraces); err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// traceCall traces a call executed on top of the given block.
+func (api *API) traceCall(
+	args evmtypes.TransactionArgs,
+	opts traceOptions,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*TraceResults, error) {
+	res, err := api.backend.TraceCall(args, blockNrOrHash, opts.tracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	var vmRes interface{}
+	if opts.vmTrace {
+		if vmRes, err = api.backend.TraceCall(args, blockNrOrHash, vmTraceConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	codeAt := api.codeGetter(blockNrOrHash)
+	return opts.results(res, vmRes, callCode(args, codeAt), codeAt)
+}
+
+// callCode returns the code executed by the call, the init code for contract
+// creations.
+func callCode(args evmtypes.TransactionArgs, codeAt codeGetter) []byte {
+	if args.To != nil {
+		return codeAt(*args.To)
+	}
+	return args.GetData()
+}
+
+// codeGetter returns a function fetching the code of the accounts at the given
+// block.
+func (api *API) codeGetter(blockNrOrHash rpctypes.BlockNumberOrHash) codeGetter {
+	return func(addr common.Address) []byte {
+		code, err := api.backend.GetCode(addr, blockNrOrHash)
+		if err != nil {
+			api.logger.Debug("failed to get code", "address", addr, "error", err.Error())
+			return nil
+		}
+		return code
+	}
+}
+
+// traceOptions are the trace types requested on the replay and call methods.
+type traceOptions struct {
+	trace     bool
+	stateDiff bool
+	vmTrace   bool
+}
+
+func parseTraceTypes(traceTypes []string) (traceOptions, error) {
+	var opts traceOptions
+	for _, t := range traceTypes {
+		switch t {
+		case TypeTrace:
+			opts.trace = true
+		case TypeStateDiff:
+			opts.stateDiff = true
+		case TypeVMTrace:
+			opts.vmTrace = true
+		default:
+			return opts, fmt.Errorf("invalid trace type %q", t)
+		}
+	}
+	return opts, nil
+}
+
+// tracerConfig returns the config of the tracers run in a single execution
+// to produce the call traces and the state diff. The call traces are always
+// recorded since they hold the output of the execution.
+func (opts traceOptions) tracerConfig() *rpctypes.TraceConfig {
+	tracers := map[string]json.RawMessage{
+		"flatCallTracer": flatTraceConfig.TracerConfig,
+	}
+	if opts.stateDiff {
+		tracers["prestateTracer"] = json.RawMessage(`{"diffMode":true}`)
+	}
+	bz, _ := json.Marshal(tracers)
+	return &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: "muxTracer"},
+		TracerConfig: bz,
+	}
+}
+
+// results assembles the trace results out of the output of the tracers.
+func (opts traceOptions) results(res, vmRes interface{}, code []byte, codeAt codeGetter) (*TraceResults, error) {
+	var mux struct {
+		FlatCallTracer []*FlatTrace  `json:"flatCallTracer"`
+		PrestateTracer *prestateDiff `json:"prestateTracer"`
+	}
+	if err := decodeResult(res, &mux); err != nil {
+		return nil, err
+	}
+
+	results := &TraceResults{Output: hexutil.Bytes{}}
+	if len(mux.FlatCallTracer) > 0 && mux.FlatCallTracer[0].Result != nil {
+		switch root := mux.FlatCallTracer[0].Result; {
+		case root.Output != nil:
+			results.Output = *root.Output
+		case root.Code != nil:
+			results.Output = *root.Code
+		}
+	}
+	if opts.trace {
+		// the block and transaction fields are not part of the replay traces
+		for _, trace := range mux.FlatCallTracer {
+			trace.BlockHash, trace.BlockNumber = nil, nil
+			trace.TransactionHash, trace.TransactionPosition = nil, nil
+		}
+		results.Trace = mux.FlatCallTracer
+	}
+	if opts.stateDiff && mux.PrestateTracer != nil {
+		results.StateDiff = newStateDiff(mux.PrestateTracer)
+	}
+	if opts.vmTrace {
+		var logs structLogResult
+		if err := decodeResult(vmRes, &logs); err != nil {
+			return nil, err
+		}
+		results.VMTrace = newVMTrace(code, logs.StructLogs, codeAt)
+	}
+	return results, nil
+}
+
+// blockNrOrHashOrLatest defaults to the latest block if no block is given.
+func blockNrOrHashOrLatest(blockNrOrHash *rpctypes.BlockNumberOrHash) rpctypes.BlockNumberOrHash {
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		return rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	return *blockNrOrHash
+}
+
+// decodeResult decodes a tracer output, returned as a generic JSON object by
+// the backend, into the given type.
+func decodeResult(res interface{}, v interface{}) error {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
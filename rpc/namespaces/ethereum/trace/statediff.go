@@ -0,0 +1,158 @@
+package trace
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateDiff is the Parity representation of the state changes of a
+// transaction, keyed by account.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff holds the changes of a single account. Each field is either
+// "=" when unchanged, {"+": value} when created, {"-": value} when deleted
+// or {"*": {"from": value, "to": value}} when modified.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// prestateAccount is an account as returned by the prestateTracer.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// prestateDiff is the output of the prestateTracer in diff mode.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+const diffSame = "="
+
+func diffBorn(v interface{}) interface{} {
+	return map[string]interface{}{"+": v}
+}
+
+func diffDied(v interface{}) interface{} {
+	return map[string]interface{}{"-": v}
+}
+
+func diffChanged(from, to interface{}) interface{} {
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
+
+// newStateDiff converts the output of the prestateTracer in diff mode into
+// the Parity state diff format.
+//
+// In diff mode, the pre state holds the full account of every modified
+// account, but only the modified storage slots, while the post state only
+// holds the modified fields. Accounts that were deleted are missing from the
+// post state and accounts that were created are missing from the pre state.
+func newStateDiff(d *prestateDiff) StateDiff {
+	sd := make(StateDiff, len(d.Pre))
+	for addr, pre := range d.Pre {
+		post, ok := d.Post[addr]
+		switch {
+		case !ok:
+			sd[addr] = accountDiffOf(pre, diffDied)
+		case pre.empty():
+			sd[addr] = accountDiffOf(pre.merge(post), diffBorn)
+		default:
+			sd[addr] = modifiedAccountDiff(pre, post)
+		}
+	}
+	for addr, post := range d.Post {
+		if _, ok := d.Pre[addr]; !ok {
+			sd[addr] = accountDiffOf(post, diffBorn)
+		}
+	}
+	return sd
+}
+
+func accountDiffOf(acc *prestateAccount, diff func(interface{}) interface{}) *AccountDiff {
+	ad := &AccountDiff{
+		Balance: diff(acc.balance()),
+		Code:    diff(acc.code()),
+		Nonce:   diff(hexutil.Uint64(acc.Nonce)),
+		Storage: make(map[common.Hash]interface{}, len(acc.Storage)),
+	}
+	for key, val := range acc.Storage {
+		ad.Storage[key] = diff(val)
+	}
+	return ad
+}
+
+func modifiedAccountDiff(pre, post *prestateAccount) *AccountDiff {
+	ad := &AccountDiff{
+		Balance: diffSame,
+		Code:    diffSame,
+		Nonce:   diffSame,
+		Storage: make(map[common.Hash]interface{}),
+	}
+	if post.Balance != nil && pre.balance().ToInt().Cmp(post.Balance.ToInt()) != 0 {
+		ad.Balance = diffChanged(pre.balance(), post.Balance)
+	}
+	if len(post.Code) > 0 {
+		ad.Code = diffChanged(pre.code(), post.Code)
+	}
+	if post.Nonce != 0 && post.Nonce != pre.Nonce {
+		ad.Nonce = diffChanged(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+	}
+	// slots reset to zero are missing from the post state
+	for key, from := range pre.Storage {
+		if to := post.Storage[key]; from != to {
+			ad.Storage[key] = diffChanged(from, to)
+		}
+	}
+	// slots that were zero are missing from the pre state
+	for key, to := range post.Storage {
+		if _, ok := pre.Storage[key]; !ok {
+			ad.Storage[key] = diffChanged(common.Hash{}, to)
+		}
+	}
+	return ad
+}
+
+func (a *prestateAccount) balance() *hexutil.Big {
+	if a.Balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return a.Balance
+}
+
+func (a *prestateAccount) code() hexutil.Bytes {
+	if a.Code == nil {
+		return hexutil.Bytes{}
+	}
+	return a.Code
+}
+
+// empty returns true if the account didn't exist before the transaction.
+func (a *prestateAccount) empty() bool {
+	return a.balance().ToInt().Sign() == 0 && a.Nonce == 0 && len(a.Code) == 0 && len(a.Storage) == 0
+}
+
+// merge returns the account resulting from applying the modified fields of
+// the post state on top of the account.
+func (a *prestateAccount) merge(post *prestateAccount) *prestateAccount {
+	merged := *a
+	if post.Balance != nil {
+		merged.Balance = post.Balance
+	}
+	if len(post.Code) > 0 {
+		merged.Code = post.Code
+	}
+	if post.Nonce != 0 {
+		merged.Nonce = post.Nonce
+	}
+	merged.Storage = post.Storage
+	return &merged
+}
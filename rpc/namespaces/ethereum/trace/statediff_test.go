@@ -0,0 +1,96 @@
+package trace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateDiff(t *testing.T) {
+	testCases := []struct {
+		msg    string
+		diff   string
+		expect string
+	}{
+		{
+			"modified account",
+			`{
+				"pre": {"0x0000000000000000000000000000000000000001": {
+					"balance": "0x10", "nonce": 1, "code": "0x6000",
+					"storage": {
+						"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000002",
+						"0x0000000000000000000000000000000000000000000000000000000000000003": "0x0000000000000000000000000000000000000000000000000000000000000004"
+					}
+				}},
+				"post": {"0x0000000000000000000000000000000000000001": {
+					"balance": "0x8", "nonce": 2,
+					"storage": {
+						"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000005",
+						"0x0000000000000000000000000000000000000000000000000000000000000006": "0x0000000000000000000000000000000000000000000000000000000000000007"
+					}
+				}}
+			}`,
+			`{"0x0000000000000000000000000000000000000001": {
+				"balance": {"*": {"from": "0x10", "to": "0x8"}},
+				"code": "=",
+				"nonce": {"*": {"from": "0x1", "to": "0x2"}},
+				"storage": {
+					"0x0000000000000000000000000000000000000000000000000000000000000001": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000000000000000000000000000005"}},
+					"0x0000000000000000000000000000000000000000000000000000000000000003": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000004", "to": "0x0000000000000000000000000000000000000000000000000000000000000000"}},
+					"0x0000000000000000000000000000000000000000000000000000000000000006": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000000", "to": "0x0000000000000000000000000000000000000000000000000000000000000007"}}
+				}
+			}}`,
+		},
+		{
+			"created account",
+			`{
+				"pre": {},
+				"post": {"0x0000000000000000000000000000000000000002": {"balance": "0x1", "nonce": 1, "code": "0x6001"}}
+			}`,
+			`{"0x0000000000000000000000000000000000000002": {
+				"balance": {"+": "0x1"},
+				"code": {"+": "0x6001"},
+				"nonce": {"+": "0x1"},
+				"storage": {}
+			}}`,
+		},
+		{
+			"empty account funded",
+			`{
+				"pre": {"0x0000000000000000000000000000000000000003": {"balance": "0x0"}},
+				"post": {"0x0000000000000000000000000000000000000003": {"balance": "0x5"}}
+			}`,
+			`{"0x0000000000000000000000000000000000000003": {
+				"balance": {"+": "0x5"},
+				"code": {"+": "0x"},
+				"nonce": {"+": "0x0"},
+				"storage": {}
+			}}`,
+		},
+		{
+			"deleted account",
+			`{
+				"pre": {"0x0000000000000000000000000000000000000004": {"balance": "0x3", "code": "0x00"}},
+				"post": {}
+			}`,
+			`{"0x0000000000000000000000000000000000000004": {
+				"balance": {"-": "0x3"},
+				"code": {"-": "0x00"},
+				"nonce": {"-": "0x0"},
+				"storage": {}
+			}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			var diff prestateDiff
+			require.NoError(t, json.Unmarshal([]byte(tc.diff), &diff))
+
+			bz, err := json.Marshal(newStateDiff(&diff))
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expect, string(bz))
+		})
+	}
+}
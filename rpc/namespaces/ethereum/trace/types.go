@@ -0,0 +1,132 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// Trace types that can be requested on the replay and call methods.
+const (
+	TypeTrace     = "trace"
+	TypeStateDiff = "stateDiff"
+	TypeVMTrace   = "vmTrace"
+)
+
+// FlatTrace is a single call frame in the Parity flat trace format, as
+// produced by the flatCallTracer.
+type FlatTrace struct {
+	Action              Action       `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              *Result      `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// Action is the action of a call frame.
+type Action struct {
+	Author         *common.Address `json:"author,omitempty"`
+	RewardType     string          `json:"rewardType,omitempty"`
+	SelfDestructed *common.Address `json:"address,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+}
+
+// Result is the result of a call frame. It is nil for failed frames.
+type Result struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// TraceResults is the output of a replayed transaction or call. Only the
+// requested trace types are populated.
+type TraceResults struct {
+	Output          hexutil.Bytes `json:"output"`
+	StateDiff       StateDiff     `json:"stateDiff"`
+	Trace           []*FlatTrace  `json:"trace"`
+	VMTrace         *VMTrace      `json:"vmTrace"`
+	TransactionHash *common.Hash  `json:"transactionHash,omitempty"`
+}
+
+// FilterArgs are the arguments of trace_filter.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// matches returns true if the trace sender is in the from addresses and the
+// trace recipient in the to addresses. An empty address list matches all.
+func (args *FilterArgs) matches(t *FlatTrace) bool {
+	from, to := t.Action.From, t.Action.To
+	switch t.Type {
+	case "create":
+		to = nil
+		if t.Result != nil {
+			to = t.Result.Address
+		}
+	case "suicide":
+		from, to = t.Action.SelfDestructed, t.Action.RefundAddress
+	}
+	return matchAddress(args.FromAddress, from) && matchAddress(args.ToAddress, to)
+}
+
+func matchAddress(addresses []common.Address, addr *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range addresses {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// CallRequest is an element of the trace_callMany parameters, which is encoded
+// as a [call, traceTypes] tuple.
+type CallRequest struct {
+	Args       evmtypes.TransactionArgs
+	TraceTypes []string
+}
+
+// UnmarshalJSON decodes the [call, traceTypes] tuple.
+func (r *CallRequest) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("expected [call, traceTypes] tuple, got %d elements", len(raw))
+	}
+	if err := json.Unmarshal(raw[0], &r.Args); err != nil {
+		return err
+	}
+	return json.Unmarshal(raw[1], &r.TraceTypes)
+}
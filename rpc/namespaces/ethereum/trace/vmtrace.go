@@ -0,0 +1,220 @@
+package trace
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+)
+
+// VMTrace is the Parity virtual machine trace of a call frame.
+type VMTrace struct {
+	Code hexutil.Bytes  `json:"code"`
+	Ops  []*VMOperation `json:"ops"`
+}
+
+// VMOperation is a single executed instruction. Sub holds the trace of the
+// call frame created by the instruction, if any.
+type VMOperation struct {
+	Cost uint64               `json:"cost"`
+	Ex   *VMExecutedOperation `json:"ex"`
+	Pc   uint64               `json:"pc"`
+	Sub  *VMTrace             `json:"sub"`
+}
+
+// VMExecutedOperation holds the effects of an instruction. It is nil if the
+// instruction failed.
+type VMExecutedOperation struct {
+	Mem   *MemoryDiff  `json:"mem"`
+	Push  []string     `json:"push"`
+	Store *StorageDiff `json:"store"`
+	Used  uint64       `json:"used"`
+}
+
+// MemoryDiff is a memory region written by an instruction.
+type MemoryDiff struct {
+	Off  uint64        `json:"off"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// StorageDiff is a storage slot written by an instruction.
+type StorageDiff struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// structLogResult is the output of the default struct logger.
+type structLogResult struct {
+	Failed      bool          `json:"failed"`
+	ReturnValue hexutil.Bytes `json:"returnValue"`
+	StructLogs  []structLog   `json:"structLogs"`
+}
+
+type structLog struct {
+	Pc      uint64   `json:"pc"`
+	Op      string   `json:"op"`
+	Gas     uint64   `json:"gas"`
+	GasCost uint64   `json:"gasCost"`
+	Depth   int      `json:"depth"`
+	Error   string   `json:"error"`
+	Stack   []string `json:"stack"`
+	Memory  []string `json:"memory"`
+}
+
+// codeGetter returns the code of an account. It is used to fill the code of
+// the call frames entered during the execution.
+type codeGetter func(common.Address) []byte
+
+// newVMTrace builds the Parity vm trace out of the struct logs of a
+// transaction. The struct logs must have been recorded with the memory and
+// the stack enabled.
+//
+// NOTE: the code of a called contract is fetched from the state at the
+// beginning of the block, so it is empty for a contract created earlier in
+// the same block.
+func newVMTrace(code []byte, logs []structLog, codeAt codeGetter) *VMTrace {
+	if len(logs) == 0 {
+		return &VMTrace{Code: code, Ops: []*VMOperation{}}
+	}
+	trace, _ := buildVMTrace(code, logs, 0, codeAt)
+	return trace
+}
+
+// buildVMTrace consumes the logs of the call frame starting at index i and
+// returns its trace along with the index of the first log after the frame.
+func buildVMTrace(code []byte, logs []structLog, i int, codeAt codeGetter) (*VMTrace, int) {
+	trace := &VMTrace{Code: code, Ops: []*VMOperation{}}
+	depth := logs[i].Depth
+	for i < len(logs) && logs[i].Depth == depth {
+		log := logs[i]
+		op := vm.StringToOp(log.Op)
+		vmOp := &VMOperation{Pc: log.Pc, Cost: log.GasCost}
+		i++
+
+		if i < len(logs) && logs[i].Depth > depth {
+			vmOp.Sub, i = buildVMTrace(subCode(op, &log, codeAt), logs, i, codeAt)
+		}
+
+		switch {
+		case i < len(logs) && logs[i].Depth == depth:
+			// the effects of the instruction are visible on the next one
+			next := logs[i]
+			vmOp.Ex = &VMExecutedOperation{
+				Mem:   memoryDiff(op, log.Stack, next.Memory),
+				Push:  pushed(op, next.Stack),
+				Store: storageDiff(op, log.Stack),
+				Used:  next.Gas,
+			}
+		case log.Error == "":
+			// last instruction of the frame
+			used := uint64(0)
+			if log.Gas > log.GasCost {
+				used = log.Gas - log.GasCost
+			}
+			vmOp.Ex = &VMExecutedOperation{Push: []string{}, Used: used}
+		}
+		trace.Ops = append(trace.Ops, vmOp)
+	}
+	return trace, i
+}
+
+// subCode returns the code executed by the call frame created by the
+// instruction.
+func subCode(op vm.OpCode, log *structLog, codeAt codeGetter) []byte {
+	switch op {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		addr := stackBack(log.Stack, 1)
+		return codeAt(common.Address(addr.Bytes20()))
+	case vm.CREATE, vm.CREATE2:
+		return memorySlice(log.Memory, stackBack(log.Stack, 1).Uint64(), stackBack(log.Stack, 2).Uint64())
+	default:
+		return nil
+	}
+}
+
+// pushed returns the stack items pushed by the instruction. As in Parity,
+// the DUP and SWAP instructions report all the stack items they touched.
+func pushed(op vm.OpCode, stack []string) []string {
+	n := 1
+	switch {
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		n = int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		n = int(op-vm.SWAP1) + 2
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		n = 0
+	}
+	switch op {
+	case vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE, vm.JUMP, vm.JUMPI, vm.JUMPDEST,
+		vm.STOP, vm.RETURN, vm.REVERT, vm.INVALID, vm.SELFDESTRUCT, vm.CALLDATACOPY,
+		vm.CODECOPY, vm.RETURNDATACOPY, vm.EXTCODECOPY, vm.MCOPY:
+		n = 0
+	}
+	if n > len(stack) {
+		n = len(stack)
+	}
+	return append([]string{}, stack[len(stack)-n:]...)
+}
+
+// memoryDiff returns the memory region written by the instruction.
+func memoryDiff(op vm.OpCode, stack []string, memory []string) *MemoryDiff {
+	var off, size uint64
+	switch op {
+	case vm.MSTORE:
+		off, size = stackBack(stack, 0).Uint64(), 32
+	case vm.MSTORE8:
+		off, size = stackBack(stack, 0).Uint64(), 1
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		off, size = stackBack(stack, 0).Uint64(), stackBack(stack, 2).Uint64()
+	case vm.EXTCODECOPY:
+		off, size = stackBack(stack, 1).Uint64(), stackBack(stack, 3).Uint64()
+	case vm.CALL, vm.CALLCODE:
+		off, size = stackBack(stack, 5).Uint64(), stackBack(stack, 6).Uint64()
+	case vm.DELEGATECALL, vm.STATICCALL:
+		off, size = stackBack(stack, 4).Uint64(), stackBack(stack, 5).Uint64()
+	}
+	if size == 0 {
+		return nil
+	}
+	return &MemoryDiff{Off: off, Data: memorySlice(memory, off, size)}
+}
+
+// storageDiff returns the storage slot written by the instruction.
+func storageDiff(op vm.OpCode, stack []string) *StorageDiff {
+	if op != vm.SSTORE {
+		return nil
+	}
+	return &StorageDiff{
+		Key: stackBack(stack, 0).Hex(),
+		Val: stackBack(stack, 1).Hex(),
+	}
+}
+
+// stackBack returns the n-th item from the top of the stack.
+func stackBack(stack []string, n int) *uint256.Int {
+	if n >= len(stack) {
+		return new(uint256.Int)
+	}
+	v, err := uint256.FromHex(stack[len(stack)-1-n])
+	if err != nil {
+		return new(uint256.Int)
+	}
+	return v
+}
+
+// memorySlice returns a copy of the memory region, truncated to the memory
+// size.
+func memorySlice(memory []string, off, size uint64) []byte {
+	mem, err := hex.DecodeString(strings.Join(memory, ""))
+	if err != nil || off >= uint64(len(mem)) {
+		return []byte{}
+	}
+	end := uint64(len(mem))
+	if size < end-off {
+		end = off + size
+	}
+	return common.CopyBytes(mem[off:end])
+}
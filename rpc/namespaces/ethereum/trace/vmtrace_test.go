@@ -0,0 +1,86 @@
+package trace
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVMTrace(t *testing.T) {
+	callee := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	word := "000000000000000000000000000000000000000000000000000000000000002a"
+
+	logs := []structLog{
+		{Pc: 0, Op: "PUSH1", Gas: 100, GasCost: 3, Depth: 1, Stack: []string{}},
+		{Pc: 2, Op: "PUSH1", Gas: 97, GasCost: 3, Depth: 1, Stack: []string{"0x2a"}},
+		{Pc: 4, Op: "MSTORE", Gas: 94, GasCost: 6, Depth: 1, Stack: []string{"0x2a", "0x0"}},
+		{
+			Pc: 5, Op: "CALL", Gas: 88, GasCost: 20, Depth: 1,
+			Stack:  []string{"0x20", "0x0", "0x0", "0x0", "0x0", "0xaa", "0x10"},
+			Memory: []string{word},
+		},
+		{Pc: 0, Op: "PUSH1", Gas: 16, GasCost: 3, Depth: 2, Stack: []string{}},
+		{Pc: 2, Op: "STOP", Gas: 13, GasCost: 0, Depth: 2, Stack: []string{"0x1"}},
+		{Pc: 6, Op: "PUSH1", Gas: 60, GasCost: 3, Depth: 1, Stack: []string{"0x1"}, Memory: []string{word}},
+		{Pc: 8, Op: "SSTORE", Gas: 57, GasCost: 20, Depth: 1, Stack: []string{"0x1", "0x2"}, Memory: []string{word}},
+		{Pc: 9, Op: "INVALID", Gas: 37, GasCost: 37, Depth: 1, Stack: []string{}, Error: "invalid opcode: INVALID"},
+	}
+	codeAt := func(addr common.Address) []byte {
+		require.Equal(t, callee, addr)
+		return []byte{0x60, 0x01, 0x00}
+	}
+
+	trace := newVMTrace([]byte{0x60}, logs, codeAt)
+	require.Equal(t, []byte{0x60}, []byte(trace.Code))
+	require.Len(t, trace.Ops, 7)
+
+	require.Equal(t, []string{"0x2a"}, trace.Ops[0].Ex.Push)
+	require.Equal(t, uint64(97), trace.Ops[0].Ex.Used)
+
+	mstore := trace.Ops[2]
+	require.Empty(t, mstore.Ex.Push)
+	require.NotNil(t, mstore.Ex.Mem)
+	require.Equal(t, uint64(0), mstore.Ex.Mem.Off)
+	require.Equal(t, common.HexToHash(word).Bytes(), []byte(mstore.Ex.Mem.Data))
+
+	call := trace.Ops[3]
+	require.Equal(t, uint64(20), call.Cost)
+	require.Equal(t, []string{"0x1"}, call.Ex.Push)
+	require.Equal(t, uint64(60), call.Ex.Used)
+	require.NotNil(t, call.Sub)
+	require.Equal(t, []byte{0x60, 0x01, 0x00}, []byte(call.Sub.Code))
+	require.Len(t, call.Sub.Ops, 2)
+	require.Equal(t, []string{"0x1"}, call.Sub.Ops[0].Ex.Push)
+	require.Equal(t, uint64(13), call.Sub.Ops[1].Ex.Used)
+
+	sstore := trace.Ops[5]
+	require.Equal(t, &StorageDiff{Key: "0x2", Val: "0x1"}, sstore.Ex.Store)
+	require.Nil(t, sstore.Ex.Mem)
+
+	// failed instructions have no effects
+	require.Nil(t, trace.Ops[6].Ex)
+}
+
+func TestPushed(t *testing.T) {
+	stack := []string{"0x1", "0x2", "0x3"}
+	testCases := []struct {
+		op     string
+		expect []string
+	}{
+		{"ADD", []string{"0x3"}},
+		{"DUP1", []string{"0x2", "0x3"}},
+		{"SWAP2", []string{"0x1", "0x2", "0x3"}},
+		{"POP", []string{}},
+		{"LOG1", []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.op, func(t *testing.T) {
+			trace := newVMTrace(nil, []structLog{
+				{Op: tc.op, Depth: 1},
+				{Op: "STOP", Depth: 1, Stack: stack},
+			}, nil)
+			require.Equal(t, tc.expect, trace.Ops[0].Ex.Push)
+		})
+	}
+}
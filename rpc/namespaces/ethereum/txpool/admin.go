@@ -0,0 +1,47 @@
+package txpool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// AdminAPI offers a privileged API to introspect the whole mempool, EVM and
+// Cosmos transactions, and to evict transactions from it.
+type AdminAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAdminAPI creates a new tx pool admin service.
+func NewAdminAPI(logger log.Logger, backend backend.EVMBackend) *AdminAPI {
+	return &AdminAPI{
+		logger:  logger.With("module", "txpooladmin"),
+		backend: backend,
+	}
+}
+
+// Content returns the EVM transactions of the mempool with their effective tip
+// and why the queued ones are not executable, along with the Cosmos transactions
+func (api *AdminAPI) Content() (*types.MempoolContent, error) {
+	api.logger.Debug("txpooladmin_content")
+	return api.backend.MempoolContent()
+}
+
+// RemoveTransaction removes the EVM or Cosmos transaction of the given hash from
+// the mempool, and reports whether it was found
+func (api *AdminAPI) RemoveTransaction(hash common.Hash) (bool, error) {
+	api.logger.Info("txpooladmin_removeTransaction", "hash", hash)
+	return api.backend.RemovePoolTransaction(hash)
+}
+
+// RemoveSender removes all the transactions of the sender from the mempool, and
+// returns the number of removed transactions
+func (api *AdminAPI) RemoveSender(sender common.Address) (hexutil.Uint, error) {
+	api.logger.Info("txpooladmin_removeSender", "sender", sender)
+	return api.backend.RemovePoolSender(sender)
+}
@@ -0,0 +1,349 @@
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// sendRawTransaction is the method whose transactions are rate limited by sender
+	sendRawTransaction = "eth_sendRawTransaction"
+
+	// ForwardedForHeader is the header of the client IP forwarded by the websocket server
+	// and the local reverse proxies
+	ForwardedForHeader = "X-Forwarded-For"
+
+	// maxRequestContentLength is the maximum size of the inspected requests, as the
+	// go-ethereum HTTP server
+	maxRequestContentLength = 1024 * 1024 * 5
+
+	// sweepInterval is the interval at which the idle buckets are dropped
+	sweepInterval = time.Minute
+)
+
+var (
+	errIPLimitExceeded     = errors.New("rate limit exceeded for the client IP")
+	errSenderLimitExceeded = errors.New("rate limit exceeded for the transaction sender")
+
+	ipRejectedCounter     = metrics.NewRegisteredCounter("rpc/ratelimit/ip/rejected", nil)
+	senderRejectedCounter = metrics.NewRegisteredCounter("rpc/ratelimit/sender/rejected", nil)
+)
+
+// Limiter rate limits the JSON-RPC requests of the configured methods by client IP,
+// and the transactions submitted through `eth_sendRawTransaction` by sender.
+type Limiter struct {
+	methods       map[string]struct{}
+	prefixes      []string
+	ipBuckets     *buckets
+	senderBuckets *buckets
+	logger        log.Logger
+}
+
+// NewLimiter returns a limiter of the JSON-RPC requests with the given configuration.
+func NewLimiter(cfg config.RateLimitConfig, logger log.Logger) *Limiter {
+	l := &Limiter{
+		methods: make(map[string]struct{}),
+		logger:  logger.With("module", "ratelimit"),
+	}
+	for _, method := range cfg.Methods {
+		if prefix, ok := strings.CutSuffix(method, "*"); ok {
+			l.prefixes = append(l.prefixes, prefix)
+		} else {
+			l.methods[method] = struct{}{}
+		}
+	}
+	if cfg.IPRate > 0 {
+		l.ipBuckets = newBuckets(rate.Limit(cfg.IPRate), cfg.IPBurst)
+	}
+	if cfg.SenderRate > 0 {
+		l.senderBuckets = newBuckets(rate.Limit(cfg.SenderRate), cfg.SenderBurst)
+	}
+	return l
+}
+
+// Handler returns the handler rate limiting the requests before passing them to the
+// next handler. The requests are passed through if no limit is configured.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	if l.ipBuckets == nil && l.senderBuckets == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l.serveHTTP(w, r, next)
+	})
+}
+
+type jsonrpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Error   *jsonError      `json:"error,omitempty"`
+}
+
+type jsonError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (l *Limiter) serveHTTP(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	// the invalid requests are reported by the JSON-RPC server
+	msgs, batch, err := parseMessages(body)
+	if err != nil {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	ip := ClientIP(r)
+	var allowed, rejected []*jsonrpcMessage
+	for _, msg := range msgs {
+		if err := l.allow(ip, msg); err != nil {
+			rejected = append(rejected, &jsonrpcMessage{
+				Version: "2.0",
+				ID:      msg.ID,
+				Error:   &jsonError{Code: rpctypes.ErrCodeLimitExceeded, Message: err.Error()},
+			})
+			continue
+		}
+		allowed = append(allowed, msg)
+	}
+
+	switch {
+	case len(rejected) == 0:
+		next.ServeHTTP(w, r)
+	case !batch:
+		writeJSON(w, rejected[0])
+	case len(allowed) == 0:
+		writeJSON(w, rejected)
+	default:
+		l.serveBatch(w, r, next, allowed, rejected)
+	}
+}
+
+// serveBatch passes the allowed requests of a batch to the next handler, and responds
+// with their responses along with the errors of the rejected requests.
+func (l *Limiter) serveBatch(w http.ResponseWriter, r *http.Request, next http.Handler, allowed, rejected []*jsonrpcMessage) {
+	body, err := json.Marshal(allowed)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req := r.Clone(r.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+
+	res := newResponseBuffer()
+	next.ServeHTTP(res, req)
+
+	var responses []json.RawMessage
+	if err := json.Unmarshal(res.body.Bytes(), &responses); err != nil {
+		// not a batch response, e.g. the batch is too large
+		res.writeTo(w)
+		return
+	}
+	for _, msg := range rejected {
+		bz, err := json.Marshal(msg)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		responses = append(responses, bz)
+	}
+	writeJSON(w, responses)
+}
+
+// allow returns an error if the request exceeds the limit of the client IP or of the
+// transaction sender.
+func (l *Limiter) allow(ip string, msg *jsonrpcMessage) error {
+	if !l.isLimited(msg.Method) {
+		return nil
+	}
+
+	if l.ipBuckets != nil && !l.ipBuckets.allow(ip) {
+		ipRejectedCounter.Inc(1)
+		metrics.GetOrRegisterCounter("rpc/ratelimit/ip/rejected/"+msg.Method, nil).Inc(1)
+		l.logger.Debug("request rejected by the client IP rate limit", "ip", ip, "method", msg.Method)
+		return errIPLimitExceeded
+	}
+
+	if l.senderBuckets != nil && msg.Method == sendRawTransaction {
+		// the invalid transactions are reported by the JSON-RPC server
+		sender, err := recoverSender(msg.Params)
+		if err != nil {
+			return nil
+		}
+		if !l.senderBuckets.allow(sender.Hex()) {
+			senderRejectedCounter.Inc(1)
+			l.logger.Debug("transaction rejected by the sender rate limit", "sender", sender.Hex())
+			return errSenderLimitExceeded
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) isLimited(method string) bool {
+	if _, ok := l.methods[method]; ok {
+		return true
+	}
+	for _, prefix := range l.prefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the IP of the client of the request. The IP forwarded by the
+// websocket server or a reverse proxy is only trusted from the loopback interface.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	forwarded, _, _ := strings.Cut(r.Header.Get(ForwardedForHeader), ",")
+	if ip := net.ParseIP(strings.TrimSpace(forwarded)); ip != nil {
+		return ip.String()
+	}
+	return host
+}
+
+// parseMessages returns the JSON-RPC requests of the body, and if they are a batch.
+func parseMessages(body []byte) ([]*jsonrpcMessage, bool, error) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var msgs []*jsonrpcMessage
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return nil, true, err
+		}
+		return msgs, true, nil
+	}
+
+	var msg jsonrpcMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, false, err
+	}
+	return []*jsonrpcMessage{&msg}, false, nil
+}
+
+// recoverSender returns the sender of the raw transaction of the params of a
+// `eth_sendRawTransaction` request.
+func recoverSender(params json.RawMessage) (common.Address, error) {
+	var args []hexutil.Bytes
+	if err := json.Unmarshal(params, &args); err != nil {
+		return common.Address{}, err
+	}
+	if len(args) == 0 {
+		return common.Address{}, errors.New("missing raw transaction")
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(args[0]); err != nil {
+		return common.Address{}, err
+	}
+	var signer ethtypes.Signer = ethtypes.HomesteadSigner{}
+	if tx.Protected() {
+		signer = ethtypes.LatestSignerForChainID(tx.ChainId())
+	}
+	return ethtypes.Sender(signer, tx)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v) // #nosec G703
+}
+
+// buckets are the token buckets of the keys sharing the same limit.
+type buckets struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	limiters  map[string]*rate.Limiter
+	lastSweep time.Time
+}
+
+func newBuckets(limit rate.Limit, burst int) *buckets {
+	return &buckets{
+		limit:     limit,
+		burst:     burst,
+		limiters:  make(map[string]*rate.Limiter),
+		lastSweep: time.Now(),
+	}
+}
+
+// allow takes a token from the bucket of the key, false if the bucket is empty.
+func (b *buckets) allow(key string) bool {
+	now := time.Now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// the full buckets are dropped as they behave as new ones
+	if now.Sub(b.lastSweep) >= sweepInterval {
+		for k, limiter := range b.limiters {
+			if limiter.TokensAt(now) >= float64(b.burst) {
+				delete(b.limiters, k)
+			}
+		}
+		b.lastSweep = now
+	}
+
+	limiter, ok := b.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(b.limit, b.burst)
+		b.limiters[key] = limiter
+	}
+	return limiter.AllowN(now, 1)
+}
+
+// responseBuffer is a response writer keeping the response in memory.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: make(http.Header), status: http.StatusOK}
+}
+
+func (r *responseBuffer) Header() http.Header { return r.header }
+
+func (r *responseBuffer) Write(bz []byte) (int, error) { return r.body.Write(bz) }
+
+func (r *responseBuffer) WriteHeader(status int) { r.status = status }
+
+func (r *responseBuffer) writeTo(w http.ResponseWriter) {
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes()) // #nosec G703
+}
@@ -0,0 +1,226 @@
+package ratelimit
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log"
+)
+
+// echoHandler answers each request with its method as result
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	msgs, batch, err := parseMessages(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var res []map[string]any
+	for _, msg := range msgs {
+		res = append(res, map[string]any{"jsonrpc": "2.0", "id": msg.ID, "result": msg.Method})
+	}
+	if batch {
+		writeJSON(w, res)
+		return
+	}
+	writeJSON(w, res[0])
+})
+
+func newTestLimiter(malleate func(cfg *config.RateLimitConfig)) http.Handler {
+	cfg := config.DefaultRateLimitConfig()
+	malleate(&cfg)
+	return NewLimiter(cfg, log.NewNopLogger()).Handler(echoHandler)
+}
+
+type response struct {
+	ID     int        `json:"id"`
+	Result string     `json:"result"`
+	Error  *jsonError `json:"error"`
+}
+
+func request(method string, id int, params ...any) string {
+	if params == nil {
+		params = []any{}
+	}
+	bz, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params})
+	return string(bz)
+}
+
+func post(t *testing.T, h http.Handler, remoteAddr, forwarded, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	if forwarded != "" {
+		req.Header.Set(ForwardedForHeader, forwarded)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), v))
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	cfg := config.DefaultRateLimitConfig()
+	h := NewLimiter(cfg, log.NewNopLogger()).Handler(echoHandler)
+
+	for i := 0; i < 10; i++ {
+		var res response
+		decode(t, post(t, h, "1.2.3.4:1000", "", request("eth_call", i)), &res)
+		require.Nil(t, res.Error)
+	}
+}
+
+func TestLimiterIP(t *testing.T) {
+	h := newTestLimiter(func(cfg *config.RateLimitConfig) {
+		cfg.IPRate = 0.001
+		cfg.IPBurst = 2
+	})
+
+	for i := 0; i < 2; i++ {
+		var res response
+		decode(t, post(t, h, "1.2.3.4:1000", "", request("eth_call", i)), &res)
+		require.Nil(t, res.Error)
+		require.Equal(t, "eth_call", res.Result)
+	}
+
+	// the limited method is rejected once the burst is spent
+	var res response
+	decode(t, post(t, h, "1.2.3.4:1000", "", request("eth_call", 2)), &res)
+	require.NotNil(t, res.Error)
+	require.Equal(t, rpctypes.ErrCodeLimitExceeded, res.Error.Code)
+	require.Equal(t, 2, res.ID)
+
+	// the prefix matches are limited
+	decode(t, post(t, h, "1.2.3.4:1000", "", request("debug_traceTransaction", 3)), &res)
+	require.NotNil(t, res.Error)
+
+	// the other methods are not limited
+	res = response{}
+	decode(t, post(t, h, "1.2.3.4:1000", "", request("eth_blockNumber", 4)), &res)
+	require.Nil(t, res.Error)
+
+	// the other clients are not limited
+	decode(t, post(t, h, "5.6.7.8:1000", "", request("eth_call", 5)), &res)
+	require.Nil(t, res.Error)
+}
+
+func TestLimiterBatch(t *testing.T) {
+	h := newTestLimiter(func(cfg *config.RateLimitConfig) {
+		cfg.IPRate = 0.001
+		cfg.IPBurst = 1
+	})
+
+	batch := fmt.Sprintf("[%s,%s,%s]",
+		request("eth_call", 1), request("eth_call", 2), request("eth_blockNumber", 3))
+	var res []response
+	decode(t, post(t, h, "1.2.3.4:1000", "", batch), &res)
+	require.Len(t, res, 3)
+
+	byID := make(map[int]response)
+	for _, r := range res {
+		byID[r.ID] = r
+	}
+	require.Nil(t, byID[1].Error)
+	require.Equal(t, "eth_call", byID[1].Result)
+	require.NotNil(t, byID[2].Error)
+	require.Equal(t, rpctypes.ErrCodeLimitExceeded, byID[2].Error.Code)
+	require.Nil(t, byID[3].Error)
+
+	// all the requests of the batch are rejected
+	batch = fmt.Sprintf("[%s,%s]", request("eth_call", 4), request("eth_estimateGas", 5))
+	decode(t, post(t, h, "1.2.3.4:1000", "", batch), &res)
+	require.Len(t, res, 2)
+	for _, r := range res {
+		require.NotNil(t, r.Error)
+	}
+}
+
+func TestLimiterSender(t *testing.T) {
+	h := newTestLimiter(func(cfg *config.RateLimitConfig) {
+		cfg.SenderRate = 0.001
+		cfg.SenderBurst = 1
+	})
+
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9001))
+	signedTx := func(key *ecdsa.PrivateKey, nonce uint64) hexutil.Bytes {
+		tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+			ChainID:   big.NewInt(9001),
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(1),
+			Gas:       21000,
+			To:        &common.Address{},
+		})
+		require.NoError(t, err)
+		bz, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		return bz
+	}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var res response
+	decode(t, post(t, h, "1.2.3.4:1000", "", request(sendRawTransaction, 1, signedTx(key, 0))), &res)
+	require.Nil(t, res.Error)
+
+	// the second transaction of the sender is rejected, even from another client
+	decode(t, post(t, h, "5.6.7.8:1000", "", request(sendRawTransaction, 2, signedTx(key, 1))), &res)
+	require.NotNil(t, res.Error)
+	require.Equal(t, rpctypes.ErrCodeLimitExceeded, res.Error.Code)
+
+	// the other senders are not limited
+	res = response{}
+	decode(t, post(t, h, "1.2.3.4:1000", "", request(sendRawTransaction, 3, signedTx(otherKey, 0))), &res)
+	require.Nil(t, res.Error)
+
+	// the invalid transactions are passed through
+	decode(t, post(t, h, "1.2.3.4:1000", "", request(sendRawTransaction, 4, "0x1234")), &res)
+	require.Nil(t, res.Error)
+}
+
+func TestClientIP(t *testing.T) {
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		expIP      string
+	}{
+		{"remote address", "1.2.3.4:1000", "", "1.2.3.4"},
+		{"forwarded from a remote address", "1.2.3.4:1000", "5.6.7.8", "1.2.3.4"},
+		{"forwarded from loopback", "127.0.0.1:1000", "5.6.7.8, 10.0.0.1", "5.6.7.8"},
+		{"invalid forwarded from loopback", "127.0.0.1:1000", "invalid", "127.0.0.1"},
+		{"no forwarded from loopback", "[::1]:1000", "", "::1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.forwarded != "" {
+				req.Header.Set(ForwardedForHeader, tc.forwarded)
+			}
+			require.Equal(t, tc.expIP, ClientIP(req))
+		})
+	}
+}
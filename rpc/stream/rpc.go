@@ -6,7 +6,9 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -30,6 +32,8 @@ const (
 	headerStreamCapacity    = 128 * 32
 	txStreamSegmentSize     = 1024
 	txStreamCapacity        = 1024 * 32
+	ethTxStreamSegmentSize  = 256
+	ethTxStreamCapacity     = 256 * 32
 	logStreamSegmentSize    = 2048
 	logStreamCapacity       = 2048 * 32
 )
@@ -45,6 +49,12 @@ var (
 	NewBlockHeaderEvents = cmtquery.MustCompile(fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, cmttypes.EventNewBlockHeader))
 )
 
+// EVMTxPool is the source of the transactions entering the pending state of
+// the EVM mempool.
+type EVMTxPool interface {
+	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+}
+
 type RPCHeader struct {
 	EthHeader *ethtypes.Header
 	Hash      common.Hash
@@ -63,6 +73,10 @@ type RPCStream struct {
 	// pendingTxStream is backed by check-tx ante handler
 	pendingTxStream *Stream[common.Hash]
 
+	// pendingEthTxStream is backed by the EVM mempool, nil if it's not enabled
+	pendingEthTxStream *Stream[*types.RPCTransaction]
+	pendingEthTxSub    event.Subscription
+
 	wg sync.WaitGroup
 }
 
@@ -103,6 +117,10 @@ func (s *RPCStream) initSubscriptions() {
 }
 
 func (s *RPCStream) Close() error {
+	if s.pendingEthTxSub != nil {
+		s.pendingEthTxSub.Unsubscribe()
+	}
+
 	if s.headerStream == nil {
 		// not initialized
 		return nil
@@ -124,6 +142,13 @@ func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
 	return s.pendingTxStream
 }
 
+// PendingEthTxStream returns the stream of the transactions entering the
+// pending state of the EVM mempool, it returns nil if the stream doesn't
+// listen to the EVM mempool.
+func (s *RPCStream) PendingEthTxStream() *Stream[*types.RPCTransaction] {
+	return s.pendingEthTxStream
+}
+
 func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	s.initSubscriptions()
 	return s.logStream
@@ -134,6 +159,39 @@ func (s *RPCStream) ListenPendingTx(hash common.Hash) {
 	s.PendingTxStream().Add(hash)
 }
 
+// ListenEVMMempool feeds the pending eth tx stream with the transactions
+// promoted to the pending state of the EVM mempool, formatted against the
+// header returned by currentHeader.
+func (s *RPCStream) ListenEVMMempool(pool EVMTxPool, currentHeader func() *ethtypes.Header) {
+	if s.pendingEthTxStream != nil {
+		// already listening
+		return
+	}
+
+	s.pendingEthTxStream = NewStream[*types.RPCTransaction](ethTxStreamSegmentSize, ethTxStreamCapacity)
+
+	ch := make(chan core.NewTxsEvent, subscribBufferSize)
+	s.pendingEthTxSub = pool.SubscribeTransactions(ch, false)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			select {
+			case ev := <-ch:
+				header := currentHeader()
+				txs := make([]*types.RPCTransaction, len(ev.Txs))
+				for i, tx := range ev.Txs {
+					txs[i] = types.NewRPCPendingTransaction(tx, header, evmtypes.GetEthChainConfig())
+				}
+				s.pendingEthTxStream.Add(txs...)
+			case <-s.pendingEthTxSub.Err():
+				return
+			}
+		}
+	}()
+}
+
 func (s *RPCStream) start(
 	wg *sync.WaitGroup,
 	chBlocks <-chan coretypes.ResultEvent,
@@ -3,3 +3,39 @@ package types
 import "errors"
 
 var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+// JSON-RPC error codes returned by eth_simulateV1.
+// See: https://github.com/ethereum/execution-apis/blob/main/src/eth/simulate.yaml
+const (
+	ErrCodeNonceTooHigh          = -38011
+	ErrCodeNonceTooLow           = -38010
+	ErrCodeIntrinsicGas          = -38013
+	ErrCodeInsufficientFunds     = -38014
+	ErrCodeBlockGasLimitReached  = -38015
+	ErrCodeBlockNumberInvalid    = -38020
+	ErrCodeBlockTimestampInvalid = -38021
+	ErrCodeClientLimitExceeded   = -38026
+	ErrCodeInternalError         = -32603
+	ErrCodeInvalidParams         = -32602
+	ErrCodeReverted              = -32000
+	ErrCodeVMError               = -32015
+)
+
+// JSON-RPC error codes defined by EIP-1474.
+// See: https://eips.ethereum.org/EIPS/eip-1474#error-codes
+const (
+	ErrCodeLimitExceeded = -32005
+)
+
+// SimError is an API error with a JSON-RPC error code that aborts an
+// eth_simulateV1 request.
+type SimError struct {
+	Code    int
+	Message string
+}
+
+// Error implements the error interface.
+func (e *SimError) Error() string { return e.Message }
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *SimError) ErrorCode() int { return e.Code }
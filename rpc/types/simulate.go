@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// previous simulated block.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride             `json:"stateOverrides,omitempty"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is a set of header fields to override for a simulated block.
+//
+// NOTE: prevRandao is not supported since the RANDOM opcode always returns a
+// constant value on this chain.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number,omitempty"`
+	Time          *hexutil.Uint64 `json:"time,omitempty"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit,omitempty"`
+	FeeRecipient  *common.Address `json:"feeRecipient,omitempty"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// MarshalJSON marshals the call result, encoding empty logs as an empty array
+// instead of null.
+func (r *SimCallResult) MarshalJSON() ([]byte, error) {
+	type callResultAlias SimCallResult
+	if r.Logs == nil {
+		r.Logs = []*ethtypes.Log{}
+	}
+	return json.Marshal((*callResultAlias)(r))
+}
+
+// SimCallError is the error of a simulated call that failed during execution.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimBlockResult is the result of a simulated block as returned by the
+// SimulateV1 gRPC query. The JSON-RPC server formats it into the ethereum
+// block representation.
+type SimBlockResult struct {
+	Header       *ethtypes.Header        `json:"header"`
+	Transactions []*ethtypes.Transaction `json:"transactions"`
+	Senders      []common.Address        `json:"senders"`
+	Calls        []SimCallResult         `json:"calls"`
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -169,6 +170,55 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// PrivateTransactionArgs represents the arguments of eth_sendPrivateTransaction.
+type PrivateTransactionArgs struct {
+	Tx             hexutil.Bytes   `json:"tx"`
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber,omitempty"`
+}
+
+// BundleArgs represents the arguments of eth_sendBundle.
+type BundleArgs struct {
+	Txs          []hexutil.Bytes `json:"txs"`
+	BlockNumber  hexutil.Uint64  `json:"blockNumber"`
+	MinTimestamp *uint64         `json:"minTimestamp,omitempty"`
+	MaxTimestamp *uint64         `json:"maxTimestamp,omitempty"`
+}
+
+// BundleResult represents the result of eth_sendBundle.
+type BundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// PoolTransaction represents an EVM transaction of the mempool along with its
+// effective tip and, for the queued ones, why it's not executable.
+type PoolTransaction struct {
+	*RPCTransaction
+	EffectiveTip *hexutil.Big `json:"effectiveTip"`
+	QueuedReason string       `json:"queuedReason,omitempty"`
+	Private      bool         `json:"private,omitempty"`
+}
+
+// CosmosPoolTransaction represents a Cosmos transaction of the mempool.
+type CosmosPoolTransaction struct {
+	Hash         string         `json:"hash"`
+	Signer       string         `json:"signer"`
+	Sequence     hexutil.Uint64 `json:"sequence"`
+	Messages     []string       `json:"messages"`
+	Gas          hexutil.Uint64 `json:"gas"`
+	GasPrice     *hexutil.Big   `json:"gasPrice"`
+	EffectiveTip *hexutil.Big   `json:"effectiveTip"`
+	Time         *time.Time     `json:"time,omitempty"`
+}
+
+// MempoolContent represents the content of the unified mempool, the EVM
+// transactions are grouped by status, sender and nonce.
+type MempoolContent struct {
+	BaseFee *hexutil.Big                           `json:"baseFee"`
+	Pending map[string]map[string]*PoolTransaction `json:"pending"`
+	Queued  map[string]map[string]*PoolTransaction `json:"queued"`
+	Cosmos  []*CosmosPoolTransaction               `json:"cosmos"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee         *big.Int   // base fee for each block
 	Reward                       []*big.Int // each element of the array will have the tip provided to miners for the percentile given
@@ -25,6 +25,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -55,6 +56,16 @@ func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEt
 	return ethTxs, nil
 }
 
+// FeeGranter returns the fee granter of the Cosmos transaction wrapping
+// sponsored Ethereum transactions, nil if the fees are paid by the sender.
+func FeeGranter(tx sdk.Tx) sdk.AccAddress {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) == 0 {
+		return nil
+	}
+	return feeTx.FeeGranter()
+}
+
 // EthHeaderFromComet is an util function that returns an Ethereum Header
 // from a CometBFT Header.
 //
@@ -378,6 +389,37 @@ func CalcBaseFee(config *ethparams.ChainConfig, parent *ethtypes.Header, p feema
 	).TruncateInt().BigInt(), nil
 }
 
+// CalcNextBaseFee calculates the base fee of the child block of the given parent
+// header with the base fee algorithm of the fee market params. The params, the
+// excess gas and the average window gas must be queried at the parent height,
+// they are only used by the exponential and moving window algorithms.
+func CalcNextBaseFee(config *ethparams.ChainConfig, parent *ethtypes.Header, p feemarkettypes.Params, excessGas, windowGas uint64) (*big.Int, error) {
+	if p.BaseFeeAlgorithm == feemarkettypes.BaseFeeAlgorithmEIP1559 || !config.IsLondon(parent.Number) {
+		return CalcBaseFee(config, parent, p)
+	}
+
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+	if p.BaseFeeAlgorithm == feemarkettypes.BaseFeeAlgorithmExponential {
+		baseFee := feemarkettypes.CalcExponentialBaseFee(excessGas, p.BaseFeeUpdateFraction, p.MinGasPrice)
+		return baseFee.MulInt(factor).TruncateInt().BigInt(), nil
+	}
+
+	if p.ElasticityMultiplier == 0 {
+		return nil, errors.New("ElasticityMultiplier cannot be 0 as it's checked in the params validation")
+	}
+	if p.BaseFee.IsNil() {
+		return new(big.Int), nil
+	}
+	// the params base fee is the base fee of the parent block, in the bank
+	// decimals used by the fee market
+	parentGasTarget := parent.GasLimit / uint64(p.ElasticityMultiplier)
+	baseFee := feemarkettypes.CalcGasBaseFee(
+		windowGas, parentGasTarget, uint64(p.BaseFeeChangeDenominator),
+		p.BaseFee, sdkmath.LegacyOneDec().QuoInt(factor), p.MinGasPrice,
+	)
+	return baseFee.MulInt(factor).TruncateInt().BigInt(), nil
+}
+
 // RPCMarshalHeader converts the given header to the RPC output .
 //
 // This method refers to internal package method of go-ethereum v1.16.3 - RPCMarshalHeader
@@ -472,11 +514,13 @@ func newRPCTransactionFromBlockIndex(b *ethtypes.Block, blockHash common.Hash, i
 	return NewRPCTransaction(txs[index], blockHash, b.NumberU64(), b.Time(), index, b.BaseFee(), config)
 }
 
-// RPCMarshalReceipt marshals a transaction receipt into a JSON object.
+// RPCMarshalReceipt marshals a transaction receipt into a JSON object. On top of the Ethereum
+// fields, feePayer is the account which paid the fees, the fee granter of the sponsored
+// transactions or the sender.
 //
 // This method refers to go-ethereum v1.16.3 internal package method marshalReceipt
 // (https://github.com/ethereum/go-ethereum/blob/d818a9af7bd5919808df78f31580f59382c53150/internal/ethapi/api.go#L1478-L1518)
-func RPCMarshalReceipt(receipt *ethtypes.Receipt, tx *ethtypes.Transaction, from common.Address) (map[string]interface{}, error) {
+func RPCMarshalReceipt(receipt *ethtypes.Receipt, tx *ethtypes.Transaction, from, feePayer common.Address) (map[string]interface{}, error) {
 	fields := map[string]interface{}{
 		"blockHash":         receipt.BlockHash,
 		"blockNumber":       hexutil.Uint64(receipt.BlockNumber.Uint64()),
@@ -491,6 +535,7 @@ func RPCMarshalReceipt(receipt *ethtypes.Receipt, tx *ethtypes.Transaction, from
 		"logsBloom":         receipt.Bloom,
 		"type":              hexutil.Uint(tx.Type()),
 		"effectiveGasPrice": (*hexutil.Big)(receipt.EffectiveGasPrice),
+		"feePayer":          feePayer,
 	}
 
 	// Assign receipt status or post state.
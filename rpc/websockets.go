@@ -14,8 +14,10 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -23,8 +25,12 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
+	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -36,6 +42,10 @@ const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
 )
 
+// syncingPollInterval is the interval at which the syncing subscription polls
+// the node status
+var syncingPollInterval = time.Second
+
 type WebsocketsServer interface {
 	Start()
 }
@@ -194,8 +204,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:      new(sync.Mutex),
+		conn:     conn,
+		clientIP: ratelimit.ClientIP(r),
 	}
 
 	s.readLoop(ws)
@@ -217,6 +228,8 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// clientIP is forwarded to the JSON-RPC server for its rate limits
+	clientIP string
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -388,6 +401,7 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(ratelimit.ForwardedForHeader, wsConn.clientIP)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -443,6 +457,15 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
+		if len(params) > 1 {
+			fullTx, ok := params[1].(bool)
+			if !ok {
+				return nil, errors.New("invalid full transaction flag")
+			}
+			if fullTx {
+				return api.subscribePendingEthTransactions(wsConn, subID)
+			}
+		}
 		return api.subscribePendingTransactions(wsConn, subID)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
@@ -659,8 +682,174 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// subscribePendingEthTransactions streams the full transactions entering the
+// pending state of the EVM mempool.
+func (api *pubSubAPI) subscribePendingEthTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	txStream := api.events.PendingEthTxStream()
+	if txStream == nil {
+		return nil, errors.New("full pending transactions subscription requires the EVM mempool")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go txStream.Subscribe(ctx, func(txs []*types.RPCTransaction, _ int) error {
+		for _, tx := range txs {
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       tx,
+				},
+			}
+
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				api.logger.Debug("error writing pending transaction, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
+// SyncStatus is the progress notified by the syncing subscription while the
+// node is catching up.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// SyncingResult is the result notified by the syncing subscription while the
+// node is catching up, it notifies false when it's done.
+type SyncingResult struct {
+	Syncing bool       `json:"syncing"`
+	Status  SyncStatus `json:"status"`
+}
+
+// consensusStateDumper is implemented by the CometBFT clients exposing the
+// consensus state of the peers.
+type consensusStateDumper interface {
+	DumpConsensusState(context.Context) (*coretypes.ResultDumpConsensusState, error)
+}
+
+// subscribeSyncing polls the CometBFT status and notifies the progress of the
+// catch-up each time it changes, then false when it's done. The starting block
+// is the latest synced block when the catch-up started, and the highest block
+// is the highest one committed by the peers, if the client exposes their
+// consensus state, or the current block otherwise.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT client")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		var (
+			syncing bool
+			last    SyncStatus
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			status, err := api.clientCtx.Client.Status(ctx)
+			if err != nil {
+				api.logger.Debug("failed to query node status", "error", err.Error())
+				continue
+			}
+
+			var result any = false
+			if status.SyncInfo.CatchingUp {
+				current := status.SyncInfo.LatestBlockHeight
+				syncStatus := SyncStatus{
+					StartingBlock: last.StartingBlock,
+					CurrentBlock:  hexutil.Uint64(current),                                  //nolint:gosec // G115 // won't exceed uint64
+					HighestBlock:  hexutil.Uint64(max(current, api.peersHighestBlock(ctx))), //nolint:gosec // G115 // won't exceed uint64
+				}
+				if !syncing {
+					// the catch-up starts from the latest synced block
+					syncStatus.StartingBlock = syncStatus.CurrentBlock
+				} else if syncStatus == last {
+					continue
+				}
+				syncing, last = true, syncStatus
+				result = &SyncingResult{Syncing: true, Status: syncStatus}
+			} else {
+				if !syncing {
+					continue
+				}
+				syncing, last = false, SyncStatus{}
+			}
+
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			}
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return
+			}
+		}
+	}()
+
+	return cancel, nil
+}
+
+// peersHighestBlock returns the highest block committed by the peers, from
+// their consensus state, or 0 if it's not available.
+func (api *pubSubAPI) peersHighestBlock(ctx context.Context) int64 {
+	dumper, ok := api.clientCtx.Client.(consensusStateDumper)
+	if !ok {
+		return 0
+	}
+	res, err := dumper.DumpConsensusState(ctx)
+	if err != nil {
+		api.logger.Debug("failed to query the consensus state", "error", err.Error())
+		return 0
+	}
+
+	var highest int64
+	for _, peer := range res.Peers {
+		var peerState struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &peerState); err != nil {
+			api.logger.Debug("failed to decode peer state", "peer", peer.NodeAddress, "error", err.Error())
+			continue
+		}
+		// the peer is in consensus for the block following its latest one
+		highest = max(highest, peerState.RoundState.Height-1)
+	}
+	return highest
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
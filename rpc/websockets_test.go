@@ -1,17 +1,30 @@
 package rpc
 
 import (
+	"context"
+	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/server/config"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
 
@@ -214,3 +227,145 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+// dialTestWebsocketServer starts the websocket server with the pub sub api and
+// returns a connection to it.
+func dialTestWebsocketServer(t *testing.T, api *pubSubAPI) *websocket.Conn {
+	t.Helper()
+	srv := newTestWebsocketServer()
+	srv.api = api
+
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	conn, httpResp, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	require.NotNil(t, httpResp)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// subscribe sends an eth_subscribe request and returns the raw response
+func subscribe(t *testing.T, conn *websocket.Conn, params ...any) map[string]any {
+	t.Helper()
+	require.NoError(t, conn.WriteJSON(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_subscribe",
+		"params":  params,
+	}))
+	var res map[string]any
+	require.NoError(t, conn.ReadJSON(&res))
+	return res
+}
+
+// readNotification reads the result of the next subscription notification
+func readNotification(t *testing.T, conn *websocket.Conn) any {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var res SubscriptionNotification
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, "eth_subscription", res.Method)
+	return res.Params.Result
+}
+
+type testTxPool struct {
+	feed event.Feed
+}
+
+func (p *testTxPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, _ bool) event.Subscription {
+	return p.feed.Subscribe(ch)
+}
+
+func TestSubscribePendingFullTransactions(t *testing.T) {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, evmtypes.SetChainConfig(evmtypes.DefaultChainConfig(testconstants.ExampleChainID.EVMChainID)))
+
+	// the full transactions are not available without the EVM mempool
+	events := stream.NewRPCStreams(nil, log.NewNopLogger(), nil)
+	conn := dialTestWebsocketServer(t, newPubSubAPI(client.Context{}, log.NewNopLogger(), events))
+	res := subscribe(t, conn, "newPendingTransactions", true)
+	require.Contains(t, res, "error")
+
+	pool := &testTxPool{}
+	events.ListenEVMMempool(pool, func() *ethtypes.Header { return nil })
+	defer events.Close()
+
+	res = subscribe(t, conn, "newPendingTransactions", true)
+	require.Contains(t, res, "result")
+
+	to := common.Address{1}
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, To: &to, Value: big.NewInt(10), Gas: 21000, GasPrice: big.NewInt(1)})
+	pool.feed.Send(core.NewTxsEvent{Txs: []*ethtypes.Transaction{tx}})
+
+	result, ok := readNotification(t, conn).(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, tx.Hash().Hex(), result["hash"])
+	require.Equal(t, "0x1", result["nonce"])
+	require.Equal(t, "0x5208", result["gas"])
+}
+
+type testStatusClient struct {
+	client.CometRPC
+
+	mu          sync.Mutex
+	catchingUp  bool
+	height      int64
+	peerHeights []int64
+}
+
+func (c *testStatusClient) setStatus(catchingUp bool, height int64, peerHeights ...int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.catchingUp, c.height, c.peerHeights = catchingUp, height, peerHeights
+}
+
+func (c *testStatusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		CatchingUp:        c.catchingUp,
+		LatestBlockHeight: c.height,
+	}}, nil
+}
+
+func (c *testStatusClient) DumpConsensusState(context.Context) (*coretypes.ResultDumpConsensusState, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res := &coretypes.ResultDumpConsensusState{}
+	for _, height := range c.peerHeights {
+		res.Peers = append(res.Peers, coretypes.PeerStateInfo{
+			PeerState: []byte(fmt.Sprintf(`{"round_state":{"height":"%d","round":0,"step":1}}`, height)),
+		})
+	}
+	return res, nil
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	interval := syncingPollInterval
+	syncingPollInterval = 10 * time.Millisecond
+	defer func() { syncingPollInterval = interval }()
+
+	statusClient := &testStatusClient{}
+	statusClient.setStatus(true, 10, 21, 31)
+	conn := dialTestWebsocketServer(t, newPubSubAPI(client.Context{Client: statusClient}, log.NewNopLogger(), &stream.RPCStream{}))
+	res := subscribe(t, conn, "syncing")
+	require.Contains(t, res, "result")
+
+	result, ok := readNotification(t, conn).(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, true, result["syncing"])
+	require.Equal(t, map[string]any{"startingBlock": "0xa", "currentBlock": "0xa", "highestBlock": "0x1e"}, result["status"])
+
+	// the progress is notified from the same starting block
+	statusClient.setStatus(true, 15, 31)
+	result, ok = readNotification(t, conn).(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, map[string]any{"startingBlock": "0xa", "currentBlock": "0xf", "highestBlock": "0x1e"}, result["status"])
+
+	statusClient.setStatus(false, 30)
+	require.Equal(t, false, readNotification(t, conn))
+}
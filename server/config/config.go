@@ -1,12 +1,14 @@
 package config
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/netip"
 	"path"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
@@ -55,6 +57,15 @@ const (
 	// DefaultEVMTracer is the default vm.Tracer type
 	DefaultEVMTracer = ""
 
+	// DefaultEVMLiveTracerPath is the default directory of the live tracer output files
+	DefaultEVMLiveTracerPath = "data/live-tracer"
+
+	// DefaultEVMLiveTracerMaxSize is the default size in megabytes of a live tracer output file before it gets rotated
+	DefaultEVMLiveTracerMaxSize = 100
+
+	// DefaultMempoolJournal is the default path of the journal of the local EVM mempool transactions
+	DefaultMempoolJournal = "data/transactions.rlp"
+
 	// DefaultEnablePreimageRecording is the default value for EnablePreimageRecording
 	DefaultEnablePreimageRecording = false
 
@@ -82,6 +93,31 @@ const (
 	// DefaultFeeHistoryCap is the default cap for total number of blocks that can be fetched
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultGasPriceOracleBlocks is the default number of blocks sampled by the gas price oracle
+	DefaultGasPriceOracleBlocks = 20
+
+	// DefaultGasPriceOraclePercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGasPriceOraclePercentile = 60
+
+	// DefaultGasPriceOracleIgnorePrice is the default tip (in wei) below which the transactions are not sampled
+	DefaultGasPriceOracleIgnorePrice = 2
+
+	// DefaultGasPriceOracleMaxPrice is the default maximum tip (in wei) suggested by the gas price oracle
+	DefaultGasPriceOracleMaxPrice = 500_000_000_000
+
+	// DefaultGasPriceOracleMempoolPressure is the default number of pending mempool transactions from which
+	// their tips are factored in the gas price oracle suggestion
+	DefaultGasPriceOracleMempoolPressure = 1024
+
+	// MaxGasPriceOracleBlocks is the maximum number of blocks sampled by the gas price oracle
+	MaxGasPriceOracleBlocks = 1024
+
+	// DefaultRateLimitIPBurst is the default number of rate limited requests a client IP can send at once
+	DefaultRateLimitIPBurst = 50
+
+	// DefaultRateLimitSenderBurst is the default number of transactions a sender can submit at once
+	DefaultRateLimitSenderBurst = 16
+
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
@@ -122,6 +158,24 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// IndexerBackendKV is the custom indexer backend storing the eth txs in a KV db
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendSQL is the custom indexer backend storing the blocks, eth txs, receipts and logs in a SQL db
+	IndexerBackendSQL = "sql"
+
+	// DefaultIndexerBackend is the default backend of the custom indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// IndexerSQLDriverSQLite is the SQLite driver of the SQL indexer
+	IndexerSQLDriverSQLite = "sqlite"
+
+	// IndexerSQLDriverPostgres is the Postgres driver of the SQL indexer
+	IndexerSQLDriverPostgres = "postgres"
+
+	// DefaultIndexerSQLDriver is the default driver of the SQL indexer
+	DefaultIndexerSQLDriver = IndexerSQLDriverSQLite
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -141,6 +195,17 @@ type EVMConfig struct {
 	// Tracer defines vm.Tracer type that the EVM will use if the node is run in
 	// trace mode. Default: 'json'.
 	Tracer string `mapstructure:"tracer"`
+	// LiveTracer defines the name of the live tracer fed with the blocks and the
+	// EVM executions processed by the node, it's disabled if empty.
+	LiveTracer string `mapstructure:"live-tracer"`
+	// LiveTracerConfig defines the json config of the live tracer.
+	LiveTracerConfig string `mapstructure:"live-tracer-config"`
+	// LiveTracerPath defines the directory of the live tracer output files,
+	// relative to the node home if not absolute.
+	LiveTracerPath string `mapstructure:"live-tracer-path"`
+	// LiveTracerMaxSize defines the size in megabytes of a live tracer output
+	// file before it gets rotated.
+	LiveTracerMaxSize int `mapstructure:"live-tracer-max-size"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
 	// Enables tracking of SHA3 preimages in the VM
@@ -171,6 +236,17 @@ type MempoolConfig struct {
 	GlobalQueue uint64 `mapstructure:"global-queue"`
 	// Lifetime is the maximum amount of time non-executable transaction are queued
 	Lifetime time.Duration `mapstructure:"lifetime"`
+	// Locals are the addresses of the accounts whose transactions are treated as local
+	Locals []string `mapstructure:"locals"`
+	// NoLocals disables the local transaction handling
+	NoLocals bool `mapstructure:"no-locals"`
+	// Journal is the path of the journal of the local transactions surviving node restarts,
+	// relative to the node home if not absolute, the journal is disabled if empty
+	Journal string `mapstructure:"journal"`
+	// JournalRemotes defines if the remote transactions are journaled too
+	JournalRemotes bool `mapstructure:"journal-remotes"`
+	// Rejournal is the time interval to regenerate the journal
+	Rejournal time.Duration `mapstructure:"rejournal"`
 }
 
 // DefaultMempoolConfig returns the default mempool configuration
@@ -183,6 +259,8 @@ func DefaultMempoolConfig() MempoolConfig {
 		AccountQueue: 64,            // 64 non-executable transaction slots per account
 		GlobalQueue:  1024,          // 1024 global non-executable slots
 		Lifetime:     3 * time.Hour, // 3 hour lifetime for queued transactions
+		Journal:      DefaultMempoolJournal,
+		Rejournal:    time.Hour, // regenerate the journal every hour
 	}
 }
 
@@ -209,6 +287,14 @@ func (c MempoolConfig) Validate() error {
 	if c.Lifetime < 1 {
 		return fmt.Errorf("lifetime must be at least 1 nanosecond, got %s", c.Lifetime)
 	}
+	for _, local := range c.Locals {
+		if !common.IsHexAddress(local) {
+			return fmt.Errorf("invalid local account address %s", local)
+		}
+	}
+	if c.Journal != "" && c.Rejournal < time.Second {
+		return fmt.Errorf("rejournal must be at least 1 second, got %s", c.Rejournal)
+	}
 	return nil
 }
 
@@ -254,12 +340,119 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndexer defines if the custom indexer also indexes the eth logs to serve `eth_getLogs`.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
+	// IndexerBackend defines the db backend of the custom indexer, either "kv" or "sql".
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerSQLDriver defines the driver of the SQL indexer db, either "sqlite" or "postgres".
+	IndexerSQLDriver string `mapstructure:"indexer-sql-driver"`
+	// IndexerSQLDSN defines the data source name of the SQL indexer db, a SQLite db in the data
+	// directory is used if empty.
+	IndexerSQLDSN string `mapstructure:"indexer-sql-dsn"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// GasPriceOracle defines the configuration of the gas price oracle
+	GasPriceOracle GasPriceOracleConfig `mapstructure:"gas-price-oracle"`
+	// RateLimit defines the rate limits of the JSON-RPC requests
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+}
+
+// GasPriceOracleConfig defines the configuration of the gas price oracle suggesting the
+// tips of `eth_gasPrice` and `eth_maxPriorityFeePerGas`.
+type GasPriceOracleConfig struct {
+	// Blocks is the number of recent blocks whose effective tips are sampled, the
+	// suggested tip is derived from the base fee only if 0
+	Blocks uint64 `mapstructure:"blocks"`
+	// Percentile is the percentile of the sampled tips that is suggested
+	Percentile uint64 `mapstructure:"percentile"`
+	// IgnorePrice is the tip (in wei) below which the transactions are not sampled
+	IgnorePrice uint64 `mapstructure:"ignore-price"`
+	// MaxPrice is the maximum suggested tip (in wei), the tip is not capped if 0
+	MaxPrice uint64 `mapstructure:"max-price"`
+	// MempoolPressure is the number of pending mempool transactions from which their tips
+	// are factored in the suggested tip, the mempool is ignored if 0
+	MempoolPressure uint64 `mapstructure:"mempool-pressure"`
+}
+
+// RateLimitConfig defines the token bucket rate limits of the expensive JSON-RPC methods,
+// keyed by client IP, and of the transactions submitted through the JSON-RPC, keyed by sender.
+type RateLimitConfig struct {
+	// Methods are the rate limited methods, a method ending with "*" matches all the
+	// methods starting with its prefix
+	Methods []string `mapstructure:"methods"`
+	// IPRate is the number of rate limited requests per second allowed for each client
+	// IP, the client IPs are not limited if 0
+	IPRate float64 `mapstructure:"ip-rate"`
+	// IPBurst is the number of rate limited requests a client IP can send at once
+	IPBurst int `mapstructure:"ip-burst"`
+	// SenderRate is the number of `eth_sendRawTransaction` per second allowed for each
+	// transaction sender, the senders are not limited if 0
+	SenderRate float64 `mapstructure:"sender-rate"`
+	// SenderBurst is the number of transactions a sender can submit at once
+	SenderBurst int `mapstructure:"sender-burst"`
+}
+
+// GetDefaultRateLimitedMethods returns the JSON-RPC methods that are rate limited by default.
+func GetDefaultRateLimitedMethods() []string {
+	return []string{"eth_sendRawTransaction", "eth_call", "eth_estimateGas", "eth_getLogs", "debug_trace*"}
+}
+
+// DefaultRateLimitConfig returns the default rate limit configuration, the limits are
+// disabled by default
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Methods:     GetDefaultRateLimitedMethods(),
+		IPBurst:     DefaultRateLimitIPBurst,
+		SenderBurst: DefaultRateLimitSenderBurst,
+	}
+}
+
+// Validate returns an error if the rate limit configuration is invalid
+func (c RateLimitConfig) Validate() error {
+	if c.IPRate < 0 {
+		return fmt.Errorf("ip rate cannot be negative, got %f", c.IPRate)
+	}
+	if c.IPRate > 0 && c.IPBurst < 1 {
+		return fmt.Errorf("ip burst must be at least 1, got %d", c.IPBurst)
+	}
+	if c.SenderRate < 0 {
+		return fmt.Errorf("sender rate cannot be negative, got %f", c.SenderRate)
+	}
+	if c.SenderRate > 0 && c.SenderBurst < 1 {
+		return fmt.Errorf("sender burst must be at least 1, got %d", c.SenderBurst)
+	}
+	for _, method := range c.Methods {
+		if method == "" || method == "*" {
+			return fmt.Errorf("invalid rate limited method %q", method)
+		}
+	}
+	return nil
+}
+
+// DefaultGasPriceOracleConfig returns the default gas price oracle configuration
+func DefaultGasPriceOracleConfig() GasPriceOracleConfig {
+	return GasPriceOracleConfig{
+		Blocks:          DefaultGasPriceOracleBlocks,
+		Percentile:      DefaultGasPriceOraclePercentile,
+		IgnorePrice:     DefaultGasPriceOracleIgnorePrice,
+		MaxPrice:        DefaultGasPriceOracleMaxPrice,
+		MempoolPressure: DefaultGasPriceOracleMempoolPressure,
+	}
+}
+
+// Validate returns an error if the gas price oracle configuration is invalid
+func (c GasPriceOracleConfig) Validate() error {
+	if c.Blocks > MaxGasPriceOracleBlocks {
+		return fmt.Errorf("blocks cannot be greater than %d, got %d", MaxGasPriceOracleBlocks, c.Blocks)
+	}
+	if c.Percentile > 100 {
+		return fmt.Errorf("percentile must be between 0 and 100, got %d", c.Percentile)
+	}
+	return nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -274,6 +467,8 @@ type TLSConfig struct {
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
 		Tracer:                  DefaultEVMTracer,
+		LiveTracerPath:          DefaultEVMLiveTracerPath,
+		LiveTracerMaxSize:       DefaultEVMLiveTracerMaxSize,
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
@@ -289,6 +484,14 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.LiveTracerConfig != "" && !json.Valid([]byte(c.LiveTracerConfig)) {
+		return fmt.Errorf("invalid live tracer config %q, expected a json object", c.LiveTracerConfig)
+	}
+
+	if c.LiveTracerMaxSize < 0 {
+		return fmt.Errorf("live tracer max size cannot be negative: %d", c.LiveTracerMaxSize)
+	}
+
 	if _, err := netip.ParseAddrPort(c.GethMetricsAddress); err != nil {
 		return fmt.Errorf("invalid geth metrics address %q: %w", c.GethMetricsAddress, err)
 	}
@@ -307,7 +510,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "txpooladmin", "debug", "miner", "trace", "ots"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -337,9 +540,15 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
 		MaxOpenConnections:   DefaultMaxOpenConnections,
 		EnableIndexer:        false,
+		EnableLogIndexer:     false,
+		IndexerBackend:       DefaultIndexerBackend,
+		IndexerSQLDriver:     DefaultIndexerSQLDriver,
+		IndexerSQLDSN:        "",
 		MetricsAddress:       DefaultJSONRPCMetricsAddress,
 		WSOrigins:            GetDefaultWSOrigins(),
 		EnableProfiling:      DefaultEnableProfiling,
+		GasPriceOracle:       DefaultGasPriceOracleConfig(),
+		RateLimit:            DefaultRateLimitConfig(),
 	}
 }
 
@@ -389,6 +598,31 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.EnableLogIndexer && !c.EnableIndexer {
+		return errors.New("JSON-RPC log indexer requires the indexer to be enabled")
+	}
+
+	if c.IndexerBackend != IndexerBackendKV && c.IndexerBackend != IndexerBackendSQL {
+		return fmt.Errorf("invalid JSON-RPC indexer backend %q, available backends: %s, %s", c.IndexerBackend, IndexerBackendKV, IndexerBackendSQL)
+	}
+
+	if c.IndexerBackend == IndexerBackendSQL {
+		if c.IndexerSQLDriver != IndexerSQLDriverSQLite && c.IndexerSQLDriver != IndexerSQLDriverPostgres {
+			return fmt.Errorf("invalid JSON-RPC indexer SQL driver %q, available drivers: %s, %s", c.IndexerSQLDriver, IndexerSQLDriverSQLite, IndexerSQLDriverPostgres)
+		}
+		if c.IndexerSQLDriver == IndexerSQLDriverPostgres && c.IndexerSQLDSN == "" {
+			return errors.New("JSON-RPC indexer SQL DSN is required with the postgres driver")
+		}
+	}
+
+	if err := c.GasPriceOracle.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC gas price oracle config: %w", err)
+	}
+
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit config: %w", err)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
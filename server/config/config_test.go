@@ -55,6 +55,21 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal GasPriceOracleConfig",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.gas-price-oracle.percentile", 50)
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				require.NotEqual(t, uint64(50), cfg.JSONRPC.GasPriceOracle.Percentile)
+				cfg.JSONRPC.GasPriceOracle.Percentile = 50
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -69,3 +84,108 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGasPriceOracleConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(cfg *serverconfig.GasPriceOracleConfig)
+		wantErr  bool
+	}{
+		{
+			"default",
+			func(_ *serverconfig.GasPriceOracleConfig) {},
+			false,
+		},
+		{
+			"oracle disabled",
+			func(cfg *serverconfig.GasPriceOracleConfig) {
+				cfg.Blocks = 0
+				cfg.MaxPrice = 0
+				cfg.MempoolPressure = 0
+			},
+			false,
+		},
+		{
+			"too many blocks",
+			func(cfg *serverconfig.GasPriceOracleConfig) {
+				cfg.Blocks = serverconfig.MaxGasPriceOracleBlocks + 1
+			},
+			true,
+		},
+		{
+			"percentile above 100",
+			func(cfg *serverconfig.GasPriceOracleConfig) {
+				cfg.Percentile = 101
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultGasPriceOracleConfig()
+			tt.malleate(&cfg)
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRateLimitConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(cfg *serverconfig.RateLimitConfig)
+		wantErr  bool
+	}{
+		{
+			"default",
+			func(_ *serverconfig.RateLimitConfig) {},
+			false,
+		},
+		{
+			"limits enabled",
+			func(cfg *serverconfig.RateLimitConfig) {
+				cfg.IPRate = 10
+				cfg.SenderRate = 0.5
+			},
+			false,
+		},
+		{
+			"negative IP rate",
+			func(cfg *serverconfig.RateLimitConfig) {
+				cfg.IPRate = -1
+			},
+			true,
+		},
+		{
+			"zero sender burst",
+			func(cfg *serverconfig.RateLimitConfig) {
+				cfg.SenderRate = 1
+				cfg.SenderBurst = 0
+			},
+			true,
+		},
+		{
+			"wildcard method",
+			func(cfg *serverconfig.RateLimitConfig) {
+				cfg.Methods = append(cfg.Methods, "*")
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultRateLimitConfig()
+			tt.malleate(&cfg)
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -13,6 +13,20 @@ const DefaultEVMConfigTemplate = `
 # Valid types are: json|struct|access_list|markdown
 tracer = "{{ .EVM.Tracer }}"
 
+# LiveTracer defines the live tracer fed with the blocks and the EVM executions processed by the node,
+# which writes its output to rotating files. Leave empty to disable it.
+# Valid tracers are: supply|balances, or a custom tracer registered by the app.
+live-tracer = "{{ .EVM.LiveTracer }}"
+
+# LiveTracerConfig defines the JSON config of the live tracer.
+live-tracer-config = "{{ .EVM.LiveTracerConfig }}"
+
+# LiveTracerPath defines the directory of the live tracer output files, relative to the node home if not absolute.
+live-tracer-path = "{{ .EVM.LiveTracerPath }}"
+
+# LiveTracerMaxSize defines the size in megabytes of a live tracer output file before it gets rotated.
+live-tracer-max-size = {{ .EVM.LiveTracerMaxSize }}
+
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
@@ -52,6 +66,23 @@ global-queue = {{ .EVM.Mempool.GlobalQueue }}
 # Lifetime is the maximum amount of time non-executable transaction are queued
 lifetime = "{{ .EVM.Mempool.Lifetime }}"
 
+# Locals are the addresses of the accounts whose transactions are treated as local, on top of
+# the accounts which submitted a transaction through the JSON-RPC server of the node
+locals = [{{ range $i, $v := .EVM.Mempool.Locals }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# NoLocals disables the local transaction handling
+no-locals = {{ .EVM.Mempool.NoLocals }}
+
+# Journal is the path of the journal of the local transactions surviving node restarts,
+# relative to the node home if not absolute. The journal is disabled if empty.
+journal = "{{ .EVM.Mempool.Journal }}"
+
+# JournalRemotes defines if the remote transactions are journaled too
+journal-remotes = {{ .EVM.Mempool.JournalRemotes }}
+
+# Rejournal is the time interval to regenerate the journal
+rejournal = "{{ .EVM.Mempool.Rejournal }}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -116,6 +147,22 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndexer enables the indexing of the EVM logs by address and topics, which is used by
+# 'eth_getLogs' for the blocks indexed since it's enabled. It requires the custom indexer to be enabled.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
+# IndexerBackend defines the database of the custom indexer, either "kv" or "sql".
+# The "sql" backend also stores the blocks, receipts and logs into relational tables, and always
+# indexes the logs used by 'eth_getLogs'.
+indexer-backend = "{{ .JSONRPC.IndexerBackend }}"
+
+# IndexerSQLDriver defines the driver of the "sql" indexer backend, either "sqlite" or "postgres".
+indexer-sql-driver = "{{ .JSONRPC.IndexerSQLDriver }}"
+
+# IndexerSQLDSN defines the data source name of the "sql" indexer backend. It defaults to the
+# SQLite database data/evmindexer.sqlite if empty, and is required with the "postgres" driver.
+indexer-sql-dsn = "{{ .JSONRPC.IndexerSQLDSN }}"
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -129,6 +176,48 @@ batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
 # Enabled profiling in the debug namespace
 enable-profiling = {{ .JSONRPC.EnableProfiling }}
 
+# Gas price oracle suggesting the tips of 'eth_gasPrice' and 'eth_maxPriorityFeePerGas'
+[json-rpc.gas-price-oracle]
+
+# Blocks is the number of recent blocks whose effective tips are sampled.
+# The suggested tip is derived from the base fee only if 0.
+blocks = {{ .JSONRPC.GasPriceOracle.Blocks }}
+
+# Percentile is the percentile of the sampled tips that is suggested.
+percentile = {{ .JSONRPC.GasPriceOracle.Percentile }}
+
+# IgnorePrice is the tip (in wei) below which the transactions are not sampled.
+ignore-price = {{ .JSONRPC.GasPriceOracle.IgnorePrice }}
+
+# MaxPrice is the maximum suggested tip (in wei), the tip is not capped if 0.
+max-price = {{ .JSONRPC.GasPriceOracle.MaxPrice }}
+
+# MempoolPressure is the number of pending mempool transactions from which their tips are
+# factored in the suggested tip, the mempool is ignored if 0.
+mempool-pressure = {{ .JSONRPC.GasPriceOracle.MempoolPressure }}
+
+# Token bucket rate limits of the expensive JSON-RPC methods and of the submitted transactions.
+# The rejected requests get the -32005 "limit exceeded" error.
+[json-rpc.rate-limit]
+
+# Methods are the rate limited methods, a method ending with "*" matches all the methods starting
+# with its prefix.
+methods = [{{ range $i, $v := .JSONRPC.RateLimit.Methods }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# IPRate is the number of rate limited requests per second allowed for each client IP, over HTTP
+# and WebSocket. The client IPs are not limited if 0.
+ip-rate = {{ .JSONRPC.RateLimit.IPRate }}
+
+# IPBurst is the number of rate limited requests a client IP can send at once.
+ip-burst = {{ .JSONRPC.RateLimit.IPBurst }}
+
+# SenderRate is the number of 'eth_sendRawTransaction' per second allowed for each transaction
+# sender. The senders are not limited if 0.
+sender-rate = {{ .JSONRPC.RateLimit.SenderRate }}
+
+# SenderBurst is the number of transactions a sender can submit at once.
+sender-burst = {{ .JSONRPC.RateLimit.SenderBurst }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
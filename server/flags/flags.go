@@ -54,9 +54,23 @@ const (
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCEnableLogIndexer     = "json-rpc.enable-log-indexer"
+	JSONRPCIndexerBackend       = "json-rpc.indexer-backend"
+	JSONRPCIndexerSQLDriver     = "json-rpc.indexer-sql-driver"
+	JSONRPCIndexerSQLDSN        = "json-rpc.indexer-sql-dsn"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCGPOBlocks            = "json-rpc.gas-price-oracle.blocks"
+	JSONRPCGPOPercentile        = "json-rpc.gas-price-oracle.percentile"
+	JSONRPCGPOIgnorePrice       = "json-rpc.gas-price-oracle.ignore-price"
+	JSONRPCGPOMaxPrice          = "json-rpc.gas-price-oracle.max-price"
+	JSONRPCGPOMempoolPressure   = "json-rpc.gas-price-oracle.mempool-pressure"
+	JSONRPCRateLimitMethods     = "json-rpc.rate-limit.methods"
+	JSONRPCRateLimitIPRate      = "json-rpc.rate-limit.ip-rate"
+	JSONRPCRateLimitIPBurst     = "json-rpc.rate-limit.ip-burst"
+	JSONRPCRateLimitSenderRate  = "json-rpc.rate-limit.sender-rate"
+	JSONRPCRateLimitSenderBurst = "json-rpc.rate-limit.sender-burst"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -66,19 +80,28 @@ const (
 // EVM flags
 const (
 	EVMTracer                  = "evm.tracer"
+	EVMLiveTracer              = "evm.live-tracer"
+	EVMLiveTracerConfig        = "evm.live-tracer-config"
+	EVMLiveTracerPath          = "evm.live-tracer-path"
+	EVMLiveTracerMaxSize       = "evm.live-tracer-max-size"
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
 	EVMChainID                 = "evm.evm-chain-id"
 	EVMMinTip                  = "evm.min-tip"
 	EvmGethMetricsAddress      = "evm.geth-metrics-address"
 
-	EVMMempoolPriceLimit   = "evm.mempool.price-limit"
-	EVMMempoolPriceBump    = "evm.mempool.price-bump"
-	EVMMempoolAccountSlots = "evm.mempool.account-slots"
-	EVMMempoolGlobalSlots  = "evm.mempool.global-slots"
-	EVMMempoolAccountQueue = "evm.mempool.account-queue"
-	EVMMempoolGlobalQueue  = "evm.mempool.global-queue"
-	EVMMempoolLifetime     = "evm.mempool.lifetime"
+	EVMMempoolPriceLimit     = "evm.mempool.price-limit"
+	EVMMempoolPriceBump      = "evm.mempool.price-bump"
+	EVMMempoolAccountSlots   = "evm.mempool.account-slots"
+	EVMMempoolGlobalSlots    = "evm.mempool.global-slots"
+	EVMMempoolAccountQueue   = "evm.mempool.account-queue"
+	EVMMempoolGlobalQueue    = "evm.mempool.global-queue"
+	EVMMempoolLifetime       = "evm.mempool.lifetime"
+	EVMMempoolLocals         = "evm.mempool.locals"
+	EVMMempoolNoLocals       = "evm.mempool.no-locals"
+	EVMMempoolJournal        = "evm.mempool.journal"
+	EVMMempoolJournalRemotes = "evm.mempool.journal-remotes"
+	EVMMempoolRejournal      = "evm.mempool.rejournal"
 )
 
 // TLS flags
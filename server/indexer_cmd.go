@@ -5,24 +5,32 @@ import (
 
 	"github.com/spf13/cobra"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+// FlagRepair deletes and reindexes the mismatched blocks found by the verify mode
+const FlagRepair = "repair"
+
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|backfill|verify]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- backfill: index the eth txs of the already indexed blocks by their sender, recipient and created contract addresses, it should be run once on the nodes indexed before the address index was introduced, only with the kv indexer backend.
+		- verify: cross-check the entries of the indexed blocks against the block results and report the mismatches, the entries of the mismatched blocks are deleted and reindexed with the --repair flag.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -36,19 +44,23 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "backfill" && direction != "verify" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|backfill|verify, got: %s", direction)
+			}
+
+			appConfig, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
 			}
 
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			idxer, err := NewEVMTxIndexer(home, appConfig.JSONRPC, server.GetAppDBBackend(serverCtx.Viper), logger.With("module", "evmindex"), clientCtx)
 			if err != nil {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -65,16 +77,24 @@ func NewIndexTxCmd() *cobra.Command {
 				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
 			})
 
-			indexBlock := func(height int64) error {
+			loadBlock := func(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
 				blk := blockStore.LoadBlock(height)
 				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
+					return nil, nil, fmt.Errorf("block not found %d", height)
 				}
 				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
+				if err != nil {
+					return nil, nil, err
+				}
+				return blk, resBlk.TxResults, nil
+			}
+
+			indexBlock := func(height int64) error {
+				blk, txResults, err := loadBlock(height)
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				if err := idxer.IndexBlock(blk, txResults); err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -110,6 +130,74 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "backfill":
+				kvIndexer, ok := idxer.(*indexer.KVIndexer)
+				if !ok {
+					// the other backends always index the addresses
+					return fmt.Errorf("backfill is only supported by the %s indexer backend", cosmosevmserverconfig.IndexerBackendKV)
+				}
+				first, err := kvIndexer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				latest, err := kvIndexer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 {
+					// nothing to backfill if indexer db is empty
+					return nil
+				}
+				for i := first; i <= latest; i++ {
+					blk, txResults, err := loadBlock(i)
+					if err != nil {
+						return err
+					}
+					if err := kvIndexer.BackfillAddressIndex(blk, txResults); err != nil {
+						return err
+					}
+					fmt.Println(i)
+				}
+			case "verify":
+				repair, err := cmd.Flags().GetBool(FlagRepair)
+				if err != nil {
+					return err
+				}
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				latest, err := idxer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				var mismatched int
+				for i := max(first, 1); first != -1 && i <= latest; i++ {
+					blk, txResults, err := loadBlock(i)
+					if err != nil {
+						return err
+					}
+					mismatches, err := indexer.VerifyBlock(clientCtx, logger, idxer, blk, txResults)
+					if err != nil {
+						return err
+					}
+					if len(mismatches) == 0 {
+						continue
+					}
+					mismatched++
+					for _, mismatch := range mismatches {
+						fmt.Printf("block %d: %s\n", i, mismatch)
+					}
+					if repair {
+						if err := indexer.RepairBlock(clientCtx, logger, idxer, blk, txResults); err != nil {
+							return err
+						}
+						fmt.Printf("block %d: repaired\n", i)
+					}
+				}
+				if mismatched > 0 && !repair {
+					return fmt.Errorf("found %d mismatched blocks, run with --%s to reindex them", mismatched, FlagRepair)
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -117,5 +205,6 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Bool(FlagRepair, false, "Delete the entries of the mismatched blocks found by the verify mode and reindex them")
 	return cmd
 }
@@ -18,12 +18,16 @@ const (
 	NewBlockWaitTimeout = 60 * time.Second
 )
 
-// EVMIndexerService indexes transactions for json-rpc service.
+// EVMIndexerService indexes transactions, and their logs if the log index is
+// enabled, for json-rpc service. The blocks pruned from the node, according to
+// its retain height, are pruned from the indexer too.
 type EVMIndexerService struct {
 	service.BaseService
 
 	txIdxr servertypes.EVMTxIndexer
 	client rpcclient.Client
+	// retainHeight is the height below which the indexer was pruned
+	retainHeight int64
 }
 
 // NewEVMIndexerService returns a new service instance.
@@ -120,5 +124,24 @@ func (eis *EVMIndexerService) OnStart() error {
 			}
 			lastBlock = blockResult.Height
 		}
+		eis.prune(ctx)
 	}
 }
+
+// prune removes the indexed blocks below the earliest block of the node.
+func (eis *EVMIndexerService) prune(ctx context.Context) {
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		eis.Logger.Error("failed to fetch status", "err", err)
+		return
+	}
+	earliest := status.SyncInfo.EarliestBlockHeight
+	if earliest <= eis.retainHeight {
+		return
+	}
+	if err := eis.txIdxr.Prune(earliest); err != nil {
+		eis.Logger.Error("failed to prune indexer", "retainHeight", earliest, "err", err)
+		return
+	}
+	eis.retainHeight = earliest
+}
@@ -17,6 +17,7 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/server/types"
@@ -51,6 +52,9 @@ func StartJSONRPC(
 
 	stream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
 	app.RegisterPendingTxListener(stream.ListenPendingTx)
+	if mempool != nil {
+		stream.ListenEVMMempool(mempool, mempool.GetBlockchain().CurrentBlock)
+	}
 
 	// Set Geth's global logger to use this handler
 	handler := &CustomSlogHandler{logger: logger}
@@ -76,7 +80,8 @@ func StartJSONRPC(
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	limiter := ratelimit.NewLimiter(config.JSONRPC.RateLimit, srvCtx.Logger)
+	r.Handle("/", limiter.Handler(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/mempool/txpool/locals"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	// FlagJournal overrides the path of the EVM mempool journal set in the app config
+	FlagJournal = "journal"
+	// FlagJSONRPCURL is the url of the JSON-RPC server the transactions are submitted to
+	FlagJSONRPCURL = "json-rpc-url"
+)
+
+// NewMempoolCmd creates a new Cobra command to move the EVM mempool transactions between nodes.
+func NewMempoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mempool",
+		Short: "EVM mempool subcommands",
+	}
+	cmd.AddCommand(
+		newMempoolExportCmd(),
+		newMempoolImportCmd(),
+	)
+	return cmd
+}
+
+func newMempoolExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Export the journaled EVM mempool transactions to a file",
+		Long: `Export the EVM mempool transactions of the node journal to a file, as a RLP stream of signed transactions.
+
+The journal holds the transactions of the local accounts, or all the pool transactions if journal-remotes is enabled,
+it is regenerated from the pool content at every rejournal interval. The node can be running or stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			path, err := cmd.Flags().GetString(FlagJournal)
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				appConfig, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+				if err != nil {
+					return err
+				}
+				path = appConfig.EVM.Mempool.Journal
+			}
+			if path == "" {
+				return errors.New("the EVM mempool journal is disabled")
+			}
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(serverCtx.Config.RootDir, path)
+			}
+
+			txs, err := readTransactions(path)
+			if err != nil {
+				return err
+			}
+
+			output, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer output.Close()
+			for _, tx := range txs {
+				if err := rlp.Encode(output, tx); err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("exported %d transactions to %s\n", len(txs), args[0])
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagJournal, "", "the path of the journal, relative to the node home if not absolute, defaults to the journal of the app config")
+	return cmd
+}
+
+func newMempoolImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import EVM transactions into the mempool of a node",
+		Long: `Submit the EVM transactions of a file created by the export command to a node through its JSON-RPC server.
+
+The transactions are submitted in the order of the file, the ones already included in a block or rejected by the node are reported and skipped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			url, err := cmd.Flags().GetString(FlagJSONRPCURL)
+			if err != nil {
+				return err
+			}
+
+			txs, err := readTransactions(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := ethclient.DialContext(cmd.Context(), url)
+			if err != nil {
+				return fmt.Errorf("failed to connect to %s: %w", url, err)
+			}
+			defer client.Close()
+
+			imported := 0
+			for _, tx := range txs {
+				if err := client.SendTransaction(cmd.Context(), tx); err != nil {
+					cmd.PrintErrf("failed to import transaction %s: %s\n", tx.Hash(), err)
+					continue
+				}
+				imported++
+			}
+
+			cmd.Printf("imported %d transactions, %d failed\n", imported, len(txs)-imported)
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagJSONRPCURL, "http://"+cosmosevmserverconfig.DefaultJSONRPCAddress, "the url of the JSON-RPC server of the node")
+	return cmd
+}
+
+// readTransactions reads the RLP stream of transactions of the file, the
+// duplicated transactions are skipped.
+func readTransactions(path string) (ethtypes.Transactions, error) {
+	input, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	var (
+		txs  ethtypes.Transactions
+		seen = make(map[common.Hash]struct{})
+	)
+	err = locals.ReadTransactions(input, func(batch ethtypes.Transactions) {
+		for _, tx := range batch {
+			if _, ok := seen[tx.Hash()]; ok {
+				continue
+			}
+			seen[tx.Hash()] = struct{}{}
+			txs = append(txs, tx)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transactions of %s: %w", path, err)
+	}
+	return txs, nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// NewRollbackCmd creates the SDK command rolling back the CometBFT and the app
+// states by one height, which additionally rewinds the evm tx indexer, if
+// enabled, to the rolled back height so the blocks above are reindexed once
+// they are executed again.
+func NewRollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := sdkserver.NewRollbackCmd(appCreator, defaultNodeHome)
+	rollback := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := rollback(cmd, args); err != nil {
+			return err
+		}
+		return rewindIndexer(cmd)
+	}
+	return cmd
+}
+
+// rewindIndexer rewinds the evm tx indexer to the last block height of the
+// CometBFT state.
+func rewindIndexer(cmd *cobra.Command) error {
+	serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+	appConfig, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if !appConfig.JSONRPC.EnableIndexer {
+		return nil
+	}
+
+	cfg := serverCtx.Config
+	stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	state, err := sm.NewStore(stateDB, sm.StoreOptions{}).Load()
+	if err != nil {
+		return err
+	}
+	if err := stateDB.Close(); err != nil {
+		return err
+	}
+
+	idxer, db, err := openEVMTxIndexer(
+		cfg.RootDir,
+		appConfig.JSONRPC,
+		sdkserver.GetAppDBBackend(serverCtx.Viper),
+		serverCtx.Logger.With("module", "evmindex"),
+		client.GetClientContextFromCmd(cmd),
+	)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if err := idxer.Rewind(state.LastBlockHeight); err != nil {
+		return err
+	}
+	fmt.Printf("Rewound evm tx indexer to height %d\n", state.LastBlockHeight)
+	return nil
+}
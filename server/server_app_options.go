@@ -1,14 +1,18 @@
 package server
 
 import (
+	"encoding/json"
 	"math"
 	"path/filepath"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	srvflags "github.com/cosmos/evm/server/flags"
+	"github.com/cosmos/evm/x/vm/tracers/live"
 
 	"cosmossdk.io/log"
 
@@ -140,10 +144,57 @@ func GetLegacyPoolConfig(appOpts servertypes.AppOptions, logger log.Logger) *leg
 	if lifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolLifetime)); lifetime != 0 {
 		legacyConfig.Lifetime = lifetime
 	}
+	for _, local := range cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolLocals)) {
+		if !common.IsHexAddress(local) {
+			logger.Error("invalid local account address in app.toml or flag, skipping it", "address", local)
+			continue
+		}
+		legacyConfig.Locals = append(legacyConfig.Locals, common.HexToAddress(local))
+	}
+	legacyConfig.NoLocals = cast.ToBool(appOpts.Get(srvflags.EVMMempoolNoLocals))
+	if journal := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal)); journal != "" {
+		if !filepath.IsAbs(journal) {
+			journal = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), journal)
+		}
+		legacyConfig.Journal = journal
+	}
+	legacyConfig.JournalRemotes = cast.ToBool(appOpts.Get(srvflags.EVMMempoolJournalRemotes))
+	if rejournal := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal)); rejournal != 0 {
+		legacyConfig.Rejournal = rejournal
+	}
 
 	return &legacyConfig
 }
 
+// GetLiveTracer creates the live tracer set in the app options, it returns nil
+// if no live tracer is set.
+func GetLiveTracer(appOpts servertypes.AppOptions, logger log.Logger) (*tracing.Hooks, error) {
+	if appOpts == nil {
+		logger.Error("app options is nil, live tracer disabled")
+		return nil, nil
+	}
+
+	name := cast.ToString(appOpts.Get(srvflags.EVMLiveTracer))
+	if name == "" {
+		return nil, nil
+	}
+	path := cast.ToString(appOpts.Get(srvflags.EVMLiveTracerPath))
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), path)
+	}
+	sink := live.FileSinkConfig{
+		Path:    path,
+		MaxSize: cast.ToInt(appOpts.Get(srvflags.EVMLiveTracerMaxSize)),
+	}
+
+	hooks, err := live.New(name, json.RawMessage(cast.ToString(appOpts.Get(srvflags.EVMLiveTracerConfig))), sink)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("live tracer enabled", "tracer", name, "path", sink.Path)
+	return hooks, nil
+}
+
 func GetCosmosPoolMaxTx(appOpts servertypes.AppOptions, logger log.Logger) int {
 	if appOpts == nil {
 		// we don't want to return 0 here, as then appOpts.Get() will return nil and that will be
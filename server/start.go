@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net"
@@ -10,10 +11,12 @@ import (
 	"runtime/pprof"
 
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+	_ "github.com/lib/pq" // postgres driver of the sql indexer
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "modernc.org/sqlite" // sqlite driver of the sql indexer
 
 	abciserver "github.com/cometbft/cometbft/abci/server"
 	tcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
@@ -219,12 +222,30 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the indexing of the eth logs by the custom tx indexer for `eth_getLogs`")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "the db backend of the custom tx indexer (kv|sql)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDriver, cosmosevmserverconfig.DefaultIndexerSQLDriver, "the driver of the sql custom tx indexer (sqlite|postgres)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDSN, "", "the data source name of the sql custom tx indexer, defaults to the SQLite db data/evmindexer.sqlite")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOBlocks, cosmosevmserverconfig.DefaultGasPriceOracleBlocks, "the number of recent blocks whose effective tips are sampled by the gas price oracle (0=derived from the base fee)")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOPercentile, cosmosevmserverconfig.DefaultGasPriceOraclePercentile, "the percentile of the sampled tips suggested by the gas price oracle")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOIgnorePrice, cosmosevmserverconfig.DefaultGasPriceOracleIgnorePrice, "the tip (in wei) below which the transactions are not sampled by the gas price oracle")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOMaxPrice, cosmosevmserverconfig.DefaultGasPriceOracleMaxPrice, "the maximum tip (in wei) suggested by the gas price oracle (0=uncapped)")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOMempoolPressure, cosmosevmserverconfig.DefaultGasPriceOracleMempoolPressure, "the number of pending mempool transactions from which their tips are factored in by the gas price oracle (0=ignored)") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitMethods, cosmosevmserverconfig.GetDefaultRateLimitedMethods(), "the rate limited JSON-RPC methods, a method ending with '*' matches all the methods starting with its prefix")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitIPRate, 0, "the number of rate limited requests per second allowed for each client IP (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitIPBurst, cosmosevmserverconfig.DefaultRateLimitIPBurst, "the number of rate limited requests a client IP can send at once")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitSenderRate, 0, "the number of eth_sendRawTransaction per second allowed for each transaction sender (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitSenderBurst, cosmosevmserverconfig.DefaultRateLimitSenderBurst, "the number of transactions a sender can submit at once")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
+	cmd.Flags().String(srvflags.EVMLiveTracer, "", "the live tracer fed with the blocks and the EVM executions processed by the node (supply|balances)")
+	cmd.Flags().String(srvflags.EVMLiveTracerConfig, "", "the JSON config of the live tracer")
+	cmd.Flags().String(srvflags.EVMLiveTracerPath, cosmosevmserverconfig.DefaultEVMLiveTracerPath, "the directory of the live tracer output files, relative to the node home if not absolute")
+	cmd.Flags().Int(srvflags.EVMLiveTracerMaxSize, cosmosevmserverconfig.DefaultEVMLiveTracerMaxSize, "the size in megabytes of a live tracer output file before it gets rotated")
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
 	cmd.Flags().Uint64(srvflags.EVMMinTip, cosmosevmserverconfig.DefaultEVMMinTip, "the minimum priority fee for the mempool")
 	cmd.Flags().String(srvflags.EvmGethMetricsAddress, cosmosevmserverconfig.DefaultGethMetricsAddress, "the address to bind the geth metrics server to")
@@ -236,6 +257,11 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultMempoolConfig().AccountQueue, "the maximum number of non-executable transaction slots permitted per account")
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().GlobalQueue, "the maximum number of non-executable transaction slots for all accounts")
 	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultMempoolConfig().Lifetime, "the maximum amount of time non-executable transaction are queued")
+	cmd.Flags().StringSlice(srvflags.EVMMempoolLocals, nil, "the addresses of the accounts whose transactions are treated as local")
+	cmd.Flags().Bool(srvflags.EVMMempoolNoLocals, false, "disables the local transaction handling")
+	cmd.Flags().String(srvflags.EVMMempoolJournal, cosmosevmserverconfig.DefaultMempoolConfig().Journal, "the path of the journal of the local transactions surviving node restarts, relative to the node home if not absolute, disabled if empty")
+	cmd.Flags().Bool(srvflags.EVMMempoolJournalRemotes, false, "journal the remote transactions too")
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, cosmosevmserverconfig.DefaultMempoolConfig().Rejournal, "the time interval to regenerate the journal")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -483,14 +509,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer servertypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = NewEVMTxIndexer(home, config.JSONRPC, server.GetAppDBBackend(svrCtx.Viper), idxLogger, clientCtx)
 		if err != nil {
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -569,6 +593,73 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenIndexerSQLDB opens the SQL db of the custom eth indexer, the SQLite db
+// data/evmindexer.sqlite is used if the data source name is empty.
+func OpenIndexerSQLDB(rootDir, driver, dsn string) (*sql.DB, error) {
+	if dsn == "" {
+		dsn = filepath.Join(rootDir, "data", "evmindexer.sqlite")
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driver == cosmosevmserverconfig.IndexerSQLDriverSQLite {
+		// SQLite doesn't support concurrent writers
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// NewEVMTxIndexer opens the db of the custom eth indexer with the backend set
+// in the JSON-RPC config and creates the indexer.
+func NewEVMTxIndexer(
+	rootDir string,
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (servertypes.EVMTxIndexer, error) {
+	idxer, _, err := openEVMTxIndexer(rootDir, cfg, backendType, logger, clientCtx)
+	return idxer, err
+}
+
+// openEVMTxIndexer is NewEVMTxIndexer also returning the db of the indexer, to be
+// closed by the caller.
+func openEVMTxIndexer(
+	rootDir string,
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (servertypes.EVMTxIndexer, io.Closer, error) {
+	if cfg.IndexerBackend == cosmosevmserverconfig.IndexerBackendSQL {
+		db, err := OpenIndexerSQLDB(rootDir, cfg.IndexerSQLDriver, cfg.IndexerSQLDSN)
+		if err != nil {
+			return nil, nil, err
+		}
+		idxer, err := indexer.NewSQLIndexer(db, logger, clientCtx)
+		if err != nil {
+			_ = db.Close()
+			return nil, nil, err
+		}
+		return idxer, db, nil
+	}
+
+	idxDB, err := OpenIndexerDB(rootDir, backendType)
+	if err != nil {
+		return nil, nil, err
+	}
+	kvIndexer := indexer.NewKVIndexer(idxDB, logger, clientCtx)
+	if cfg.EnableLogIndexer {
+		kvIndexer = kvIndexer.WithLogIndex()
+	}
+	return kvIndexer, idxDB, nil
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
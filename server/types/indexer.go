@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -11,10 +12,63 @@ import (
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
+	// Rewind removes the indexed entries of the blocks above the height, it's
+	// used to resume the indexing after a rollback.
+	Rewind(height int64) error
+	// Prune removes the indexed entries of the blocks below the retain height.
+	Prune(retainHeight int64) error
+	// DeleteBlock removes the indexed entries of the block and the ones of the
+	// eth txs wherever they are indexed, it's used to repair the block before
+	// reindexing it.
+	DeleteBlock(height int64, txHashes []common.Hash) error
 
 	// GetByTxHash returns nil if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetBySender returns a page of the eth txs sent by the address.
+	GetBySender(common.Address, AddressTxsQuery) ([]IndexedTx, error)
+	// GetByRecipient returns a page of the eth txs sent to the address.
+	GetByRecipient(common.Address, AddressTxsQuery) ([]IndexedTx, error)
+	// GetContractCreation returns nil if the contract was not created by a
+	// top-level eth tx.
+	GetContractCreation(common.Address) (*IndexedTx, error)
+}
+
+// EVMLogIndexer defines the interface of the eth tx indexers that also index
+// the eth logs by address and topics.
+type EVMLogIndexer interface {
+	// LogIndexRange returns the range of the blocks with indexed logs, returns
+	// -1 if no logs are indexed.
+	LogIndexRange() (first, last int64, err error)
+	// GetLogs returns the logs matching the addresses and topics within the
+	// [fromBlock, toBlock] range. At most limit logs are returned, unless
+	// limit is 0.
+	GetLogs(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
+
+// AddressTxsQuery defines the block range and the page of an address index
+// query.
+type AddressTxsQuery struct {
+	// FromBlock and ToBlock are the inclusive block range of the query.
+	FromBlock int64
+	ToBlock   int64
+	// After is the last tx of the previous page, the page starts at the
+	// beginning of the range if nil.
+	After *IndexedTx
+	// Reverse returns the txs in descending order.
+	Reverse bool
+	// Limit is the max number of txs in the page, no limit if 0.
+	Limit int
+}
+
+// IndexedTx is the position of an indexed eth tx.
+type IndexedTx struct {
+	Hash       common.Hash
+	Height     int64
+	EthTxIndex int32
 }
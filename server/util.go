@@ -51,10 +51,13 @@ func AddCommands(
 		cometbftCmd,
 		sdkserver.ExportCmd(appExport, opts.DefaultNodeHome),
 		version.NewVersionCommand(),
-		sdkserver.NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
+		NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+
+		// evm mempool commands
+		NewMempoolCmd(),
 	)
 }
 
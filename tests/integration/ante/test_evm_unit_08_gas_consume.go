@@ -199,6 +199,7 @@ func (s *EvmUnitAnteTestSuite) TestConsumeGasAndEmitEvent() {
 				expectedEvent := sdktypes.NewEvent(
 					sdktypes.EventTypeTx,
 					sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+					sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, sender.String()),
 				)
 				// Check events are present
 				events := unitNetwork.GetContext().EventManager().Events()
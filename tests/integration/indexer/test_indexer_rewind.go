@@ -0,0 +1,229 @@
+package indexer
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// indexerWithLogs is an eth tx indexer also indexing the logs
+type indexerWithLogs interface {
+	servertypes.EVMTxIndexer
+	servertypes.EVMLogIndexer
+}
+
+func TestIndexerRewindAndPrune(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+	to := common.BigToAddress(big.NewInt(1))
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// the block at each height holds an eth tx emitting a log
+	const blockCount = 3
+	var (
+		blocks    []*cmttypes.Block
+		txResults [][]*abci.ExecTxResult
+		txHashes  []common.Hash
+	)
+	for height := int64(1); height <= blockCount; height++ {
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    uint64(height), //nolint:gosec // G115 // test heights are positive
+			To:       &to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 21000,
+		})
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		anyValue, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{
+			Hash: txHash.Hex(),
+			Logs: []*types.Log{{Address: to.Hex(), Topics: []string{common.Hash{1}.Hex()}, TxHash: txHash.Hex()}},
+		})
+		require.NoError(t, err)
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{anyValue}})
+		require.NoError(t, err)
+
+		blocks = append(blocks, &cmttypes.Block{
+			Header: cmttypes.Header{Height: height},
+			Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+		})
+		txResults = append(txResults, []*abci.ExecTxResult{{
+			Code:    0,
+			Data:    data,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+			},
+		}})
+		txHashes = append(txHashes, txHash)
+	}
+
+	// sentHeights returns the heights of the indexed eth txs of the sender
+	sentHeights := func(idxer indexerWithLogs) []int64 {
+		txs, err := idxer.GetBySender(from, servertypes.AddressTxsQuery{ToBlock: math.MaxInt64})
+		require.NoError(t, err)
+		var heights []int64
+		for _, tx := range txs {
+			heights = append(heights, tx.Height)
+		}
+		return heights
+	}
+	// logHeights returns the heights of the indexed logs of the recipient
+	logHeights := func(idxer indexerWithLogs) []int64 {
+		logs, err := idxer.GetLogs(0, blockCount, []common.Address{to}, nil, 0)
+		require.NoError(t, err)
+		var heights []int64
+		for _, log := range logs {
+			heights = append(heights, int64(log.BlockNumber)) //nolint:gosec // G115 // test heights are small
+		}
+		return heights
+	}
+
+	// the indexers are returned along with a function corrupting the second
+	// block with entries a reindexing of the block doesn't replace
+	testCases := []struct {
+		name       string
+		newIndexer func(t *testing.T) (indexerWithLogs, func())
+	}{
+		{"kv", func(t *testing.T) (indexerWithLogs, func()) {
+			db := dbm.NewMemDB()
+			corrupt := func() {
+				// a stale sender entry missing from the block keys
+				require.NoError(t, db.Set(indexer.AddressTxKey(indexer.KeyPrefixSender, from, 2, 1), common.Hash{2}.Bytes()))
+			}
+			return indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx).WithLogIndex(), corrupt
+		}},
+		{"sql", func(t *testing.T) (indexerWithLogs, func()) {
+			db := openSQLiteDB(t)
+			idxer, err := indexer.NewSQLIndexer(db, log.NewNopLogger(), clientCtx)
+			require.NoError(t, err)
+			corrupt := func() {
+				// the eth tx of the block indexed at another height
+				for _, table := range []string{"transactions", "receipts", "logs"} {
+					_, err := db.Exec(`UPDATE ` + table + ` SET height = 5 WHERE height = 2`)
+					require.NoError(t, err)
+				}
+			}
+			return idxer, corrupt
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer, corrupt := tc.newIndexer(t)
+			for i, block := range blocks {
+				require.NoError(t, idxer.IndexBlock(block, txResults[i]))
+			}
+			for i, block := range blocks {
+				mismatches, err := indexer.VerifyBlock(clientCtx, log.NewNopLogger(), idxer, block, txResults[i])
+				require.NoError(t, err)
+				require.Empty(t, mismatches)
+			}
+
+			// the block is verified against other results, then reindexed with them
+			otherResults := []*abci.ExecTxResult{{Code: 0, GasUsed: 30000, Events: txResults[1][0].Events}}
+			mismatches, err := indexer.VerifyBlock(clientCtx, log.NewNopLogger(), idxer, blocks[1], otherResults)
+			require.NoError(t, err)
+			require.NotEmpty(t, mismatches)
+			require.NoError(t, idxer.IndexBlock(blocks[1], otherResults))
+			mismatches, err = indexer.VerifyBlock(clientCtx, log.NewNopLogger(), idxer, blocks[1], otherResults)
+			require.NoError(t, err)
+			require.Empty(t, mismatches)
+			require.NoError(t, idxer.IndexBlock(blocks[1], txResults[1]))
+			require.Equal(t, []int64{1, 2, 3}, sentHeights(idxer))
+			require.Equal(t, []int64{1, 2, 3}, logHeights(idxer))
+
+			// the corrupted block is repaired
+			corrupt()
+			mismatches, err = indexer.VerifyBlock(clientCtx, log.NewNopLogger(), idxer, blocks[1], txResults[1])
+			require.NoError(t, err)
+			require.NotEmpty(t, mismatches)
+			require.NoError(t, indexer.RepairBlock(clientCtx, log.NewNopLogger(), idxer, blocks[1], txResults[1]))
+			mismatches, err = indexer.VerifyBlock(clientCtx, log.NewNopLogger(), idxer, blocks[1], txResults[1])
+			require.NoError(t, err)
+			require.Empty(t, mismatches)
+			require.Equal(t, []int64{1, 2, 3}, sentHeights(idxer))
+			require.Equal(t, []int64{1, 2, 3}, logHeights(idxer))
+
+			require.NoError(t, idxer.Rewind(2))
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(2), last)
+			_, err = idxer.GetByTxHash(txHashes[2])
+			require.Error(t, err)
+			_, err = idxer.GetByBlockAndIndex(3, 0)
+			require.Error(t, err)
+			require.Equal(t, []int64{1, 2}, sentHeights(idxer))
+			require.Equal(t, []int64{1, 2}, logHeights(idxer))
+			first, last, err := idxer.LogIndexRange()
+			require.NoError(t, err)
+			require.Equal(t, int64(1), first)
+			require.Equal(t, int64(2), last)
+
+			require.NoError(t, idxer.Prune(2))
+			first, err = idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(2), first)
+			_, err = idxer.GetByTxHash(txHashes[0])
+			require.Error(t, err)
+			res, err := idxer.GetByTxHash(txHashes[1])
+			require.NoError(t, err)
+			require.Equal(t, int64(2), res.Height)
+			require.Equal(t, []int64{2}, sentHeights(idxer))
+			require.Equal(t, []int64{2}, logHeights(idxer))
+			first, last, err = idxer.LogIndexRange()
+			require.NoError(t, err)
+			require.Equal(t, int64(2), first)
+			require.Equal(t, int64(2), last)
+
+			// the remaining block is reindexed after a rewind to the genesis
+			require.NoError(t, idxer.Rewind(0))
+			last, err = idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(-1), last)
+			require.NoError(t, idxer.IndexBlock(blocks[1], txResults[1]))
+			require.Equal(t, []int64{2}, sentHeights(idxer))
+		})
+	}
+}
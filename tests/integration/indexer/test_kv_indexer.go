@@ -14,6 +14,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
@@ -186,6 +187,33 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				expTxs := []servertypes.IndexedTx{{Hash: txHash, Height: 1, EthTxIndex: 0}}
+				sent, err := idxer.GetBySender(from, servertypes.AddressTxsQuery{ToBlock: 1})
+				require.NoError(t, err)
+				require.Equal(t, expTxs, sent)
+				received, err := idxer.GetByRecipient(to, servertypes.AddressTxsQuery{ToBlock: 1, Reverse: true})
+				require.NoError(t, err)
+				require.Equal(t, expTxs, received)
+				received, err = idxer.GetByRecipient(to, servertypes.AddressTxsQuery{FromBlock: 2, ToBlock: 10})
+				require.NoError(t, err)
+				require.Empty(t, received)
+				received, err = idxer.GetByRecipient(to, servertypes.AddressTxsQuery{ToBlock: 1, After: &expTxs[0]})
+				require.NoError(t, err)
+				require.Empty(t, received)
+				created, err := idxer.GetContractCreation(to)
+				require.NoError(t, err)
+				require.Nil(t, created)
+
+				// backfill only indexes the addresses
+				backfilled := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+				require.NoError(t, backfilled.BackfillAddressIndex(tc.block, tc.blockResult))
+				sent, err = backfilled.GetBySender(from, servertypes.AddressTxsQuery{ToBlock: 1})
+				require.NoError(t, err)
+				require.Equal(t, expTxs, sent)
+				last, err = backfilled.LastIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), last)
 			}
 		})
 	}
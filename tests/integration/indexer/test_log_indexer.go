@@ -0,0 +1,121 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKVIndexerLogs(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	addr1, addr2 := common.Address{1}, common.Address{2}
+	topic1, topic2 := common.Hash{1}, common.Hash{2}
+
+	// txResult builds the result of an eth tx emitting the logs
+	txResult := func(logs ...*types.Log) *abci.ExecTxResult {
+		anyValue, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{
+			Hash: common.Hash{byte(len(logs))}.Hex(),
+			Logs: logs,
+		})
+		require.NoError(t, err)
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{anyValue}})
+		require.NoError(t, err)
+		return &abci.ExecTxResult{Code: 0, Data: data}
+	}
+	blocks := map[int64][]*abci.ExecTxResult{
+		1: {txResult(
+			&types.Log{Address: addr1.Hex(), Topics: []string{topic1.Hex()}, Index: 0},
+			&types.Log{Address: addr2.Hex(), Topics: []string{topic2.Hex(), topic1.Hex()}, Index: 1},
+		)},
+		2: {},
+		3: {
+			txResult(&types.Log{Address: addr2.Hex(), Topics: []string{topic1.Hex()}, Index: 0}),
+			txResult(&types.Log{Address: addr1.Hex(), Data: []byte{1}, Index: 1}),
+		},
+	}
+	indexBlock := func(idxer *indexer.KVIndexer, height int64) {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, blocks[height]))
+	}
+
+	// the logs are not indexed if the log index is disabled
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	indexBlock(idxer, 1)
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	idxer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx).WithLogIndex()
+	for height := int64(1); height <= 3; height++ {
+		indexBlock(idxer, height)
+	}
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	// position returns the block number, the first address byte and the
+	// index of the log
+	position := func(log *ethtypes.Log) [3]uint64 {
+		return [3]uint64{log.BlockNumber, uint64(log.Address[0]), uint64(log.Index)}
+	}
+	testCases := []struct {
+		name      string
+		fromBlock int64
+		toBlock   int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expect    [][3]uint64
+	}{
+		{"all logs", 1, 3, nil, nil, 0, [][3]uint64{{1, 1, 0}, {1, 2, 1}, {3, 2, 0}, {3, 1, 1}}},
+		{"limit", 1, 3, nil, nil, 2, [][3]uint64{{1, 1, 0}, {1, 2, 1}}},
+		{"block range", 2, 3, nil, nil, 0, [][3]uint64{{3, 2, 0}, {3, 1, 1}}},
+		{"address", 1, 3, []common.Address{addr1}, nil, 0, [][3]uint64{{1, 1, 0}, {3, 1, 1}}},
+		{"addresses", 1, 3, []common.Address{addr2, addr1}, nil, 0, [][3]uint64{{1, 1, 0}, {1, 2, 1}, {3, 2, 0}, {3, 1, 1}}},
+		{"first topic", 1, 3, nil, [][]common.Hash{{topic1}}, 0, [][3]uint64{{1, 1, 0}, {3, 2, 0}}},
+		{"second topic", 1, 3, nil, [][]common.Hash{{}, {topic1}}, 0, [][3]uint64{{1, 2, 1}}},
+		{"topics alternatives", 1, 3, nil, [][]common.Hash{{topic2, topic1}}, 0, [][3]uint64{{1, 1, 0}, {1, 2, 1}, {3, 2, 0}}},
+		{"address and topic", 1, 3, []common.Address{addr2}, [][]common.Hash{{topic1}}, 0, [][3]uint64{{3, 2, 0}}},
+		{"no match", 1, 3, []common.Address{{3}}, nil, 0, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.fromBlock, tc.toBlock, tc.addresses, tc.topics, tc.limit)
+			require.NoError(t, err)
+			var positions [][3]uint64
+			for _, log := range logs {
+				positions = append(positions, position(log))
+			}
+			require.Equal(t, tc.expect, positions)
+		})
+	}
+
+	// a gap in the indexed blocks starts a new range
+	indexBlock(idxer, 5)
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), first)
+	require.Equal(t, int64(5), last)
+}
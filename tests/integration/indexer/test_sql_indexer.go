@@ -0,0 +1,201 @@
+package indexer
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// openSQLiteDB opens an in-memory SQLite db, with a single connection so the
+// db is shared by all the queries.
+func openSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestSQLIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	})
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	anyValue, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{
+		Hash: txHash.Hex(),
+		Logs: []*types.Log{
+			{Address: to.Hex(), Topics: []string{common.Hash{1}.Hex()}, Data: []byte{1}, TxHash: txHash.Hex(), Index: 0},
+			{Address: to.Hex(), Topics: []string{common.Hash{2}.Hex(), common.Hash{1}.Hex()}, TxHash: txHash.Hex(), Index: 1},
+		},
+	})
+	require.NoError(t, err)
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{anyValue}})
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{
+		Header: cmttypes.Header{Height: 1, Time: time.Unix(100, 0)},
+		Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+	}
+	txResults := []*abci.ExecTxResult{
+		{
+			Code:    0,
+			Data:    data,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+			},
+		},
+	}
+
+	db := openSQLiteDB(t)
+	idxer, err := indexer.NewSQLIndexer(db, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	// the block is indexed twice to check the rows are replaced
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 2}}, nil))
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+
+	expResult := &servertypes.TxResult{Height: 1, GasUsed: 21000, CumulativeGasUsed: 21000}
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, expResult, res)
+	res, err = idxer.GetByBlockAndIndex(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, expResult, res)
+	_, err = idxer.GetByTxHash(common.Hash{1})
+	require.Error(t, err)
+	_, err = idxer.GetByBlockAndIndex(1, 1)
+	require.Error(t, err)
+
+	expTxs := []servertypes.IndexedTx{{Hash: txHash, Height: 1, EthTxIndex: 0}}
+	sent, err := idxer.GetBySender(from, servertypes.AddressTxsQuery{ToBlock: 2})
+	require.NoError(t, err)
+	require.Equal(t, expTxs, sent)
+	received, err := idxer.GetByRecipient(to, servertypes.AddressTxsQuery{ToBlock: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, expTxs, received)
+	received, err = idxer.GetByRecipient(to, servertypes.AddressTxsQuery{FromBlock: 2, ToBlock: 10})
+	require.NoError(t, err)
+	require.Empty(t, received)
+	received, err = idxer.GetByRecipient(to, servertypes.AddressTxsQuery{ToBlock: 2, After: &expTxs[0]})
+	require.NoError(t, err)
+	require.Empty(t, received)
+	created, err := idxer.GetContractCreation(to)
+	require.NoError(t, err)
+	require.Nil(t, created)
+
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(2), last)
+
+	logs, err := idxer.GetLogs(1, 2, nil, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	require.Equal(t, to, logs[0].Address)
+	require.Equal(t, []common.Hash{{1}}, logs[0].Topics)
+	require.Equal(t, []byte{1}, logs[0].Data)
+	require.Equal(t, uint64(1), logs[0].BlockNumber)
+	require.Equal(t, txHash, logs[0].TxHash)
+	require.Equal(t, uint(1), logs[1].Index)
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expIdx    []uint
+	}{
+		{"limit", nil, nil, 1, []uint{0}},
+		{"address", []common.Address{to}, nil, 0, []uint{0, 1}},
+		{"other address", []common.Address{from}, nil, 0, nil},
+		{"first topic", nil, [][]common.Hash{{{1}}}, 0, []uint{0}},
+		{"second topic", nil, [][]common.Hash{{}, {{1}}}, 0, []uint{1}},
+		{"topics alternatives", nil, [][]common.Hash{{{1}, {2}}}, 0, []uint{0, 1}},
+		{"wildcard topic", nil, [][]common.Hash{{}, {}}, 0, []uint{1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(0, 2, tc.addresses, tc.topics, tc.limit)
+			require.NoError(t, err)
+			var indexes []uint
+			for _, log := range logs {
+				indexes = append(indexes, log.Index)
+			}
+			require.Equal(t, tc.expIdx, indexes)
+		})
+	}
+
+	// the migrations are only applied once
+	_, err = indexer.NewSQLIndexer(db, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+}
@@ -0,0 +1,97 @@
+package mempool
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestInspectTxs tests the introspection of the EVM and Cosmos transactions of the mempool
+func (s *IntegrationTestSuite) TestInspectTxs() {
+	mpool := s.evmMempool()
+	key0, key1 := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	baseFee := s.network.App.GetEVMKeeper().GetBaseFee(s.network.GetContext())
+
+	gasPrice := big.NewInt(2000000000)
+	pendingTx := s.createEVMValueTransferTx(key0, 0, gasPrice)
+	gappedTx := s.createEVMValueTransferTx(key0, 2, gasPrice)
+	cosmosTx := s.createCosmosSendTx(key1, gasPrice)
+	s.Require().NoError(s.checkTxs([]sdk.Tx{pendingTx, gappedTx, cosmosTx}))
+
+	evmTxs, err := mpool.InspectEVMTxs()
+	s.Require().NoError(err)
+	s.Require().Len(evmTxs, 2)
+
+	s.Require().Equal(s.ethTx(pendingTx).Hash(), evmTxs[0].Tx.Hash())
+	s.Require().Equal(key0.Addr, evmTxs[0].From)
+	s.Require().True(evmTxs[0].Pending)
+	s.Require().Empty(evmTxs[0].QueuedReason)
+	s.Require().Equal(new(big.Int).Sub(gasPrice, baseFee), evmTxs[0].EffectiveTip)
+
+	s.Require().Equal(s.ethTx(gappedTx).Hash(), evmTxs[1].Tx.Hash())
+	s.Require().False(evmTxs[1].Pending)
+	s.Require().Equal(evmmempool.QueuedReasonNonceGap, evmTxs[1].QueuedReason)
+
+	cosmosTxs, err := mpool.InspectCosmosTxs()
+	s.Require().NoError(err)
+	s.Require().Len(cosmosTxs, 1)
+	s.Require().Equal(s.getTxHash(cosmosTx), common.Bytes2Hex(cosmosTxs[0].Hash))
+	s.Require().Equal(key1.AccAddr, cosmosTxs[0].Signer)
+	s.Require().Equal(gasPrice, cosmosTxs[0].GasPrice)
+	s.Require().Equal(new(big.Int).Sub(gasPrice, baseFee), cosmosTxs[0].EffectiveTip)
+	s.Require().False(cosmosTxs[0].Time.IsZero())
+}
+
+// TestRemoveTxs tests the eviction of transactions by hash and by sender
+func (s *IntegrationTestSuite) TestRemoveTxs() {
+	mpool := s.evmMempool()
+	key0, key1 := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	gasPrice := big.NewInt(2000000000)
+
+	evmTxs := []sdk.Tx{
+		s.createEVMValueTransferTx(key0, 0, gasPrice),
+		s.createEVMValueTransferTx(key0, 1, gasPrice),
+		s.createEVMValueTransferTx(key0, 2, gasPrice),
+	}
+	cosmosTx := s.createCosmosSendTx(key1, gasPrice)
+	s.Require().NoError(s.checkTxs(append(evmTxs, cosmosTx)))
+	s.Require().Equal(4, mpool.CountTx())
+
+	found, err := mpool.RemoveTxByHash(crypto.Keccak256Hash([]byte("unknown")))
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	// the following transactions of the sender are moved to the queue
+	found, err = mpool.RemoveTxByHash(s.ethTx(evmTxs[1]).Hash())
+	s.Require().NoError(err)
+	s.Require().True(found)
+	pending, queued := mpool.GetTxPool().ContentFrom(key0.Addr)
+	s.Require().Len(pending, 1)
+	s.Require().Len(queued, 1)
+
+	// the Cosmos transactions are identified by their CometBFT hash
+	found, err = mpool.RemoveTxByHash(common.HexToHash(s.getTxHash(cosmosTx)))
+	s.Require().NoError(err)
+	s.Require().True(found)
+	cosmosTxs, err := mpool.InspectCosmosTxs()
+	s.Require().NoError(err)
+	s.Require().Empty(cosmosTxs)
+
+	removed, err := mpool.RemoveSenderTxs(key0.Addr)
+	s.Require().NoError(err)
+	s.Require().Equal(2, removed)
+	s.Require().Equal(0, mpool.CountTx())
+
+	// the Cosmos transactions of the sender are removed too
+	key2 := s.keyring.GetKey(2)
+	s.Require().NoError(s.checkTxs([]sdk.Tx{s.createCosmosSendTx(key2, gasPrice)}))
+	removed, err = mpool.RemoveSenderTxs(key2.Addr)
+	s.Require().NoError(err)
+	s.Require().Equal(1, removed)
+	s.Require().Equal(0, mpool.CountTx())
+}
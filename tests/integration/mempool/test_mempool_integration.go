@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/testutil/keyring"
+	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -1279,3 +1280,51 @@ func (s *IntegrationTestSuite) TestNonceGappedEVMTransactions() {
 		})
 	}
 }
+
+// TestPendingState tests the state built by executing the pending EVM
+// transactions of the mempool on top of the latest state
+func (s *IntegrationTestSuite) TestPendingState() {
+	sender, recipient := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	mpool := s.network.App.GetMempool()
+
+	// nonces 0 and 1 are executable while nonce 3 is queued
+	for _, nonce := range []int{0, 1, 3} {
+		tx := s.createEVMValueTransferTx(sender, nonce, big.NewInt(1000000000))
+		s.Require().NoError(mpool.Insert(s.network.GetContext(), tx))
+	}
+
+	ctx := s.network.GetContext()
+	evmKeeper := s.network.App.GetEVMKeeper()
+	pendingCtx, logs, err := evmKeeper.PendingContext(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(logs)
+
+	s.Require().Equal(uint64(0), evmKeeper.GetNonce(ctx, sender.Addr))
+	s.Require().Equal(uint64(2), evmKeeper.GetNonce(pendingCtx, sender.Addr))
+
+	latest := evmKeeper.SpendableCoin(ctx, recipient.Addr)
+	pending := evmKeeper.SpendableCoin(pendingCtx, recipient.Addr)
+	s.Require().Equal(new(big.Int).Add(latest.ToBig(), big.NewInt(2000)), pending.ToBig())
+
+	// the balance query serves the pending state on demand
+	res, err := evmKeeper.Balance(ctx, &evmtypes.QueryBalanceRequest{Address: recipient.Addr.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal(latest.String(), res.Balance)
+	res, err = evmKeeper.Balance(ctx, &evmtypes.QueryBalanceRequest{Address: recipient.Addr.Hex(), Pending: true})
+	s.Require().NoError(err)
+	s.Require().Equal(pending.String(), res.Balance)
+
+	// the shared pending state cannot be altered through the returned context
+	s.Require().NoError(evmKeeper.SetAccount(pendingCtx, sender.Addr, *statedb.NewEmptyAccount()))
+	pendingCtx, _, err = evmKeeper.PendingContext(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), evmKeeper.GetNonce(pendingCtx, sender.Addr))
+
+	// filling the nonce gap changes the pending transactions, so the pending
+	// state is built again
+	tx := s.createEVMValueTransferTx(sender, 2, big.NewInt(1000000000))
+	s.Require().NoError(mpool.Insert(s.network.GetContext(), tx))
+	pendingCtx, _, err = evmKeeper.PendingContext(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(4), evmKeeper.GetNonce(pendingCtx, sender.Addr))
+}
@@ -0,0 +1,359 @@
+package mempool
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestPrivateTransactions tests the insertion and expiry of private EVM transactions
+func (s *IntegrationTestSuite) TestPrivateTransactions() {
+	mpool := s.evmMempool()
+	height := uint64(s.network.GetContext().BlockHeight())
+
+	tx := s.createEVMValueTransferTx(s.keyring.GetKey(0), 0, big.NewInt(1000000000))
+
+	// the max block number must be ahead of the chain head
+	err := mpool.InsertPrivate(s.ethTx(tx), height)
+	s.Require().ErrorIs(err, evmmempool.ErrInvalidBlockNumber)
+	err = mpool.InsertPrivate(s.ethTx(tx), height+evmmempool.MaxPrivateBlocks+1)
+	s.Require().ErrorIs(err, evmmempool.ErrInvalidBlockNumber)
+
+	err = mpool.InsertPrivate(s.ethTx(tx), height+1)
+	s.Require().NoError(err)
+	s.Require().Equal(1, mpool.CountTx())
+
+	// the private transaction is left out of the pool content
+	pending, queued := mpool.Content()
+	s.Require().Empty(pending)
+	s.Require().Empty(queued)
+	pendingFrom, queuedFrom := mpool.ContentFrom(s.keyring.GetKey(0).Addr)
+	s.Require().Empty(pendingFrom)
+	s.Require().Empty(queuedFrom)
+	pending, _ = mpool.GetTxPool().Content()
+	s.Require().Len(pending[s.keyring.GetKey(0).Addr], 1)
+
+	// the private transaction is selected until its max block number
+	iterator := mpool.Select(s.network.GetContext().WithBlockHeight(int64(height+1)), nil)
+	s.Require().NotNil(iterator)
+	s.Require().Equal(s.getTxHash(tx), s.getTxHash(iterator.Tx()))
+
+	iterator = mpool.Select(s.network.GetContext().WithBlockHeight(int64(height+2)), nil)
+	s.Require().Nil(iterator)
+	s.Require().Equal(0, mpool.CountTx())
+
+	// nonce gapped transactions are queued
+	gappedTx := s.createEVMValueTransferTx(s.keyring.GetKey(1), 1, big.NewInt(1000000000))
+	err = mpool.InsertPrivate(s.ethTx(gappedTx), 0)
+	s.Require().NoError(err)
+	err = mpool.InsertPrivate(s.ethTx(gappedTx), 0)
+	s.Require().Error(err, "known transactions are rejected")
+	s.Require().Nil(mpool.Select(s.network.GetContext().WithBlockHeight(int64(height+1)), nil))
+}
+
+// TestPrivateTransactionsNotExposed tests that the private transactions are neither
+// streamed to the pending transaction subscribers nor executed in the pending state
+func (s *IntegrationTestSuite) TestPrivateTransactionsNotExposed() {
+	mpool := s.evmMempool()
+	sender, recipient, other := s.keyring.GetKey(0), s.keyring.GetKey(1), s.keyring.GetKey(2)
+
+	ch := make(chan core.NewTxsEvent, 10)
+	sub := mpool.SubscribeTransactions(ch, false)
+	defer sub.Unsubscribe()
+
+	privateTx := s.createEVMValueTransferTx(sender, 0, big.NewInt(1000000000))
+	s.Require().NoError(mpool.InsertPrivate(s.ethTx(privateTx), 0))
+	followingTx := s.createEVMValueTransferTx(sender, 1, big.NewInt(1000000000))
+	s.Require().NoError(mpool.Insert(s.network.GetContext(), followingTx))
+	publicTx := s.createEVMValueTransferTx(other, 0, big.NewInt(1000000000))
+	s.Require().NoError(mpool.Insert(s.network.GetContext(), publicTx))
+
+	// only the public transactions are streamed
+	streamed := make(map[common.Hash]struct{})
+	for len(streamed) < 2 {
+		select {
+		case ev := <-ch:
+			for _, tx := range ev.Txs {
+				streamed[tx.Hash()] = struct{}{}
+			}
+		case <-time.After(5 * time.Second):
+			s.FailNow("timed out waiting for the pending transactions")
+		}
+	}
+	s.Require().Equal(map[common.Hash]struct{}{
+		s.ethTx(followingTx).Hash(): {},
+		s.ethTx(publicTx).Hash():    {},
+	}, streamed)
+
+	// the private transaction and the following one of its sender are not
+	// executed in the pending state
+	ctx := s.network.GetContext()
+	evmKeeper := s.network.App.GetEVMKeeper()
+	pendingCtx, _, err := evmKeeper.PendingContext(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), evmKeeper.GetNonce(pendingCtx, sender.Addr))
+	s.Require().Equal(uint64(1), evmKeeper.GetNonce(pendingCtx, other.Addr))
+
+	latest := evmKeeper.SpendableCoin(ctx, recipient.Addr)
+	pending := evmKeeper.SpendableCoin(pendingCtx, recipient.Addr)
+	s.Require().Equal(new(big.Int).Add(latest.ToBig(), big.NewInt(1000)), pending.ToBig())
+}
+
+// TestBundles tests that the bundles targeting the proposed block are selected ahead of the pool transactions
+func (s *IntegrationTestSuite) TestBundles() {
+	gasPrice := big.NewInt(1000000000)
+
+	testCases := []struct {
+		name     string
+		setupTxs func(height uint64) ([]*evmmempool.Bundle, []sdk.Tx, []string)
+	}{
+		{
+			name: "bundle transactions are selected first and in order",
+			setupTxs: func(height uint64) ([]*evmmempool.Bundle, []sdk.Tx, []string) {
+				key0, key1, key2 := s.keyring.GetKey(0), s.keyring.GetKey(1), s.keyring.GetKey(2)
+				bundleTxs := []sdk.Tx{
+					s.createEVMValueTransferTx(key0, 0, gasPrice),
+					s.createEVMValueTransferTx(key1, 0, gasPrice),
+					s.createEVMValueTransferTx(key0, 1, gasPrice),
+				}
+				// the pool transactions of the bundle senders are left out
+				poolTxs := []sdk.Tx{
+					s.createEVMValueTransferTx(key0, 0, big.NewInt(5000000000)),
+					s.createEVMValueTransferTx(key2, 0, big.NewInt(5000000000)),
+				}
+				bundles := []*evmmempool.Bundle{s.bundle(height+1, bundleTxs...)}
+				return bundles, poolTxs, s.getTxHashes(append(bundleTxs, poolTxs[1]))
+			},
+		},
+		{
+			name: "bundles with an invalid transaction are skipped",
+			setupTxs: func(height uint64) ([]*evmmempool.Bundle, []sdk.Tx, []string) {
+				key0, key1 := s.keyring.GetKey(0), s.keyring.GetKey(1)
+				validTx := s.createEVMValueTransferTx(key1, 0, gasPrice)
+				bundles := []*evmmempool.Bundle{
+					s.bundle(height+1,
+						s.createEVMValueTransferTx(key0, 0, gasPrice),
+						s.createEVMValueTransferTx(key0, 2, gasPrice),
+					),
+					s.bundle(height+1, validTx),
+				}
+				poolTx := s.createEVMValueTransferTx(key0, 0, gasPrice)
+				return bundles, []sdk.Tx{poolTx}, s.getTxHashes([]sdk.Tx{validTx, poolTx})
+			},
+		},
+		{
+			name: "bundles are checked on top of each other",
+			setupTxs: func(height uint64) ([]*evmmempool.Bundle, []sdk.Tx, []string) {
+				key0 := s.keyring.GetKey(0)
+				tx := s.createEVMValueTransferTx(key0, 0, gasPrice)
+				bundles := []*evmmempool.Bundle{
+					s.bundle(height+1, tx),
+					s.bundle(height+1, tx, s.createEVMValueTransferTx(key0, 1, gasPrice)),
+				}
+				return bundles, nil, s.getTxHashes([]sdk.Tx{tx})
+			},
+		},
+		{
+			name: "bundles of other blocks are not selected",
+			setupTxs: func(height uint64) ([]*evmmempool.Bundle, []sdk.Tx, []string) {
+				poolTx := s.createEVMValueTransferTx(s.keyring.GetKey(0), 0, gasPrice)
+				bundles := []*evmmempool.Bundle{
+					s.bundle(height+2, s.createEVMValueTransferTx(s.keyring.GetKey(1), 0, gasPrice)),
+				}
+				return bundles, []sdk.Tx{poolTx}, s.getTxHashes([]sdk.Tx{poolTx})
+			},
+		},
+		{
+			name: "bundles out of their time range are not selected",
+			setupTxs: func(height uint64) ([]*evmmempool.Bundle, []sdk.Tx, []string) {
+				bundle := s.bundle(height+1, s.createEVMValueTransferTx(s.keyring.GetKey(1), 0, gasPrice))
+				bundle.MinTimestamp = uint64(s.network.GetContext().BlockTime().Unix()) + 3600
+				return []*evmmempool.Bundle{bundle}, nil, []string{}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Clean up previous test's resources before resetting
+			s.TearDownTest()
+			// Reset test setup to ensure clean state
+			s.SetupTest()
+
+			mpool := s.evmMempool()
+			height := uint64(s.network.GetContext().BlockHeight())
+			bundles, poolTxs, expTxHashes := tc.setupTxs(height)
+			for _, bundle := range bundles {
+				_, err := mpool.InsertBundle(bundle)
+				s.Require().NoError(err)
+			}
+			s.Require().NoError(s.checkTxs(poolTxs))
+
+			ctx := s.network.GetContext().WithBlockHeight(int64(height + 1))
+			txHashes := make([]string, 0)
+			for iterator := mpool.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+				txHashes = append(txHashes, s.getTxHash(iterator.Tx()))
+			}
+			s.Require().Equal(expTxHashes, txHashes)
+		})
+	}
+}
+
+// TestBundleLimits tests that the bundles exceeding the bundle limits are rejected
+func (s *IntegrationTestSuite) TestBundleLimits() {
+	gasPrice := big.NewInt(1000000000)
+
+	testCases := []struct {
+		name string
+		test func(mpool *evmmempool.ExperimentalEVMMempool, height uint64)
+	}{
+		{
+			name: "bundles with too many transactions are rejected",
+			test: func(mpool *evmmempool.ExperimentalEVMMempool, height uint64) {
+				txs := make([]sdk.Tx, 0, evmmempool.MaxBundleTxs+1)
+				for nonce := 0; nonce <= evmmempool.MaxBundleTxs; nonce++ {
+					txs = append(txs, s.createEVMValueTransferTx(s.keyring.GetKey(0), nonce, gasPrice))
+				}
+				_, err := mpool.InsertBundle(s.bundle(height+1, txs...))
+				s.Require().ErrorIs(err, evmmempool.ErrInvalidBundle)
+
+				_, err = mpool.InsertBundle(s.bundle(height+1, txs[:evmmempool.MaxBundleTxs]...))
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name: "bundles targeting a block are limited",
+			test: func(mpool *evmmempool.ExperimentalEVMMempool, height uint64) {
+				for i := 0; i < evmmempool.MaxBundlesPerBlock; i++ {
+					_, err := mpool.InsertBundle(s.bundle(height+1, s.createEVMValueTransferTx(s.keyring.GetKey(i), 0, gasPrice)))
+					s.Require().NoError(err)
+				}
+				tx := s.createEVMValueTransferTx(s.keyring.GetKey(evmmempool.MaxBundlesPerBlock), 0, gasPrice)
+				_, err := mpool.InsertBundle(s.bundle(height+1, tx))
+				s.Require().ErrorIs(err, evmmempool.ErrBundleLimit)
+
+				// known bundles and other blocks are not affected
+				_, err = mpool.InsertBundle(s.bundle(height+1, s.createEVMValueTransferTx(s.keyring.GetKey(0), 0, gasPrice)))
+				s.Require().NoError(err)
+				_, err = mpool.InsertBundle(s.bundle(height+2, tx))
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name: "bundles holding transactions of a sender are limited",
+			test: func(mpool *evmmempool.ExperimentalEVMMempool, height uint64) {
+				key0, key1 := s.keyring.GetKey(0), s.keyring.GetKey(1)
+				for i := 0; i < evmmempool.MaxBundlesPerSender; i++ {
+					_, err := mpool.InsertBundle(s.bundle(height+1+uint64(i), s.createEVMValueTransferTx(key0, i, gasPrice)))
+					s.Require().NoError(err)
+				}
+				bundle := s.bundle(height+1,
+					s.createEVMValueTransferTx(key1, 0, gasPrice),
+					s.createEVMValueTransferTx(key0, evmmempool.MaxBundlesPerSender, gasPrice),
+				)
+				_, err := mpool.InsertBundle(bundle)
+				s.Require().ErrorIs(err, evmmempool.ErrBundleLimit)
+
+				_, err = mpool.InsertBundle(s.bundle(height+1, s.createEVMValueTransferTx(key1, 0, gasPrice)))
+				s.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Clean up previous test's resources before resetting
+			s.TearDownTest()
+			// Reset test setup to ensure clean state
+			s.SetupTest()
+
+			tc.test(s.evmMempool(), uint64(s.network.GetContext().BlockHeight()))
+		})
+	}
+}
+
+// TestBundlesWithABCIMethodCalls tests that PrepareProposal includes the bundles targeting the proposed block
+func (s *IntegrationTestSuite) TestBundlesWithABCIMethodCalls() {
+	mpool := s.evmMempool()
+	height := uint64(s.network.GetContext().BlockHeight())
+
+	key0, key1 := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	bundleTxs := []sdk.Tx{
+		s.createEVMValueTransferTx(key0, 0, big.NewInt(1000000000)),
+		s.createEVMValueTransferTx(key0, 1, big.NewInt(1000000000)),
+	}
+	poolTx := s.createEVMValueTransferTx(key1, 0, big.NewInt(5000000000))
+
+	bundle := s.bundle(0, bundleTxs...)
+	bundleHash, err := mpool.InsertBundle(bundle)
+	s.Require().NoError(err)
+	s.Require().Equal(bundle.Hash(), bundleHash)
+	s.Require().Equal(height+1, bundle.BlockNumber, "the bundle targets the next block by default")
+	s.Require().NoError(s.checkTxs([]sdk.Tx{poolTx}))
+
+	// Call FinalizeBlock to make finalizeState before calling PrepareProposal
+	_, err = s.network.FinalizeBlock()
+	s.Require().NoError(err)
+
+	prepareProposalRes, err := s.network.App.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes: 1_000_000,
+		Height:     int64(height + 1),
+		Time:       s.network.GetContext().BlockTime(),
+	})
+	s.Require().NoError(err)
+
+	txHashes := make([]string, 0)
+	for _, txBytes := range prepareProposalRes.Txs {
+		txHashes = append(txHashes, hex.EncodeToString(tmhash.Sum(txBytes)))
+	}
+	s.Require().Equal(s.getTxHashes(append(bundleTxs, poolTx)), txHashes)
+
+	// the bundle is left out as a whole if its transactions don't all fit
+	prepareProposalRes, err = s.network.App.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes: cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(prepareProposalRes.Txs[:2])) - 1,
+		Height:     int64(height + 1),
+		Time:       s.network.GetContext().BlockTime(),
+	})
+	s.Require().NoError(err)
+
+	txHashes = make([]string, 0)
+	for _, txBytes := range prepareProposalRes.Txs {
+		txHashes = append(txHashes, hex.EncodeToString(tmhash.Sum(txBytes)))
+	}
+	s.Require().Equal(s.getTxHashes([]sdk.Tx{poolTx}), txHashes)
+}
+
+// evmMempool returns the EVM mempool of the app
+func (s *IntegrationTestSuite) evmMempool() *evmmempool.ExperimentalEVMMempool {
+	mpool, ok := s.network.App.GetMempool().(*evmmempool.ExperimentalEVMMempool)
+	s.Require().True(ok)
+	return mpool
+}
+
+// ethTx returns the EVM transaction of the Cosmos transaction
+func (s *IntegrationTestSuite) ethTx(tx sdk.Tx) *ethtypes.Transaction {
+	msg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	s.Require().True(ok)
+	return msg.AsTransaction()
+}
+
+// bundle creates a bundle of the EVM transactions targeting the block number
+func (s *IntegrationTestSuite) bundle(blockNumber uint64, txs ...sdk.Tx) *evmmempool.Bundle {
+	bundle := &evmmempool.Bundle{BlockNumber: blockNumber}
+	for _, tx := range txs {
+		bundle.Txs = append(bundle.Txs, s.ethTx(tx))
+	}
+	return bundle
+}
@@ -0,0 +1,81 @@
+package mempool
+
+import (
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// TestSponsoredEVMTransaction tests that the EVM transaction of a sender
+// without funds, whose fees are paid by a fee granter, is accepted by the
+// mempool and selected for the proposal along with its fee grant.
+func (s *IntegrationTestSuite) TestSponsoredEVMTransaction() {
+	granter := s.keyring.GetKey(1)
+	senderAddr, senderPriv := utiltx.NewAccAddressAndKey()
+	recipient := s.keyring.GetKey(2).Addr
+	denom := evmtypes.GetEVMCoinDenom()
+
+	spendLimit := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1e18)))
+	msgGrant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, granter.AccAddr, senderAddr)
+	s.Require().NoError(err)
+	res, err := s.factory.CommitCosmosTx(granter.Priv, commonfactory.CosmosTxArgs{Msgs: []sdk.Msg{msgGrant}})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), res.Log)
+	s.notifyNewBlockToMempool()
+
+	msg, err := s.factory.GenerateSignedMsgEthereumTx(senderPriv, evmtypes.EvmTxArgs{
+		Nonce:    0,
+		To:       &recipient,
+		GasLimit: TxGas,
+		GasPrice: big.NewInt(1e9),
+	})
+	s.Require().NoError(err)
+	txBuilder := s.network.App.GetTxConfig().NewTxBuilder()
+	_, err = msg.BuildTx(txBuilder, denom)
+	s.Require().NoError(err)
+	sig, err := granter.Priv.Sign(evmtypes.FeeGrantSignBytes(msg.AsTransaction().Hash()))
+	s.Require().NoError(err)
+	s.Require().NoError(evmtypes.SetFeeGrant(txBuilder, granter.AccAddr, signing.SignatureV2{
+		PubKey: granter.Priv.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+	}))
+	tx := txBuilder.GetTx()
+
+	// the sender balance isn't checked against the fees paid by the granter
+	s.Require().True(s.GetAllBalances(senderAddr).IsZero())
+	s.Require().NoError(s.checkTxs([]sdk.Tx{tx}))
+	s.Require().Equal(1, s.network.App.GetMempool().CountTx())
+
+	// nor once the pool is reset
+	s.notifyNewBlockToMempool()
+	s.Require().Equal(1, s.network.App.GetMempool().CountTx())
+
+	// the transaction is still sponsored once selected for the proposal
+	_, err = s.network.FinalizeBlock()
+	s.Require().NoError(err)
+	proposal, err := s.network.App.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes: 1_000_000,
+		Height:     1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(proposal.Txs, 1)
+	proposed, err := s.network.App.GetTxConfig().TxDecoder()(proposal.Txs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(msg.Hash(), proposed.GetMsgs()[0].(*evmtypes.MsgEthereumTx).Hash())
+	s.Require().Equal(granter.AccAddr, sdk.AccAddress(proposed.(sdk.FeeTx).FeeGranter()))
+
+	blockRes, err := s.network.NextBlockWithTxs(proposal.Txs...)
+	s.Require().NoError(err)
+	s.Require().True(blockRes.TxResults[0].IsOK(), blockRes.TxResults[0].Log)
+	s.Require().True(s.GetAllBalances(senderAddr).IsZero())
+}
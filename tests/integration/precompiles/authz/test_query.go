@@ -0,0 +1,233 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[authz.GrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expMsgTypes []string
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"pass - no grant for the msg type url",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, delegateMsgTypeURL, query.PageRequest{}}
+			},
+			[]string{},
+			false,
+			"",
+		},
+		{
+			"pass - grant for the msg type url",
+			func() []interface{} {
+				s.grantGenericAuthorization(s.network.GetContext(), granter, grantee, delegateMsgTypeURL)
+				s.grantGenericAuthorization(s.network.GetContext(), granter, grantee, setWithdrawAddressMsgTypeURL)
+				return []interface{}{granter.Addr, grantee.Addr, delegateMsgTypeURL, query.PageRequest{}}
+			},
+			[]string{delegateMsgTypeURL},
+			false,
+			"",
+		},
+		{
+			"pass - all the grants",
+			func() []interface{} {
+				s.grantGenericAuthorization(s.network.GetContext(), granter, grantee, delegateMsgTypeURL)
+				s.grantGenericAuthorization(s.network.GetContext(), granter, grantee, setWithdrawAddressMsgTypeURL)
+				return []interface{}{granter.Addr, grantee.Addr, "", query.PageRequest{}}
+			},
+			[]string{delegateMsgTypeURL, setWithdrawAddressMsgTypeURL},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+
+			bz, err := s.precompile.Grants(s.network.GetContext(), &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+				s.Require().NoError(err)
+				msgTypes := make([]string, len(out.Grants))
+				for i, grant := range out.Grants {
+					s.Require().Equal(granter.Addr, grant.Granter)
+					s.Require().Equal(grantee.Addr, grant.Grantee)
+					s.Require().Equal(sdk.MsgTypeURL(&authztypes.GenericAuthorization{}), grant.AuthorizationType)
+					s.Require().Zero(grant.Expiration)
+					msgTypes[i] = grant.MsgTypeUrl
+				}
+				s.Require().ElementsMatch(tc.expMsgTypes, msgTypes)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	var granter testkeyring.Key
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"pass - no grants",
+			func() []interface{} {
+				return []interface{}{granter.Addr, query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+		{
+			"pass - grants to several grantees",
+			func() []interface{} {
+				s.grantGenericAuthorization(s.network.GetContext(), granter, s.keyring.GetKey(1), delegateMsgTypeURL)
+				s.grantGenericAuthorization(s.network.GetContext(), granter, s.keyring.GetKey(2), delegateMsgTypeURL)
+				s.grantGenericAuthorization(s.network.GetContext(), s.keyring.GetKey(1), s.keyring.GetKey(2), delegateMsgTypeURL)
+				return []interface{}{granter.Addr, query.PageRequest{CountTotal: true}}
+			},
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+
+			bz, err := s.precompile.GranterGrants(s.network.GetContext(), &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Len(out.Grants, tc.expGrants)
+				for _, grant := range out.Grants {
+					s.Require().Equal(granter.Addr, grant.Granter)
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranteeGrants() {
+	var grantee testkeyring.Key
+	method := s.precompile.Methods[authz.GranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"pass - no grants",
+			func() []interface{} {
+				return []interface{}{grantee.Addr, query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+		{
+			"pass - grants from several granters",
+			func() []interface{} {
+				s.grantGenericAuthorization(s.network.GetContext(), s.keyring.GetKey(0), grantee, delegateMsgTypeURL)
+				s.grantGenericAuthorization(s.network.GetContext(), s.keyring.GetKey(1), grantee, delegateMsgTypeURL)
+				s.grantGenericAuthorization(s.network.GetContext(), s.keyring.GetKey(0), s.keyring.GetKey(1), delegateMsgTypeURL)
+				return []interface{}{grantee.Addr, query.PageRequest{CountTotal: true}}
+			},
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			grantee = s.keyring.GetKey(2)
+
+			bz, err := s.precompile.GranteeGrants(s.network.GetContext(), &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Len(out.Grants, tc.expGrants)
+				for _, grant := range out.Grants {
+					s.Require().Equal(grantee.Addr, grant.Grantee)
+				}
+			}
+		})
+	}
+}
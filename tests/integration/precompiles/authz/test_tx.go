@@ -0,0 +1,520 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/precompiles/testutil"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	delegateMsgTypeURL           = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	setWithdrawAddressMsgTypeURL = sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{})
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[authz.GrantMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{grantee.Addr, granter.Addr, delegateMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, delegateMsgTypeURL, ctx.BlockTime().Add(-1).Unix()}
+			},
+			func() {},
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"fail - grant to self",
+			func() []interface{} {
+				return []interface{}{granter.Addr, granter.Addr, delegateMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"grantee and granter should be different",
+		},
+		{
+			"pass - generic authorization",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, delegateMsgTypeURL, int64(0)}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, delegateMsgTypeURL)
+				s.Require().Equal(authztypes.NewGenericAuthorization(delegateMsgTypeURL), authorization)
+				s.Require().Nil(expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - generic authorization with expiration",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, delegateMsgTypeURL, ctx.BlockTime().Add(time.Hour).Unix()}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, delegateMsgTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), expiration.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.Grant(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.precompile.Events[authz.EventTypeGrant].ID, logs[0].Topics[0])
+				s.Require().Equal(common.BytesToHash(granter.Addr.Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(grantee.Addr.Bytes()), logs[0].Topics[2])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSendAuthorization() {
+	var ctx sdk.Context
+	var granter, grantee, receiver testkeyring.Key
+	method := s.precompile.Methods[authz.GrantSendAuthorizationMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - zero spend limit",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(0)}}, []common.Address{}, int64(0)}
+			},
+			func() {},
+			true,
+			"spend limit must be positive",
+		},
+		{
+			"pass - send authorization with allow list",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, []common.Address{receiver.Addr}, int64(0)}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+				sendAuthorization, ok := authorization.(*banktypes.SendAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(big.NewInt(1e18), sendAuthorization.SpendLimit.AmountOf(s.bondDenom).BigInt())
+				s.Require().Equal([]string{receiver.AccAddr.String()}, sendAuthorization.AllowList)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			receiver = s.keyring.GetKey(2)
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.GrantSendAuthorization(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantStakeAuthorization() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[authz.GrantStakeAuthorizationMethod]
+	delegate := uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - unspecified authorization type",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, uint8(0), []string{}, []string{}, big.NewInt(0), int64(0)}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(authz.ErrInvalidStakeAuthorizationType, uint8(0)),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				valAddr := s.network.GetValidators()[0].OperatorAddress
+				return []interface{}{grantee.Addr, granter.Addr, delegate, []string{valAddr}, []string{}, big.NewInt(0), int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"pass - delegate authorization with max tokens",
+			func() []interface{} {
+				valAddr := s.network.GetValidators()[0].OperatorAddress
+				return []interface{}{granter.Addr, grantee.Addr, delegate, []string{valAddr}, []string{}, big.NewInt(1e18), int64(0)}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, delegateMsgTypeURL)
+				stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, stakeAuthorization.AuthorizationType)
+				s.Require().Equal(s.bondDenom, stakeAuthorization.MaxTokens.Denom)
+				s.Require().Equal(big.NewInt(1e18), stakeAuthorization.MaxTokens.Amount.BigInt())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.GrantStakeAuthorization(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - no grant to revoke",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, delegateMsgTypeURL}
+			},
+			true,
+			"authorization not found",
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				s.grantGenericAuthorization(ctx, grantee, granter, delegateMsgTypeURL)
+				return []interface{}{grantee.Addr, granter.Addr, delegateMsgTypeURL}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"pass - revoke an existing grant",
+			func() []interface{} {
+				s.grantGenericAuthorization(ctx, granter, grantee, delegateMsgTypeURL)
+				return []interface{}{granter.Addr, grantee.Addr, delegateMsgTypeURL}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.Revoke(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, delegateMsgTypeURL)
+				s.Require().Nil(authorization)
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.precompile.Events[authz.EventTypeRevoke].ID, logs[0].Topics[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[authz.ExecMethod]
+	stakingAddr := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+	distributionAddr := common.HexToAddress(evmtypes.DistributionPrecompileAddress)
+	amount := big.NewInt(1e18)
+
+	delegateCall := func() authz.PrecompileCall {
+		input, err := stakingprecompile.ABI.Pack(stakingprecompile.DelegateMethod, granter.Addr, s.network.GetValidators()[0].OperatorAddress, amount)
+		s.Require().NoError(err)
+		return authz.PrecompileCall{Precompile: stakingAddr, Input: input}
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(logsCount int)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(int) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty calls",
+			func() []interface{} {
+				return []interface{}{grantee.Addr, []authz.PrecompileCall{}}
+			},
+			func(int) {},
+			true,
+			authz.ErrEmptyCalls.Error(),
+		},
+		{
+			"fail - grantee is not the caller",
+			func() []interface{} {
+				return []interface{}{granter.Addr, []authz.PrecompileCall{delegateCall()}}
+			},
+			func(int) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - unsupported precompile",
+			func() []interface{} {
+				call := delegateCall()
+				call.Precompile = common.HexToAddress(evmtypes.BankPrecompileAddress)
+				return []interface{}{grantee.Addr, []authz.PrecompileCall{call}}
+			},
+			func(int) {},
+			true,
+			fmt.Sprintf(authz.ErrUnsupportedPrecompile, common.HexToAddress(evmtypes.BankPrecompileAddress)),
+		},
+		{
+			"fail - no grant",
+			func() []interface{} {
+				return []interface{}{grantee.Addr, []authz.PrecompileCall{delegateCall()}}
+			},
+			func(int) {},
+			true,
+			"authorization not found",
+		},
+		{
+			"fail - delegation above the max tokens of the stake authorization",
+			func() []interface{} {
+				s.grantDelegateAuthorization(ctx, granter, grantee, big.NewInt(1))
+				return []interface{}{grantee.Addr, []authz.PrecompileCall{delegateCall()}}
+			},
+			func(int) {},
+			true,
+			"negative coin amount",
+		},
+		{
+			"pass - delegate with a stake authorization",
+			func() []interface{} {
+				s.grantDelegateAuthorization(ctx, granter, grantee, new(big.Int).Mul(amount, big.NewInt(2)))
+				return []interface{}{grantee.Addr, []authz.PrecompileCall{delegateCall()}}
+			},
+			func(logsCount int) {
+				valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+				s.Require().NoError(err)
+				delegation, err := s.network.App.GetStakingKeeper().GetDelegation(ctx, granter.AccAddr, valAddr)
+				s.Require().NoError(err)
+				s.Require().True(delegation.Shares.IsPositive())
+
+				// the max tokens of the authorization are decreased by the delegated amount
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, delegateMsgTypeURL)
+				stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(amount, stakeAuthorization.MaxTokens.Amount.BigInt())
+				s.Require().Equal(1, logsCount)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - multiple messages with generic authorizations",
+			func() []interface{} {
+				s.grantGenericAuthorization(ctx, granter, grantee, delegateMsgTypeURL)
+				s.grantGenericAuthorization(ctx, granter, grantee, setWithdrawAddressMsgTypeURL)
+				input, err := distprecompile.ABI.Pack(distprecompile.SetWithdrawAddressMethod, granter.Addr, grantee.AccAddr.String())
+				s.Require().NoError(err)
+				return []interface{}{grantee.Addr, []authz.PrecompileCall{
+					delegateCall(),
+					{Precompile: distributionAddr, Input: input},
+				}}
+			},
+			func(logsCount int) {
+				withdrawAddr, err := s.network.App.GetDistrKeeper().GetDelegatorWithdrawAddr(ctx, granter.AccAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(grantee.AccAddr, withdrawAddr)
+				s.Require().Equal(2, logsCount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, grantee.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.Exec(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(len(stateDB.Logs()))
+			}
+		})
+	}
+}
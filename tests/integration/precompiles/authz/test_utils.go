@@ -0,0 +1,39 @@
+package authz
+
+import (
+	"math/big"
+
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// grantGenericAuthorization saves a generic authorization without expiration
+// from the granter to the grantee.
+func (s *PrecompileTestSuite) grantGenericAuthorization(ctx sdk.Context, granter, grantee testkeyring.Key, msgTypeURL string) {
+	err := s.network.App.GetAuthzKeeper().SaveGrant(ctx, grantee.AccAddr, granter.AccAddr, authztypes.NewGenericAuthorization(msgTypeURL), nil)
+	s.Require().NoError(err)
+}
+
+// grantDelegateAuthorization saves a stake authorization to delegate up to the
+// max tokens to any validator from the granter to the grantee.
+func (s *PrecompileTestSuite) grantDelegateAuthorization(ctx sdk.Context, granter, grantee testkeyring.Key, maxTokens *big.Int) {
+	valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+	s.Require().NoError(err)
+
+	maxCoin := sdk.NewCoin(s.bondDenom, math.NewIntFromBigInt(maxTokens))
+	authorization, err := stakingtypes.NewStakeAuthorization(
+		[]sdk.ValAddress{valAddr},
+		nil,
+		stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+		&maxCoin,
+	)
+	s.Require().NoError(err)
+
+	err = s.network.App.GetAuthzKeeper().SaveGrant(ctx, grantee.AccAddr, granter.AccAddr, authorization, nil)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,293 @@
+package bank
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// unpairedDenom is a native denomination without a registered ERC-20 token pair.
+const unpairedDenom = "unpaired"
+
+func (s *PrecompileTestSuite) TestSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[bank.SendMethod]
+	receiver := utiltx.GenerateAddress()
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(logsCount int)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(int) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid receiver address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.tokenDenom, amount}
+			},
+			func(int) {},
+			true,
+			"invalid to address",
+		},
+		{
+			"fail - invalid denom",
+			func() []interface{} {
+				return []interface{}{receiver, "", amount}
+			},
+			func(int) {},
+			true,
+			"invalid coin",
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{receiver, s.tokenDenom, big.NewInt(0)}
+			},
+			func(int) {},
+			true,
+			"amount must be positive",
+		},
+		{
+			"fail - blocked receiver address",
+			func() []interface{} {
+				return []interface{}{common.BytesToAddress(authtypes.NewModuleAddress(minttypes.ModuleName)), s.tokenDenom, amount}
+			},
+			func(int) {},
+			true,
+			"is not allowed to receive funds",
+		},
+		{
+			"fail - send disabled for denom",
+			func() []interface{} {
+				s.network.App.GetBankKeeper().SetSendEnabled(ctx, s.tokenDenom, false)
+				return []interface{}{receiver, s.tokenDenom, amount}
+			},
+			func(int) {},
+			true,
+			"transfers are currently disabled",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{receiver, unpairedDenom, amount}
+			},
+			func(int) {},
+			true,
+			"insufficient funds",
+		},
+		{
+			"pass - send coin with a registered token pair",
+			func() []interface{} {
+				return []interface{}{receiver, s.tokenDenom, amount}
+			},
+			func(logsCount int) {
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), s.tokenDenom)
+				s.Require().Equal(amount, balance.Amount.BigInt())
+				// bank and ERC-20 Transfer events
+				s.Require().Equal(2, logsCount)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - send coin without a registered token pair",
+			func() []interface{} {
+				ctx = s.mintAndSendCoin(ctx, unpairedDenom, s.keyring.GetAccAddr(0), math.NewIntFromBigInt(amount))
+				return []interface{}{receiver, unpairedDenom, amount}
+			},
+			func(logsCount int) {
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), unpairedDenom)
+				s.Require().Equal(amount, balance.Amount.BigInt())
+				// only the bank Transfer event
+				s.Require().Equal(1, logsCount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(len(stateDB.Logs()))
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	receivers := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{}}
+			},
+			func() {},
+			true,
+			bank.ErrEmptyOutputs.Error(),
+		},
+		{
+			"fail - invalid output",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Denom: s.tokenDenom, Amount: amount},
+					{To: receivers[1], Denom: s.tokenDenom, Amount: big.NewInt(0)},
+				}}
+			},
+			func() {},
+			true,
+			"amount must be positive",
+		},
+		{
+			"fail - blocked receiver address in outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Denom: s.tokenDenom, Amount: amount},
+					{To: common.BytesToAddress(authtypes.NewModuleAddress(minttypes.ModuleName)), Denom: s.tokenDenom, Amount: amount},
+				}}
+			},
+			func() {},
+			true,
+			"is not allowed to receive funds",
+		},
+		{
+			"pass - send to multiple receivers",
+			func() []interface{} {
+				ctx = s.mintAndSendCoin(ctx, unpairedDenom, s.keyring.GetAccAddr(0), math.NewIntFromBigInt(amount))
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Denom: s.tokenDenom, Amount: amount},
+					{To: receivers[1], Denom: unpairedDenom, Amount: amount},
+				}}
+			},
+			func() {
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, receivers[0].Bytes(), s.tokenDenom)
+				s.Require().Equal(amount, balance.Amount.BigInt())
+				balance = s.network.App.GetBankKeeper().GetBalance(ctx, receivers[1].Bytes(), unpairedDenom)
+				s.Require().Equal(amount, balance.Amount.BigInt())
+				// the additional output is charged on top of the required gas
+				s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(bank.GasSend))
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.MultiSend(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSendTransferEvents() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	stateDB := s.network.GetStateDB()
+	method := s.precompile.Methods[bank.SendMethod]
+	from := s.keyring.GetAddr(0)
+	receiver := utiltx.GenerateAddress()
+	amount := big.NewInt(1e18)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, from, s.precompile.Address(), 200000)
+	_, err := s.precompile.Send(ctx, contract, stateDB, &method, []interface{}{receiver, s.tokenDenom, amount})
+	s.Require().NoError(err)
+
+	logs := stateDB.Logs()
+	s.Require().Len(logs, 2)
+
+	bankEvent := s.precompile.Events[bank.EventTypeTransfer]
+	s.Require().Equal(s.precompile.Address(), logs[0].Address)
+	s.Require().Equal(bankEvent.ID, logs[0].Topics[0])
+	s.Require().Equal(common.BytesToHash(from.Bytes()), logs[0].Topics[1])
+	s.Require().Equal(common.BytesToHash(receiver.Bytes()), logs[0].Topics[2])
+
+	var bankTransfer struct {
+		Denom  string
+		Amount *big.Int
+	}
+	err = s.precompile.UnpackIntoInterface(&bankTransfer, bank.EventTypeTransfer, logs[0].Data)
+	s.Require().NoError(err)
+	s.Require().Equal(s.tokenDenom, bankTransfer.Denom)
+	s.Require().Equal(amount, bankTransfer.Amount)
+
+	erc20Event := erc20.ABI.Events[erc20.EventTypeTransfer]
+	s.Require().Equal(s.xmplAddr, logs[1].Address)
+	s.Require().Equal(erc20Event.ID, logs[1].Topics[0])
+	s.Require().Equal(common.BytesToHash(from.Bytes()), logs[1].Topics[1])
+	s.Require().Equal(common.BytesToHash(receiver.Bytes()), logs[1].Topics[2])
+	s.Require().Equal(common.BigToHash(amount).Bytes(), logs[1].Data)
+}
@@ -0,0 +1,210 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(allowance feegrant.AllowanceData)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(feegrant.AllowanceData) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"pass - no allowance",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr}
+			},
+			func(allowance feegrant.AllowanceData) {
+				s.Require().Equal(granter.Addr, allowance.Granter)
+				s.Require().Equal(grantee.Addr, allowance.Grantee)
+				s.Require().Empty(allowance.AllowanceType)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - basic allowance",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, granter, grantee, 1e18)
+				return []interface{}{granter.Addr, grantee.Addr}
+			},
+			func(allowance feegrant.AllowanceData) {
+				s.Require().Equal(granter.Addr, allowance.Granter)
+				s.Require().Equal(grantee.Addr, allowance.Grantee)
+				s.Require().Equal(sdk.MsgTypeURL(&feegranttypes.BasicAllowance{}), allowance.AllowanceType)
+				s.Require().Equal([]cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, allowance.SpendLimit)
+				s.Require().Zero(allowance.Expiration)
+				s.Require().Zero(allowance.Period)
+				s.Require().Empty(allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - periodic allowance",
+			func() []interface{} {
+				s.grantPeriodicAllowance(ctx, granter, grantee, time.Hour, 1e18)
+				return []interface{}{granter.Addr, grantee.Addr}
+			},
+			func(allowance feegrant.AllowanceData) {
+				periodLimit := []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}
+				s.Require().Equal(sdk.MsgTypeURL(&feegranttypes.PeriodicAllowance{}), allowance.AllowanceType)
+				s.Require().Empty(allowance.SpendLimit)
+				s.Require().Equal(int64(3600), allowance.Period)
+				s.Require().Equal(periodLimit, allowance.PeriodSpendLimit)
+				s.Require().Equal(periodLimit, allowance.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), allowance.PeriodReset)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - allowed messages allowance",
+			func() []interface{} {
+				allowance, err := feegranttypes.NewAllowedMsgAllowance(&feegranttypes.BasicAllowance{}, []string{ethereumTxMsgTypeURL})
+				s.Require().NoError(err)
+				err = s.network.App.GetFeeGrantKeeper().GrantAllowance(ctx, granter.AccAddr, grantee.AccAddr, allowance)
+				s.Require().NoError(err)
+				return []interface{}{granter.Addr, grantee.Addr}
+			},
+			func(allowance feegrant.AllowanceData) {
+				s.Require().Equal(sdk.MsgTypeURL(&feegranttypes.BasicAllowance{}), allowance.AllowanceType)
+				s.Require().Empty(allowance.SpendLimit)
+				s.Require().Equal([]string{ethereumTxMsgTypeURL}, allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+
+			bz, err := s.precompile.Allowance(ctx, &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+
+				var out struct{ Allowance feegrant.AllowanceData }
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(out.Allowance)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	var ctx sdk.Context
+	var grantee testkeyring.Key
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expAllowances int
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"pass - no allowances",
+			func() []interface{} {
+				return []interface{}{grantee.Addr, query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+		{
+			"pass - allowances of several granters",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetKey(0), grantee, 1e18)
+				s.grantPeriodicAllowance(ctx, s.keyring.GetKey(2), grantee, time.Hour, 1e18)
+				return []interface{}{grantee.Addr, query.PageRequest{}}
+			},
+			2,
+			false,
+			"",
+		},
+		{
+			"pass - allowances with pagination",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetKey(0), grantee, 1e18)
+				s.grantPeriodicAllowance(ctx, s.keyring.GetKey(2), grantee, time.Hour, 1e18)
+				return []interface{}{grantee.Addr, query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			1,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+
+			bz, err := s.precompile.Allowances(ctx, &method, nil, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+
+				var out feegrant.AllowancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Len(out.Allowances, tc.expAllowances)
+				for _, allowance := range out.Allowances {
+					s.Require().Equal(grantee.Addr, allowance.Grantee)
+					s.Require().NotEmpty(allowance.AllowanceType)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,390 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var ethereumTxMsgTypeURL = sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{grantee.Addr, granter.Addr, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - zero spend limit",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(0)}}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"invalid coins",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{}, ctx.BlockTime().Add(-time.Hour).Unix(), []string{}}
+			},
+			func() {},
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - grant to self",
+			func() []interface{} {
+				return []interface{}{granter.Addr, granter.Addr, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, granter, grantee, 1e18)
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"pass - allowance without limit",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(&feegranttypes.BasicAllowance{}, allowance)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - allowance with spend limit and expiration",
+			func() []interface{} {
+				return []interface{}{
+					granter.Addr,
+					grantee.Addr,
+					[]cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}},
+					ctx.BlockTime().Add(time.Hour).Unix(),
+					[]string{},
+				}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+				s.Require().NoError(err)
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(1e18))), basic.SpendLimit)
+				s.Require().NotNil(basic.Expiration)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), basic.Expiration.Unix())
+			},
+			false,
+			"",
+		},
+		{
+			"pass - allowance restricted to EVM transactions",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), []string{ethereumTxMsgTypeURL}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+				s.Require().NoError(err)
+				allowed, ok := allowance.(*feegranttypes.AllowedMsgAllowance)
+				s.Require().True(ok)
+				s.Require().Equal([]string{ethereumTxMsgTypeURL}, allowed.AllowedMessages)
+				inner, err := allowed.GetAllowance()
+				s.Require().NoError(err)
+				s.Require().IsType(&feegranttypes.BasicAllowance{}, inner)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.GrantAllowance(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.precompile.Events[feegrant.EventTypeGrantAllowance].ID, logs[0].Topics[0])
+				s.Require().Equal(common.BytesToHash(granter.Addr.Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(grantee.Addr.Bytes()), logs[0].Topics[2])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{
+					grantee.Addr, granter.Addr, []cmn.Coin{}, int64(0), int64(3600),
+					[]cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, []string{},
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - zero period",
+			func() []interface{} {
+				return []interface{}{
+					granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), int64(0),
+					[]cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, []string{},
+				}
+			},
+			func() {},
+			true,
+			"invalid period",
+		},
+		{
+			"fail - empty period spend limit",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), int64(3600), []cmn.Coin{}, []string{}}
+			},
+			func() {},
+			true,
+			"invalid period spend limit",
+		},
+		{
+			"fail - first period ends after the expiration",
+			func() []interface{} {
+				return []interface{}{
+					granter.Addr, grantee.Addr, []cmn.Coin{}, ctx.BlockTime().Add(time.Minute).Unix(), int64(3600),
+					[]cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, []string{},
+				}
+			},
+			func() {},
+			true,
+			"cannot reset after the expiration",
+		},
+		{
+			"fail - period spend limit in another denom than the spend limit",
+			func() []interface{} {
+				return []interface{}{
+					granter.Addr, grantee.Addr, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, int64(0), int64(3600),
+					[]cmn.Coin{{Denom: "other", Amount: big.NewInt(1e18)}}, []string{},
+				}
+			},
+			func() {},
+			true,
+			"period spend limit has different currency than basic spend limit",
+		},
+		{
+			"pass - periodic allowance",
+			func() []interface{} {
+				return []interface{}{
+					granter.Addr, grantee.Addr, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(5e18)}}, int64(0), int64(3600),
+					[]cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, []string{},
+				}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+				s.Require().NoError(err)
+				periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+				s.Require().True(ok)
+				periodLimit := sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(1e18)))
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(5e18))), periodic.Basic.SpendLimit)
+				s.Require().Nil(periodic.Basic.Expiration)
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(periodLimit, periodic.PeriodSpendLimit)
+				s.Require().Equal(periodLimit, periodic.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), periodic.PeriodReset.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.GrantPeriodicAllowance(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck()
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.precompile.Events[feegrant.EventTypeGrantAllowance].ID, logs[0].Topics[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	var ctx sdk.Context
+	var granter, grantee testkeyring.Key
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, granter, grantee, 1e18)
+				return []interface{}{grantee.Addr, granter.Addr}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no allowance",
+			func() []interface{} {
+				return []interface{}{granter.Addr, grantee.Addr}
+			},
+			true,
+			feegrant.ErrAllowanceNotFound,
+		},
+		{
+			"pass - revoke allowance",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, granter, grantee, 1e18)
+				return []interface{}{granter.Addr, grantee.Addr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter = s.keyring.GetKey(0)
+			grantee = s.keyring.GetKey(1)
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.RevokeAllowance(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+
+				_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+				s.Require().ErrorContains(err, feegrant.ErrAllowanceNotFound)
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.precompile.Events[feegrant.EventTypeRevokeAllowance].ID, logs[0].Topics[0])
+				s.Require().Equal(common.BytesToHash(granter.Addr.Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(grantee.Addr.Bytes()), logs[0].Topics[2])
+			}
+		})
+	}
+}
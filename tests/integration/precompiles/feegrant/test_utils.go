@@ -0,0 +1,36 @@
+package feegrant
+
+import (
+	"time"
+
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+
+	"cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// grantBasicAllowance saves a basic allowance without expiration from the granter
+// to the grantee, limited to the given amount of the bond denom.
+func (s *PrecompileTestSuite) grantBasicAllowance(ctx sdk.Context, granter, grantee testkeyring.Key, spendLimit int64) {
+	allowance := &feegranttypes.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(spendLimit))),
+	}
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(ctx, granter.AccAddr, grantee.AccAddr, allowance)
+	s.Require().NoError(err)
+}
+
+// grantPeriodicAllowance saves a periodic allowance without expiration from the
+// granter to the grantee, limited to the given amount of the bond denom per period.
+func (s *PrecompileTestSuite) grantPeriodicAllowance(ctx sdk.Context, granter, grantee testkeyring.Key, period time.Duration, periodSpendLimit int64) {
+	limit := sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(periodSpendLimit)))
+	allowance := &feegranttypes.PeriodicAllowance{
+		Period:           period,
+		PeriodSpendLimit: limit,
+		PeriodCanSpend:   limit,
+		PeriodReset:      ctx.BlockTime().Add(period),
+	}
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(ctx, granter.AccAddr, grantee.AccAddr, allowance)
+	s.Require().NoError(err)
+}
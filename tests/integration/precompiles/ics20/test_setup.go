@@ -48,6 +48,9 @@ func (s *PrecompileTestSuite) SetupTest() {
 		*evmAppA.GetStakingKeeper(),
 		evmAppA.GetTransferKeeper(),
 		evmAppA.GetIBCKeeper().ChannelKeeper,
+		evmAppA.GetIBCKeeper().ChannelKeeperV2,
+		evmAppA.GetIBCKeeper().ClientKeeper,
+		evmAppA.GetIBCKeeper().ClientV2Keeper,
 	)
 	s.chainABondDenom, _ = evmAppA.GetStakingKeeper().BondDenom(s.chainA.GetContext())
 	evmAppB := s.chainB.App.(evm.IBCApp)
@@ -56,6 +59,9 @@ func (s *PrecompileTestSuite) SetupTest() {
 		*evmAppB.GetStakingKeeper(),
 		evmAppB.GetTransferKeeper(),
 		evmAppB.GetIBCKeeper().ChannelKeeper,
+		evmAppB.GetIBCKeeper().ChannelKeeperV2,
+		evmAppB.GetIBCKeeper().ClientKeeper,
+		evmAppB.GetIBCKeeper().ClientV2Keeper,
 	)
 	s.chainBBondDenom, _ = evmAppB.GetStakingKeeper().BondDenom(s.chainB.GetContext())
 }
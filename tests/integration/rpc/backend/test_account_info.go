@@ -299,6 +299,7 @@ func (s *TestSuite) TestGetStorageAt() {
 
 func (s *TestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	pendingNr := rpctypes.EthPendingBlockNumber
 
 	testCases := []struct {
 		name          string
@@ -385,6 +386,22 @@ func (s *TestSuite) TestGetBalance() {
 			true,
 			(*hexutil.Big)(big.NewInt(1)),
 		},
+		{
+			"pass - pending state",
+			utiltx.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &pendingNr},
+			func(_ rpctypes.BlockNumber, addr common.Address) {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterParams(QueryClient, &header, height)
+				RegisterHeader(client, &height, nil)
+				RegisterBalancePending(QueryClient, addr)
+			},
+			true,
+			(*hexutil.Big)(big.NewInt(2)),
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
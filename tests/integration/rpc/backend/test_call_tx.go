@@ -16,6 +16,7 @@ import (
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
@@ -414,6 +415,21 @@ func (s *TestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (s *TestSuite) TestSendPrivateTransactionAndBundle() {
+	ethTx, _ := s.buildEthereumTx()
+	err := ethTx.Sign(ethtypes.LatestSigner(s.backend.ChainConfig()), s.signer)
+	s.Require().NoError(err)
+	rlpEncodedBz, err := ethTx.AsTransaction().MarshalBinary()
+	s.Require().NoError(err)
+
+	// the private submission requires the EVM mempool
+	s.backend.Mempool = nil
+	_, err = s.backend.SendPrivateTransaction(rpctypes.PrivateTransactionArgs{Tx: rlpEncodedBz})
+	s.Require().ErrorContains(err, "private transactions require the EVM mempool")
+	_, err = s.backend.SendBundle(rpctypes.BundleArgs{Txs: []hexutil.Bytes{rlpEncodedBz}})
+	s.Require().ErrorContains(err, "bundles require the EVM mempool")
+}
+
 func (s *TestSuite) TestDoCall() {
 	_, bz := s.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -546,6 +562,23 @@ func (s *TestSuite) TestDoCall() {
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
+		{
+			"pass - call on the pending state",
+			func() {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterParams(QueryClient, &header, height)
+				RegisterHeader(client, &height, bz)
+				RegisterEthCallPending(QueryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64(), Pending: true})
+			},
+			rpctypes.EthPendingBlockNumber,
+			callArgs,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -568,6 +601,9 @@ func (s *TestSuite) TestDoCall() {
 func (s *TestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 	height := int64(1)
+	// the base fee of the fee history is in the bank decimals
+	historyBaseFee := math.LegacyNewDecFromBigInt(big.NewInt(1_000_000_000)).
+		QuoInt(evmtypes.GetEVMCoinDecimals().ConversionFactor())
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -583,6 +619,7 @@ func (s *TestSuite) TestGasPrice() {
 				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParams(feeMarketClient, 1)
 				RegisterParams(QueryClient, &header, height)
+				RegisterFeeHistoryError(feeMarketClient, 1, height, height)
 				RegisterGlobalMinGasPrice(QueryClient, 1)
 				RegisterBlock(client, height, nil)
 				RegisterBlockResults(client, 1)
@@ -602,6 +639,7 @@ func (s *TestSuite) TestGasPrice() {
 				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParamsError(feeMarketClient, 1)
 				RegisterParams(QueryClient, &header, height)
+				RegisterFeeHistoryError(feeMarketClient, 1, height, height)
 				RegisterBlock(client, height, nil)
 				RegisterBlockResults(client, 1)
 				RegisterConsensusParams(client, height)
@@ -611,6 +649,38 @@ func (s *TestSuite) TestGasPrice() {
 			defaultGasPrice,
 			false,
 		},
+		{
+			"pass - get the base fee from the fee history",
+			func() {
+				var header metadata.MD
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterParams(QueryClient, &header, height)
+				RegisterGlobalMinGasPrice(QueryClient, 1)
+				RegisterFeeHistory(feeMarketClient, 1, height, height, []feemarkettypes.FeeHistoryEntry{
+					{Height: height, BaseFee: &historyBaseFee, GasLimit: 100},
+				})
+			},
+			// base fee + base fee * (ElasticityMultiplier - 1) / BaseFeeChangeDenominator
+			(*hexutil.Big)(big.NewInt(1_125_000_000)),
+			true,
+		},
+		{
+			"pass - get the min gas price if the base fee is disabled in the fee history",
+			func() {
+				var header metadata.MD
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterParams(QueryClient, &header, height)
+				RegisterGlobalMinGasPrice(QueryClient, 1)
+				RegisterFeeHistory(feeMarketClient, 1, height, height, []feemarkettypes.FeeHistoryEntry{
+					{Height: height, GasLimit: 100},
+				})
+			},
+			(*hexutil.Big)(big.NewInt(constants.DefaultGasPrice)),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -16,6 +16,7 @@ import (
 	rpc "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -369,6 +370,8 @@ func (s *TestSuite) TestFeeHistory() {
 			func(_ sdk.AccAddress) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				// the fee market history doesn't cover the range
+				RegisterFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1, 1, 1)
 				var header metadata.MD
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
@@ -386,6 +389,8 @@ func (s *TestSuite) TestFeeHistory() {
 			func(_ sdk.AccAddress) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				// the fee market history doesn't cover the range
+				RegisterFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1, 1, 1)
 				var header metadata.MD
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
@@ -403,6 +408,8 @@ func (s *TestSuite) TestFeeHistory() {
 			func(_ sdk.AccAddress) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				// the fee market history doesn't cover the range
+				RegisterFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1, 1, 1)
 				var header metadata.MD
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
@@ -422,6 +429,8 @@ func (s *TestSuite) TestFeeHistory() {
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				// the fee market history doesn't cover the range
+				RegisterFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1, 1, 1)
 				var header metadata.MD
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
@@ -454,6 +463,8 @@ func (s *TestSuite) TestFeeHistory() {
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				// the fee market history doesn't cover the range
+				RegisterFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1, 1, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -484,6 +495,8 @@ func (s *TestSuite) TestFeeHistory() {
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				// the fee market history doesn't cover the range
+				RegisterFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1, 1, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -601,3 +614,134 @@ func (s *TestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (s *TestSuite) TestFeeHistoryFromState() {
+	// the fee market history keeps the base fees in the bank decimals
+	toHistoryBaseFee := func(baseFee int64) *sdkmath.LegacyDec {
+		dec := sdkmath.LegacyNewDec(baseFee).QuoInt(evmtypes.GetEVMCoinDecimals().ConversionFactor())
+		return &dec
+	}
+
+	testCases := []struct {
+		name              string
+		registerMock      func()
+		userBlockCount    math.HexOrDecimal64
+		latestBlock       ethrpc.BlockNumber
+		rewardPercentiles []float64
+		expFeeHistory     *rpc.FeeHistoryResult
+		expPass           bool
+	}{
+		{
+			"pass - next base fee from the history",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				s.backend.Ctx = rpc.ContextWithHeight(2)
+				RegisterParams(queryClient, &header, 2)
+				RegisterFeeHistory(fQueryClient, 2, 1, 2, []feemarkettypes.FeeHistoryEntry{
+					{Height: 1, BaseFee: toHistoryBaseFee(1_000_000_000), GasWanted: 60, GasUsed: 50, GasLimit: 100},
+					{Height: 2, BaseFee: toHistoryBaseFee(1_025_000_000), GasLimit: 100},
+				})
+			},
+			1,
+			1,
+			nil,
+			&rpc.FeeHistoryResult{
+				OldestBlock:      (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:          []*hexutil.Big{(*hexutil.Big)(big.NewInt(1_000_000_000)), (*hexutil.Big)(big.NewInt(1_025_000_000))},
+				GasUsedRatio:     []float64{0.5},
+				BlobBaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				BlobGasUsedRatio: []float64{0},
+			},
+			true,
+		},
+		{
+			"pass - next base fee calculated for the latest block",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeHistory(fQueryClient, 1, 1, 1, []feemarkettypes.FeeHistoryEntry{
+					{Height: 1, BaseFee: toHistoryBaseFee(1_000_000_000), GasWanted: 100, GasUsed: 100, GasLimit: 100},
+				})
+			},
+			1,
+			ethrpc.LatestBlockNumber,
+			nil,
+			&rpc.FeeHistoryResult{
+				OldestBlock: (*hexutil.Big)(big.NewInt(1)),
+				// the gas wanted is above the gas target, the base fee increases by 1/8
+				BaseFee:          []*hexutil.Big{(*hexutil.Big)(big.NewInt(1_000_000_000)), (*hexutil.Big)(big.NewInt(1_125_000_000))},
+				GasUsedRatio:     []float64{1},
+				BlobBaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				BlobGasUsedRatio: []float64{0},
+			},
+			true,
+		},
+		{
+			"fail - blocks fetched when the history doesn't cover the range",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeHistory(fQueryClient, 1, 1, 1, []feemarkettypes.FeeHistoryEntry{})
+				RegisterBlockError(client, 1)
+			},
+			1,
+			ethrpc.LatestBlockNumber,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - blocks only fetched for the rewards",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterFeeHistory(fQueryClient, 1, 1, 1, []feemarkettypes.FeeHistoryEntry{
+					{Height: 1, BaseFee: toHistoryBaseFee(0), GasWanted: 21000, GasUsed: 21000, GasLimit: 42000},
+				})
+				// the eth tx pays a gas price of 1
+				_, txBz := s.buildEthereumTx()
+				RegisterBlock(client, 1, txBz)
+				RegisterBlockResultsWithTxs(client, 1, []*types.ExecTxResult{{Code: 0, GasUsed: 21000}})
+			},
+			1,
+			ethrpc.LatestBlockNumber,
+			[]float64{50, 100},
+			&rpc.FeeHistoryResult{
+				OldestBlock:      (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:          []*hexutil.Big{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))},
+				GasUsedRatio:     []float64{0.5},
+				Reward:           [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))}},
+				BlobBaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				BlobGasUsedRatio: []float64{0},
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+			tc.registerMock()
+
+			feeHistory, err := s.backend.FeeHistory(tc.userBlockCount, tc.latestBlock, tc.rewardPercentiles)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expFeeHistory, feeHistory)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
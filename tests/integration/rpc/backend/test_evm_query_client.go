@@ -190,6 +190,13 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterEthCallPending(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	// the pending state is queried on top of the latest height, under a
+	// context cancelled by the backend once the call returns
+	queryClient.On("EthCall", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(&evmtypes.MsgEthereumTxResponse{}, nil)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -310,6 +317,12 @@ func RegisterBalance(queryClient *mocks.EVMQueryClient, addr common.Address, hei
 		Return(&evmtypes.QueryBalanceResponse{Balance: "1"}, nil)
 }
 
+func RegisterBalancePending(queryClient *mocks.EVMQueryClient, addr common.Address) {
+	// the pending state is queried on top of the latest height
+	queryClient.On("Balance", rpc.ContextWithHeight(0), &evmtypes.QueryBalanceRequest{Address: addr.String(), Pending: true}).
+		Return(&evmtypes.QueryBalanceResponse{Balance: "2"}, nil)
+}
+
 func RegisterBalanceInvalid(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Balance", rpc.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryBalanceResponse{Balance: "invalid"}, nil)
@@ -20,3 +20,14 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// FeeHistory
+func RegisterFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, height, fromHeight, toHeight int64, entries []feemarkettypes.FeeHistoryEntry) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryFeeHistoryRequest{FromHeight: fromHeight, ToHeight: toHeight}).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{Entries: entries}, nil)
+}
+
+func RegisterFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient, height, fromHeight, toHeight int64) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryFeeHistoryRequest{FromHeight: fromHeight, ToHeight: toHeight}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,112 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+)
+
+func (s *TestSuite) TestSuggestGasTipCapFromBlocks() {
+	baseFee := big.NewInt(1_000_000_000)
+	// the max base fee change is suggested for the blocks without transactions
+	defaultTip := big.NewInt(125_000_000)
+
+	// registerBlock registers a block paying a 2 gwei tip over the base fee
+	registerBlock := func() {
+		var header metadata.MD
+		client := s.backend.ClientCtx.Client.(*mocks.Client)
+		queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterParams(queryClient, &header, 1)
+
+		msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  s.backend.EvmChainID,
+			To:       &common.Address{},
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(3_000_000_000),
+		})
+		resBlock := RegisterBlock(client, 1, s.signAndEncodeEthTx(msgEthereumTx))
+		blockRes, err := RegisterBlockResultsWithEventLog(client, 1)
+		s.Require().NoError(err)
+		blockRes.TxsResults[0].Events = []types.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []types.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msgEthereumTx.AsTransaction().Hash().Hex()},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+			}},
+		}
+		s.Require().NoError(s.backend.Indexer.IndexBlock(resBlock.Block, blockRes.TxsResults))
+		RegisterBaseFee(queryClient, math.NewIntFromBigInt(baseFee))
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expGasTipCap *big.Int
+	}{
+		{
+			"pass - oracle disabled",
+			func() {
+				s.backend.Cfg.JSONRPC.GasPriceOracle.Blocks = 0
+			},
+			defaultTip,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+			},
+			defaultTip,
+		},
+		{
+			"pass - tip sampled from the block",
+			registerBlock,
+			big.NewInt(2_000_000_000),
+		},
+		{
+			"pass - tip below the ignore price",
+			func() {
+				s.backend.Cfg.JSONRPC.GasPriceOracle.IgnorePrice = 3_000_000_000
+				registerBlock()
+			},
+			defaultTip,
+		},
+		{
+			"pass - tip capped to the max price",
+			func() {
+				s.backend.Cfg.JSONRPC.GasPriceOracle.MaxPrice = 1_000_000_000
+				registerBlock()
+			},
+			big.NewInt(1_000_000_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+			RegisterFeeMarketParams(feeMarketClient, 1)
+			tc.registerMock()
+
+			gasTipCap, err := s.backend.SuggestGasTipCap(baseFee)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expGasTipCap, gasTipCap)
+
+			// the tip is cached for the head
+			gasTipCap, err = s.backend.SuggestGasTipCap(baseFee)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expGasTipCap, gasTipCap)
+		})
+	}
+}
@@ -689,10 +689,11 @@ func (s *TestSuite) TestGetTransactionReceipt() {
 			if tc.expPass {
 				s.Require().Equal(res["transactionHash"], tc.tx.Hash())
 				s.Require().Equal(res["blockNumber"], hexutil.Uint64(tc.block.Height)) //nolint: gosec // G115
-				requiredFields := []string{"status", "cumulativeGasUsed", "logsBloom", "logs", "gasUsed", "blockHash", "blockNumber", "transactionIndex", "effectiveGasPrice", "from", "to", "type"}
+				requiredFields := []string{"status", "cumulativeGasUsed", "logsBloom", "logs", "gasUsed", "blockHash", "blockNumber", "transactionIndex", "effectiveGasPrice", "from", "to", "type", "feePayer"}
 				for _, field := range requiredFields {
 					s.Require().NotNil(res[field], "field was empty %s", field)
 				}
+				s.Require().Equal(res["from"], res["feePayer"])
 				s.Require().Nil(res["contractAddress"]) // no contract creation
 				s.Require().NoError(err)
 			} else {
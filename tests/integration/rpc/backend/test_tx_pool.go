@@ -0,0 +1,16 @@
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (s *TestSuite) TestMempoolAdmin() {
+	// the mempool introspection and eviction require the EVM mempool
+	s.backend.Mempool = nil
+	_, err := s.backend.MempoolContent()
+	s.Require().ErrorContains(err, "requires the EVM mempool")
+	_, err = s.backend.RemovePoolTransaction(common.Hash{})
+	s.Require().ErrorContains(err, "requires the EVM mempool")
+	_, err = s.backend.RemovePoolSender(common.Address{})
+	s.Require().ErrorContains(err, "requires the EVM mempool")
+}
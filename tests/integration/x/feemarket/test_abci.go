@@ -2,7 +2,9 @@ package feemarket
 
 import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -58,3 +60,35 @@ func (s *KeeperTestSuite) TestEndBlock() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestEndBlockFirstMovingWindow() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+
+	params := k.GetParams(ctx)
+	params.NoBaseFee = false
+	params.EnableHeight = 1
+	params.MinGasMultiplier = math.LegacyOneDec()
+	params.BaseFeeAlgorithm = types.BaseFeeAlgorithmMovingWindow
+	params.BaseFeeWindowSize = 10
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	// the blocks below the window size are all kept in the window
+	gasWanted := []uint64{100, 200, 300, 400}
+	for i, gas := range gasWanted {
+		ctx = ctx.
+			WithBlockHeight(int64(i + 1)).
+			WithBlockGasMeter(storetypes.NewGasMeter(1_000)).
+			WithBlockGasWanted(gas)
+		s.Require().NoError(k.EndBlock(ctx))
+	}
+
+	entries := k.GetBlockGasWindow(ctx)
+	s.Require().Len(entries, len(gasWanted))
+	for i, entry := range entries {
+		s.Require().Equal(int64(i+1), entry.Height)
+		s.Require().Equal(gasWanted[i], entry.Gas)
+	}
+	s.Require().Equal(uint64(250), k.GetWindowAverageGas(ctx))
+}
@@ -9,11 +9,13 @@ import (
 
 	"github.com/cosmos/evm/testutil/integration"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket"
 	"github.com/cosmos/evm/x/feemarket/keeper"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -464,3 +466,107 @@ func (s *KeeperTestSuite) TestCalculateBaseFeeEdgeCases() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCalculateBaseFeeAlgorithms() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	minGasPrice := math.LegacyNewDec(1_000_000_000)
+	baseFee := math.LegacyNewDec(2_000_000_000)
+
+	testCases := []struct {
+		name     string
+		malleate func(params *feemarkettypes.Params)
+		// gas wanted by the blocks processed by EndBlock before the base fee calculation
+		gasWanted []uint64
+		expFee    func() math.LegacyDec
+	}{
+		{
+			"exponential - without excess gas the base fee is the min gas price",
+			func(params *feemarkettypes.Params) {
+				params.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmExponential
+				params.BaseFeeUpdateFraction = 100
+			},
+			[]uint64{50, 25},
+			func() math.LegacyDec { return minGasPrice },
+		},
+		{
+			"exponential - the excess gas is accumulated above the target",
+			func(params *feemarkettypes.Params) {
+				params.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmExponential
+				params.BaseFeeUpdateFraction = 100
+			},
+			[]uint64{100, 100},
+			func() math.LegacyDec { return feemarkettypes.CalcExponentialBaseFee(100, 100, minGasPrice) },
+		},
+		{
+			"exponential - the excess gas is drained below the target",
+			func(params *feemarkettypes.Params) {
+				params.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmExponential
+				params.BaseFeeUpdateFraction = 100
+			},
+			[]uint64{100, 100, 0},
+			func() math.LegacyDec { return feemarkettypes.CalcExponentialBaseFee(50, 100, minGasPrice) },
+		},
+		{
+			"moving window - the average gas wanted equals the target",
+			func(params *feemarkettypes.Params) {
+				params.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmMovingWindow
+				params.BaseFeeWindowSize = 2
+			},
+			[]uint64{100, 0},
+			func() math.LegacyDec { return baseFee },
+		},
+		{
+			"moving window - only the last blocks of the window are averaged",
+			func(params *feemarkettypes.Params) {
+				params.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmMovingWindow
+				params.BaseFeeWindowSize = 2
+			},
+			[]uint64{0, 100, 100},
+			// parentBaseFee * (100 - 50) / 50 / 8
+			func() math.LegacyDec { return baseFee.Add(math.LegacyNewDec(250_000_000)) },
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			k := nw.App.GetFeeMarketKeeper()
+
+			params := k.GetParams(ctx)
+			params.NoBaseFee = false
+			params.EnableHeight = 1
+			params.BaseFee = baseFee
+			params.MinGasPrice = minGasPrice
+			params.BaseFeeChangeDenominator = 8
+			params.ElasticityMultiplier = 2
+			params.MinGasMultiplier = math.LegacyOneDec()
+			tc.malleate(&params)
+			s.Require().NoError(params.Validate())
+			s.Require().NoError(k.SetParams(ctx, params))
+
+			// the gas target is MaxGas / ElasticityMultiplier = 50
+			consParams := tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}}
+			for i, gasWanted := range tc.gasWanted {
+				ctx = ctx.
+					WithBlockHeight(int64(i + 2)).
+					WithConsensusParams(consParams).
+					WithBlockGasMeter(storetypes.NewGasMeter(1_000)).
+					WithBlockGasWanted(gasWanted)
+				s.Require().NoError(k.EndBlock(ctx))
+			}
+
+			ctx = ctx.WithBlockHeight(int64(len(tc.gasWanted) + 2))
+			s.Require().Equal(tc.expFee().String(), k.CalculateBaseFee(ctx).String())
+
+			// the excess gas and the block gas window are exported
+			genesis := feemarket.ExportGenesis(ctx, *k)
+			s.Require().Equal(k.GetExcessGas(ctx), genesis.ExcessGas)
+			s.Require().LessOrEqual(len(genesis.BlockGasWindow), int(params.BaseFeeWindowSize))
+		})
+	}
+}
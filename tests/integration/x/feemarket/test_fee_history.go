@@ -0,0 +1,190 @@
+package feemarket
+
+import (
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestEndBlockFeeHistory() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name       string
+		noBaseFee  bool
+		window     uint64
+		expFound   bool
+		expBaseFee bool
+	}{
+		{
+			"fee history disabled",
+			false,
+			0,
+			false,
+			false,
+		},
+		{
+			"base fee disabled",
+			true,
+			types.DefaultHistoryServeWindow,
+			true,
+			false,
+		},
+		{
+			"pass",
+			false,
+			types.DefaultHistoryServeWindow,
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.NoBaseFee = tc.noBaseFee
+			params.HistoryServeWindow = tc.window
+			params.MinGasMultiplier = math.LegacyOneDec()
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			meter := storetypes.NewGasMeter(uint64(1000000000))
+			ctx = ctx.WithBlockGasMeter(meter).WithBlockGasWanted(5000000).WithBlockGasUsed(3000000)
+
+			s.Require().NoError(fmk.EndBlock(ctx))
+
+			entry, found := fmk.GetFeeHistoryEntry(ctx, ctx.BlockHeight())
+			s.Require().Equal(tc.expFound, found)
+			if !tc.expFound {
+				return
+			}
+			s.Require().Equal(ctx.BlockHeight(), entry.Height)
+			s.Require().Equal(uint64(5000000), entry.GasWanted)
+			s.Require().Equal(uint64(3000000), entry.GasUsed)
+			s.Require().NotZero(entry.GasLimit)
+			if tc.expBaseFee {
+				s.Require().NotNil(entry.BaseFee)
+				s.Require().Equal(params.BaseFee, *entry.BaseFee)
+			} else {
+				s.Require().Nil(entry.BaseFee)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetFeeHistory() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	fmk := nw.App.GetFeeMarketKeeper()
+
+	window := uint64(4)
+	params := fmk.GetParams(ctx)
+	params.HistoryServeWindow = window
+	s.Require().NoError(fmk.SetParams(ctx, params))
+
+	for height := int64(1); height <= 6; height++ {
+		fmk.SetFeeHistoryEntry(ctx, window, types.FeeHistoryEntry{Height: height, GasUsed: uint64(height)}) //#nosec G115
+	}
+
+	// the blocks 1 and 2 are overridden by the blocks 5 and 6
+	_, found := fmk.GetFeeHistoryEntry(ctx, 1)
+	s.Require().False(found)
+	entry, found := fmk.GetFeeHistoryEntry(ctx, 5)
+	s.Require().True(found)
+	s.Require().Equal(uint64(5), entry.GasUsed)
+	_, found = fmk.GetFeeHistoryEntry(ctx, 7)
+	s.Require().False(found)
+
+	entries := fmk.GetFeeHistory(ctx, 1, 6)
+	s.Require().Len(entries, 4)
+	for i, entry := range entries {
+		s.Require().Equal(int64(i+3), entry.Height)
+	}
+
+	// the missing blocks are skipped
+	entries = fmk.GetFeeHistory(ctx, 5, 8)
+	s.Require().Len(entries, 2)
+
+	// the slots beyond the window are pruned once it is lowered
+	params.HistoryServeWindow = 2
+	s.Require().NoError(fmk.SetParams(ctx, params))
+	params.HistoryServeWindow = window
+	s.Require().NoError(fmk.SetParams(ctx, params))
+	_, found = fmk.GetFeeHistoryEntry(ctx, 6)
+	s.Require().False(found)
+	_, found = fmk.GetFeeHistoryEntry(ctx, 5)
+	s.Require().True(found)
+
+	// the history is empty once disabled
+	params.HistoryServeWindow = 0
+	s.Require().NoError(fmk.SetParams(ctx, params))
+	s.Require().Empty(fmk.GetFeeHistory(ctx, 1, 6))
+}
+
+func (s *KeeperTestSuite) TestQueryFeeHistory() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	testCases := []struct {
+		name       string
+		req        *types.QueryFeeHistoryRequest
+		expEntries int
+		expPass    bool
+	}{
+		{
+			"fail - invalid from height",
+			&types.QueryFeeHistoryRequest{FromHeight: 0, ToHeight: 1},
+			0,
+			false,
+		},
+		{
+			"fail - to height lower than from height",
+			&types.QueryFeeHistoryRequest{FromHeight: 2, ToHeight: 1},
+			0,
+			false,
+		},
+		{
+			"fail - range too large",
+			&types.QueryFeeHistoryRequest{FromHeight: 1, ToHeight: types.MaxHistoryServeWindow + 1},
+			0,
+			false,
+		},
+		{
+			"pass",
+			&types.QueryFeeHistoryRequest{FromHeight: 1, ToHeight: 3},
+			3,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			for height := int64(1); height <= 3; height++ {
+				fmk.SetFeeHistoryEntry(ctx, types.DefaultHistoryServeWindow, types.FeeHistoryEntry{Height: height})
+			}
+
+			res, err := fmk.FeeHistory(ctx, tc.req)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Len(res.Entries, tc.expEntries)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package vm
+
+import (
+	"math/big"
+
+	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/testutil/integration/evm/utils"
+	testKeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestDistributeBaseFee() {
+	baseDenom := types.GetEVMCoinDenom()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	treasury := utiltx.GenerateAddress().Bytes()
+
+	testCases := []struct {
+		name            string
+		treasuryAddress string
+		recipient       sdk.AccAddress
+	}{
+		{
+			name:            "treasury share sent to the treasury",
+			treasuryAddress: sdk.AccAddress(treasury).String(),
+			recipient:       treasury,
+		},
+		{
+			name:      "treasury share sent to the community pool",
+			recipient: authtypes.NewModuleAddress(distrtypes.ModuleName),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// FeeCollector account is pre-funded with the fees to distribute
+			bankGenesis := banktypes.DefaultGenesisState()
+			bankGenesis.Balances = []banktypes.Balance{
+				{
+					Address: feeCollector.String(),
+					Coins:   sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(6e18))),
+				},
+			}
+			feemarketGenesis := feemarkettypes.DefaultGenesisState()
+			feemarketGenesis.Params.BaseFeeBurnRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+			feemarketGenesis.Params.BaseFeeTreasuryRatio = sdkmath.LegacyNewDecWithPrec(25, 2)
+			feemarketGenesis.Params.TreasuryAddress = tc.treasuryAddress
+			customGenesis := network.CustomGenesisState{
+				banktypes.ModuleName:      bankGenesis,
+				feemarkettypes.ModuleName: feemarketGenesis,
+			}
+
+			unitNetwork := network.NewUnitTestNetwork(
+				s.Create,
+				network.WithPreFundedAccounts(testKeyring.New(1).GetAllAccAddrs()...),
+				network.WithCustomGenesis(customGenesis),
+			)
+			ctx := unitNetwork.GetContext()
+			bankKeeper := unitNetwork.App.GetBankKeeper()
+			feeCollectorBalance := bankKeeper.GetBalance(ctx, feeCollector, baseDenom)
+			recipientBalance := bankKeeper.GetBalance(ctx, tc.recipient, baseDenom)
+			supply := bankKeeper.GetSupply(ctx, baseDenom)
+
+			baseFee := sdkmath.NewInt(1e18)
+			s.Require().NoError(unitNetwork.App.GetEVMKeeper().DistributeBaseFee(ctx, baseFee.BigInt()))
+
+			// half of the base fee is burned, a quarter goes to the treasury and
+			// the rest is left to the validators
+			s.Require().Equal(supply.Amount.Sub(sdkmath.NewInt(5e17)), bankKeeper.GetSupply(ctx, baseDenom).Amount)
+			s.Require().Equal(recipientBalance.Amount.Add(sdkmath.NewInt(25e16)), bankKeeper.GetBalance(ctx, tc.recipient, baseDenom).Amount)
+			s.Require().Equal(feeCollectorBalance.Amount.Sub(sdkmath.NewInt(75e16)), bankKeeper.GetBalance(ctx, feeCollector, baseDenom).Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBaseFeeSentToTreasury() {
+	treasury := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.EnableHeight = 1
+	feemarketGenesis.Params.NoBaseFee = false
+	feemarketGenesis.Params.BaseFeeTreasuryRatio = sdkmath.LegacyOneDec()
+	feemarketGenesis.Params.TreasuryAddress = treasury.String()
+
+	keyring := testKeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{feemarkettypes.ModuleName: feemarketGenesis}),
+	)
+	txFactory := factory.New(unitNetwork, grpc.NewIntegrationHandler(unitNetwork))
+	bankKeeper := unitNetwork.App.GetBankKeeper()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	baseDenom := types.GetEVMCoinDenom()
+	sender := keyring.GetKey(0)
+
+	// the base fee paid for the gas used by the EVM transactions
+	recipient := keyring.GetAddr(1)
+	tx, err := txFactory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+		To:        &recipient,
+		Amount:    big.NewInt(100),
+		GasFeeCap: big.NewInt(1e10),
+		GasTipCap: big.NewInt(1e9),
+	})
+	s.Require().NoError(err)
+	txBytes, err := txFactory.EncodeTx(tx)
+	s.Require().NoError(err)
+	res, err := unitNetwork.NextBlockWithTxs(txBytes)
+	s.Require().NoError(err)
+	s.Require().True(res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+	ctx := unitNetwork.GetContext()
+	baseFee := evmKeeper.GetBaseFee(ctx)
+	s.Require().Positive(baseFee.Sign())
+	gasUsed := big.NewInt(res.TxResults[0].GasUsed)
+	treasuryBalance := bankKeeper.GetBalance(ctx, treasury, baseDenom)
+	s.Require().Equal(new(big.Int).Mul(baseFee, gasUsed), treasuryBalance.Amount.BigInt())
+
+	// the base fee paid for the gas limit by the Cosmos transactions
+	gas := uint64(200_000)
+	gasPrice := sdkmath.NewIntFromBigInt(baseFee).MulRaw(2)
+	msgSend := banktypes.NewMsgSend(sender.AccAddr, keyring.GetAccAddr(1), sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(100))))
+	cosmosRes, err := txFactory.CommitCosmosTx(sender.Priv, commonfactory.CosmosTxArgs{
+		Msgs:     []sdk.Msg{msgSend},
+		Gas:      &gas,
+		GasPrice: &gasPrice,
+	})
+	s.Require().NoError(err)
+	s.Require().True(cosmosRes.IsOK(), cosmosRes.Log)
+
+	ctx = unitNetwork.GetContext()
+	baseFee = evmKeeper.GetBaseFee(ctx)
+	expBalance := treasuryBalance.Amount.Add(sdkmath.NewIntFromBigInt(baseFee).MulRaw(int64(gas)))
+	s.Require().Equal(expBalance, bankKeeper.GetBalance(ctx, treasury, baseDenom).Amount)
+}
+
+func (s *KeeperTestSuite) TestBaseFeeSentToTreasuryWithVirtualFeeCollection() {
+	treasury := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.EnableHeight = 1
+	feemarketGenesis.Params.NoBaseFee = false
+	feemarketGenesis.Params.BaseFeeTreasuryRatio = sdkmath.LegacyOneDec()
+	feemarketGenesis.Params.TreasuryAddress = treasury.String()
+
+	keyring := testKeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{feemarkettypes.ModuleName: feemarketGenesis}),
+	)
+	txFactory := factory.New(unitNetwork, grpc.NewIntegrationHandler(unitNetwork))
+	bankKeeper := unitNetwork.App.GetBankKeeper()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	evmKeeper.EnableVirtualFeeCollection()
+	baseDenom := types.GetEVMCoinDenom()
+	sender := keyring.GetKey(0)
+
+	// the base fee of the block transactions is sent to the treasury once the
+	// fees are credited to the fee collector at the end of the block
+	recipient := keyring.GetAddr(1)
+	txs := make([][]byte, 0, 2)
+	for nonce := range uint64(2) {
+		tx, err := txFactory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+			Nonce:     nonce,
+			To:        &recipient,
+			Amount:    big.NewInt(100),
+			GasFeeCap: big.NewInt(1e10),
+			GasTipCap: big.NewInt(1e9),
+		})
+		s.Require().NoError(err)
+		txBytes, err := txFactory.EncodeTx(tx)
+		s.Require().NoError(err)
+		txs = append(txs, txBytes)
+	}
+	res, err := unitNetwork.NextBlockWithTxs(txs...)
+	s.Require().NoError(err)
+
+	ctx := unitNetwork.GetContext()
+	baseFee := evmKeeper.GetBaseFee(ctx)
+	s.Require().Positive(baseFee.Sign())
+	gasUsed := new(big.Int)
+	for _, txResult := range res.TxResults {
+		s.Require().True(txResult.IsOK(), txResult.Log)
+		gasUsed.Add(gasUsed, big.NewInt(txResult.GasUsed))
+	}
+	treasuryBalance := bankKeeper.GetBalance(ctx, treasury, baseDenom)
+	s.Require().Equal(new(big.Int).Mul(baseFee, gasUsed), treasuryBalance.Amount.BigInt())
+}
+
+func (s *KeeperTestSuite) TestBaseFeeHeldWithVirtualFeeCollection() {
+	// the module accounts are blocked from receiving funds, so that the base
+	// fee cannot be sent to the treasury
+	blockedTreasury := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.EnableHeight = 1
+	feemarketGenesis.Params.NoBaseFee = false
+	feemarketGenesis.Params.BaseFeeTreasuryRatio = sdkmath.LegacyOneDec()
+	feemarketGenesis.Params.TreasuryAddress = blockedTreasury.String()
+
+	keyring := testKeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{feemarkettypes.ModuleName: feemarketGenesis}),
+	)
+	txFactory := factory.New(unitNetwork, grpc.NewIntegrationHandler(unitNetwork))
+	bankKeeper := unitNetwork.App.GetBankKeeper()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	evmKeeper.EnableVirtualFeeCollection()
+	baseDenom := types.GetEVMCoinDenom()
+	sender := keyring.GetKey(0)
+	recipient := keyring.GetAddr(1)
+	evmModule := authtypes.NewModuleAddress(types.ModuleName)
+
+	// the base fee is held by the EVM module account when it cannot be split,
+	// rather than left to the validators
+	ctx := unitNetwork.GetContext()
+	moduleBalance := bankKeeper.GetBalance(ctx, evmModule, baseDenom)
+	tx, err := txFactory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+		To:        &recipient,
+		Amount:    big.NewInt(100),
+		GasFeeCap: big.NewInt(1e10),
+		GasTipCap: big.NewInt(1e9),
+	})
+	s.Require().NoError(err)
+	txBytes, err := txFactory.EncodeTx(tx)
+	s.Require().NoError(err)
+	res, err := unitNetwork.NextBlockWithTxs(txBytes)
+	s.Require().NoError(err)
+	s.Require().True(res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	s.Require().True(utils.ContainsEventType(res.Events, types.EventTypeBaseFeeHeld))
+
+	ctx = unitNetwork.GetContext()
+	heldBaseFee := new(big.Int).Mul(evmKeeper.GetBaseFee(ctx), big.NewInt(res.TxResults[0].GasUsed))
+	s.Require().Positive(heldBaseFee.Sign())
+	s.Require().Equal(heldBaseFee, evmKeeper.GetHeldBaseFee(ctx))
+	s.Require().Equal(moduleBalance.Amount.Add(sdkmath.NewIntFromBigInt(heldBaseFee)), bankKeeper.GetBalance(ctx, evmModule, baseDenom).Amount)
+
+	// once the treasury is fixed, the held base fee is split at the end of the
+	// next block
+	treasury := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	params := unitNetwork.App.GetFeeMarketKeeper().GetParams(ctx)
+	params.TreasuryAddress = treasury.String()
+	s.Require().NoError(unitNetwork.App.GetFeeMarketKeeper().SetParams(ctx, params))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(evmKeeper.EndBlock(ctx))
+	s.Require().False(utils.ContainsEventType(ctx.EventManager().ABCIEvents(), types.EventTypeBaseFeeHeld))
+	s.Require().Zero(evmKeeper.GetHeldBaseFee(ctx).Sign())
+	s.Require().Equal(moduleBalance, bankKeeper.GetBalance(ctx, evmModule, baseDenom))
+	s.Require().Equal(heldBaseFee, bankKeeper.GetBalance(ctx, treasury, baseDenom).Amount.BigInt())
+}
@@ -0,0 +1,314 @@
+package vm
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
+	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func (s *KeeperTestSuite) TestSponsoredEthereumTx() {
+	s.SetupTest()
+
+	granter := s.Keyring.GetKey(1)
+	// the sender has no funds, the fees are paid by the granter
+	senderAddr, senderPriv := utiltx.NewAccAddressAndKey()
+	recipient := utiltx.GenerateAddress()
+	denom := types.GetEVMCoinDenom()
+	gasPrice := big.NewInt(1e9)
+	gasLimit := uint64(100_000)
+
+	// signFeeGrant sets the fee granter along with the signature of the signer
+	signFeeGrant := func(txBuilder client.TxBuilder, msg *types.MsgEthereumTx, signer cryptotypes.PrivKey) {
+		sig, err := signer.Sign(types.FeeGrantSignBytes(msg.AsTransaction().Hash()))
+		s.Require().NoError(err)
+		s.Require().NoError(types.SetFeeGrant(txBuilder, granter.AccAddr, signing.SignatureV2{
+			PubKey: signer.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		}))
+	}
+
+	buildTx := func(nonce uint64, setFeeGrant func(client.TxBuilder, *types.MsgEthereumTx)) []byte {
+		msg, err := s.Factory.GenerateSignedMsgEthereumTx(senderPriv, types.EvmTxArgs{
+			Nonce:    nonce,
+			To:       &recipient,
+			GasLimit: gasLimit,
+			GasPrice: gasPrice,
+		})
+		s.Require().NoError(err)
+
+		txBuilder := s.Network.App.GetTxConfig().NewTxBuilder()
+		_, err = msg.BuildTx(txBuilder, denom)
+		s.Require().NoError(err)
+		setFeeGrant(txBuilder, &msg)
+		bz, err := s.Network.App.GetTxConfig().TxEncoder()(txBuilder.GetTx())
+		s.Require().NoError(err)
+		return bz
+	}
+
+	signedByGranter := func(txBuilder client.TxBuilder, msg *types.MsgEthereumTx) {
+		signFeeGrant(txBuilder, msg, granter.Priv)
+	}
+
+	// without allowance the transaction is rejected
+	res, err := s.Network.NextBlockWithTxs(buildTx(0, signedByGranter))
+	s.Require().NoError(err)
+	s.Require().False(res.TxResults[0].IsOK())
+	s.Require().Contains(res.TxResults[0].Log, "does not allow to pay fees")
+
+	spendLimit := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1e18)))
+	msgGrant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, granter.AccAddr, senderAddr)
+	s.Require().NoError(err)
+	_, err = s.Factory.ExecuteCosmosTx(granter.Priv, commonfactory.CosmosTxArgs{Msgs: []sdk.Msg{msgGrant}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	ctx := s.Network.GetContext()
+	bankKeeper := s.Network.App.GetBankKeeper()
+	granterBalance := bankKeeper.GetBalance(ctx, granter.AccAddr, denom)
+
+	// the fee granter isn't covered by the Ethereum signature, it must sign the
+	// transaction for the fees to be paid by it
+	res, err = s.Network.NextBlockWithTxs(buildTx(0, func(txBuilder client.TxBuilder, _ *types.MsgEthereumTx) {
+		txBuilder.SetFeeGranter(granter.AccAddr)
+	}))
+	s.Require().NoError(err)
+	s.Require().False(res.TxResults[0].IsOK())
+	s.Require().Contains(res.TxResults[0].Log, "must sign the sponsored transaction")
+
+	res, err = s.Network.NextBlockWithTxs(buildTx(0, func(txBuilder client.TxBuilder, msg *types.MsgEthereumTx) {
+		_, otherPriv := utiltx.NewAccAddressAndKey()
+		signFeeGrant(txBuilder, msg, otherPriv)
+	}))
+	s.Require().NoError(err)
+	s.Require().False(res.TxResults[0].IsOK())
+	s.Require().Contains(res.TxResults[0].Log, "is not signed by fee granter")
+
+	res, err = s.Network.NextBlockWithTxs(buildTx(0, signedByGranter))
+	s.Require().NoError(err)
+	s.Require().True(res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+	// the granter pays the fees of the gas used, the leftover gas is refunded to it
+	ctx = s.Network.GetContext()
+	gasUsed := res.TxResults[0].GasUsed
+	s.Require().Less(uint64(gasUsed), gasLimit)
+	fees := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, big.NewInt(gasUsed)))
+	s.Require().Equal(granterBalance.Amount.Sub(fees), bankKeeper.GetBalance(ctx, granter.AccAddr, denom).Amount)
+	s.Require().True(bankKeeper.GetBalance(ctx, senderAddr, denom).IsZero())
+	s.Require().Equal(uint64(1), s.Network.App.GetEVMKeeper().GetNonce(ctx, common.BytesToAddress(senderAddr)))
+
+	// the allowance is spent by the fees of the gas used only
+	allowance, err := s.Network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, senderAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(spendLimit.Sub(sdk.NewCoin(denom, fees)), allowance.(*feegrant.BasicAllowance).SpendLimit)
+}
+
+func (s *KeeperTestSuite) TestSponsoredEthereumTxExhaustingAllowance() {
+	denom := types.GetEVMCoinDenom()
+	gasPrice := big.NewInt(1e9)
+	gasLimit := uint64(100_000)
+	// the allowance is exhausted by the fees of the gas limit
+	fees := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, big.NewInt(int64(gasLimit))))))
+
+	testCases := []struct {
+		name      string
+		allowance func(expiration time.Time) feegrant.FeeAllowanceI
+		// spendLimit returns the spend limit of the allowance granted again
+		spendLimit func(allowance feegrant.FeeAllowanceI) sdk.Coins
+	}{
+		{
+			name: "basic allowance",
+			allowance: func(expiration time.Time) feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: fees, Expiration: &expiration}
+			},
+			spendLimit: func(allowance feegrant.FeeAllowanceI) sdk.Coins {
+				return allowance.(*feegrant.BasicAllowance).SpendLimit
+			},
+		},
+		{
+			name: "periodic allowance",
+			allowance: func(expiration time.Time) feegrant.FeeAllowanceI {
+				return &feegrant.PeriodicAllowance{
+					Basic:            feegrant.BasicAllowance{SpendLimit: fees, Expiration: &expiration},
+					Period:           time.Hour,
+					PeriodSpendLimit: fees,
+				}
+			},
+			spendLimit: func(allowance feegrant.FeeAllowanceI) sdk.Coins {
+				periodic := allowance.(*feegrant.PeriodicAllowance)
+				s.Require().Equal(periodic.Basic.SpendLimit, periodic.PeriodCanSpend)
+				return periodic.Basic.SpendLimit
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			granter := s.Keyring.GetKey(1)
+			senderAddr, senderPriv := utiltx.NewAccAddressAndKey()
+			recipient := utiltx.GenerateAddress()
+			expiration := s.Network.GetContext().BlockTime().Add(24 * time.Hour)
+
+			msgGrant, err := feegrant.NewMsgGrantAllowance(tc.allowance(expiration), granter.AccAddr, senderAddr)
+			s.Require().NoError(err)
+			_, err = s.Factory.ExecuteCosmosTx(granter.Priv, commonfactory.CosmosTxArgs{Msgs: []sdk.Msg{msgGrant}})
+			s.Require().NoError(err)
+			s.Require().NoError(s.Network.NextBlock())
+
+			msg, err := s.Factory.GenerateSignedMsgEthereumTx(senderPriv, types.EvmTxArgs{
+				To:       &recipient,
+				GasLimit: gasLimit,
+				GasPrice: gasPrice,
+			})
+			s.Require().NoError(err)
+			txBuilder := s.Network.App.GetTxConfig().NewTxBuilder()
+			_, err = msg.BuildTx(txBuilder, denom)
+			s.Require().NoError(err)
+			sig, err := granter.Priv.Sign(types.FeeGrantSignBytes(msg.AsTransaction().Hash()))
+			s.Require().NoError(err)
+			s.Require().NoError(types.SetFeeGrant(txBuilder, granter.AccAddr, signing.SignatureV2{
+				PubKey: granter.Priv.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+			}))
+			bz, err := s.Network.App.GetTxConfig().TxEncoder()(txBuilder.GetTx())
+			s.Require().NoError(err)
+
+			res, err := s.Network.NextBlockWithTxs(bz)
+			s.Require().NoError(err)
+			s.Require().True(res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+			// the allowance exhausted by the ante handler is granted again with the
+			// fees of the leftover gas
+			gasUsed := res.TxResults[0].GasUsed
+			s.Require().Less(uint64(gasUsed), gasLimit)
+			refunded := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, big.NewInt(int64(gasLimit)-gasUsed)))
+			allowance, err := s.Network.App.GetFeeGrantKeeper().GetAllowance(s.Network.GetContext(), granter.AccAddr, senderAddr)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, refunded)), tc.spendLimit(allowance))
+			allowanceExpiration, err := allowance.ExpiresAt()
+			s.Require().NoError(err)
+			s.Require().True(expiration.Equal(*allowanceExpiration))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSponsoredEthereumTxRevokingAllowance() {
+	s.SetupTest()
+
+	granter, relayer := s.Keyring.GetKey(1), s.Keyring.GetKey(0)
+	senderAddr, senderPriv := utiltx.NewAccAddressAndKey()
+	denom := types.GetEVMCoinDenom()
+	gasPrice := big.NewInt(1e9)
+	gasLimit := uint64(200_000)
+
+	spendLimit := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1e18)))
+	msgGrant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, granter.AccAddr, senderAddr)
+	s.Require().NoError(err)
+	_, err = s.Factory.ExecuteCosmosTx(granter.Priv, commonfactory.CosmosTxArgs{Msgs: []sdk.Msg{msgGrant}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the code of the granter forwards its calls to the feegrant precompile, so
+	// that the sponsored transaction can revoke the allowance paying its fees
+	forwarderAddr, err := s.Factory.DeployContract(relayer.Priv, types.EvmTxArgs{}, testutiltypes.ContractDeploymentData{
+		Contract: types.CompiledContract{Bin: forwarderBytecode(common.HexToAddress(types.FeegrantPrecompileAddress))},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	accResp, err := s.Handler.GetEvmAccount(granter.Addr)
+	s.Require().NoError(err)
+	auth := s.SignSetCodeAuthorization(granter, ethtypes.SetCodeAuthorization{
+		ChainID: *uint256.NewInt(types.GetChainConfig().GetChainId()),
+		Address: forwarderAddr,
+		Nonce:   accResp.GetNonce(),
+	})
+	_, err = s.Factory.ExecuteEthTx(relayer.Priv, types.EvmTxArgs{
+		To:                &relayer.Addr,
+		GasLimit:          gasLimit,
+		AuthorizationList: []ethtypes.SetCodeAuthorization{auth},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	input, err := feegrantprecompile.ABI.Pack("revokeAllowance", granter.Addr, common.BytesToAddress(senderAddr))
+	s.Require().NoError(err)
+	msg, err := s.Factory.GenerateSignedMsgEthereumTx(senderPriv, types.EvmTxArgs{
+		To:       &granter.Addr,
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+		Input:    input,
+	})
+	s.Require().NoError(err)
+
+	txBuilder := s.Network.App.GetTxConfig().NewTxBuilder()
+	_, err = msg.BuildTx(txBuilder, denom)
+	s.Require().NoError(err)
+	sig, err := granter.Priv.Sign(types.FeeGrantSignBytes(msg.AsTransaction().Hash()))
+	s.Require().NoError(err)
+	s.Require().NoError(types.SetFeeGrant(txBuilder, granter.AccAddr, signing.SignatureV2{
+		PubKey: granter.Priv.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+	}))
+	bz, err := s.Network.App.GetTxConfig().TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	ctx := s.Network.GetContext()
+	bankKeeper := s.Network.App.GetBankKeeper()
+	granterBalance := bankKeeper.GetBalance(ctx, granter.AccAddr, denom)
+
+	res, err := s.Network.NextBlockWithTxs(bz)
+	s.Require().NoError(err)
+	s.Require().True(res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+	// the allowance revoked by the transaction is not restored by the refund of
+	// the leftover gas, which still goes to the granter
+	ctx = s.Network.GetContext()
+	_, err = s.Network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, senderAddr)
+	s.Require().ErrorIs(err, errortypes.ErrNotFound)
+
+	gasUsed := res.TxResults[0].GasUsed
+	s.Require().Less(uint64(gasUsed), gasLimit)
+	fees := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, big.NewInt(gasUsed)))
+	s.Require().Equal(granterBalance.Amount.Sub(fees), bankKeeper.GetBalance(ctx, granter.AccAddr, denom).Amount)
+}
+
+// forwarderBytecode returns the creation code of a contract forwarding its
+// calls to the target, which reverts if the call fails.
+func forwarderBytecode(target common.Address) []byte {
+	runtime := append([]byte{
+		0x36, 0x5f, 0x5f, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+		0x5f, 0x5f, 0x36, 0x5f, 0x5f, // retSize, retOffset, argsSize, argsOffset, value
+		0x73, // PUSH20 target
+	}, target.Bytes()...)
+	runtime = append(runtime,
+		0x5a, 0xf1, // CALL(GAS, ...)
+		0x15, 0x60, 0x25, 0x57, // JUMPI(0x25, ISZERO(success))
+		0x00,                   // STOP
+		0x5b, 0x5f, 0x5f, 0xfd, // JUMPDEST REVERT(0, 0)
+	)
+	initCode := []byte{
+		0x60, byte(len(runtime)), 0x60, 0x0a, 0x5f, 0x39, // CODECOPY(0, 10, len(runtime))
+		0x60, byte(len(runtime)), 0x5f, 0xf3, // RETURN(0, len(runtime))
+	}
+	return append(initCode, runtime...)
+}
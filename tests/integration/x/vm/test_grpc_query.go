@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -18,6 +19,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -1934,6 +1936,143 @@ func (s *KeeperTestSuite) TestTraceCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceCallPredecessors() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	senderKey := s.Keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, s.Factory)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	recipient := common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+	callArgs := func(method string, args ...interface{}) []byte {
+		input, err := erc20Contract.ABI.Pack(method, args...)
+		s.Require().NoError(err)
+		bz, err := json.Marshal(types.TransactionArgs{
+			From:  &senderKey.Addr,
+			To:    &contractAddr,
+			Input: (*hexutil.Bytes)(&input),
+		})
+		s.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		msg          string
+		predecessors [][]byte
+		expBalance   *big.Int
+		expPass      bool
+	}{
+		{
+			msg:        "no predecessors",
+			expBalance: big.NewInt(0),
+			expPass:    true,
+		},
+		{
+			msg: "state of the predecessors is visible",
+			predecessors: [][]byte{
+				callArgs("transfer", recipient, big.NewInt(1000)),
+				callArgs("transfer", recipient, big.NewInt(500)),
+			},
+			expBalance: big.NewInt(1500),
+			expPass:    true,
+		},
+		{
+			msg:          "invalid predecessor",
+			predecessors: [][]byte{[]byte("invalid")},
+			expPass:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().TraceCall(ctx, &types.QueryTraceCallRequest{
+				Args:            callArgs("balanceOf", recipient),
+				GasCap:          config.DefaultGasCap,
+				TraceConfig:     &types.TraceConfig{Tracer: "callTracer"},
+				BlockNumber:     ctx.BlockHeight(),
+				BlockTime:       ctx.BlockTime(),
+				BlockHash:       common.BytesToHash(ctx.HeaderHash()).Hex(),
+				ProposerAddress: sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+				ChainId:         s.Network.GetEIP155ChainID().Int64(),
+				Predecessors:    tc.predecessors,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var frame struct {
+				Output hexutil.Bytes `json:"output"`
+			}
+			s.Require().NoError(json.Unmarshal(res.Data, &frame))
+			s.Require().Equal(tc.expBalance.String(), new(big.Int).SetBytes(frame.Output).String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTraceCallTracePredecessors() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	senderKey := s.Keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, s.Factory)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	recipient := common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+	callArgs := func(method string, args ...interface{}) []byte {
+		input, err := erc20Contract.ABI.Pack(method, args...)
+		s.Require().NoError(err)
+		bz, err := json.Marshal(types.TransactionArgs{
+			From:  &senderKey.Addr,
+			To:    &contractAddr,
+			Input: (*hexutil.Bytes)(&input),
+		})
+		s.Require().NoError(err)
+		return bz
+	}
+
+	ctx := s.Network.GetContext()
+	res, err := s.Network.GetEvmClient().TraceCall(ctx, &types.QueryTraceCallRequest{
+		Args:            callArgs("balanceOf", recipient),
+		GasCap:          config.DefaultGasCap,
+		TraceConfig:     &types.TraceConfig{Tracer: "callTracer"},
+		BlockNumber:     ctx.BlockHeight(),
+		BlockTime:       ctx.BlockTime(),
+		BlockHash:       common.BytesToHash(ctx.HeaderHash()).Hex(),
+		ProposerAddress: sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+		ChainId:         s.Network.GetEIP155ChainID().Int64(),
+		Predecessors: [][]byte{
+			callArgs("balanceOf", recipient),
+			callArgs("transfer", recipient, big.NewInt(1000)),
+		},
+		TracePredecessors: true,
+	})
+	s.Require().NoError(err)
+
+	// each call is traced on top of the state of the previous ones
+	var frames []struct {
+		Output hexutil.Bytes `json:"output"`
+	}
+	s.Require().NoError(json.Unmarshal(res.Data, &frames))
+	s.Require().Len(frames, 3)
+	s.Require().Equal("0", new(big.Int).SetBytes(frames[0].Output).String())
+	s.Require().Equal("1", new(big.Int).SetBytes(frames[1].Output).String())
+	s.Require().Equal("1000", new(big.Int).SetBytes(frames[2].Output).String())
+}
+
 func (s *KeeperTestSuite) TestNonceInQuery() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -2212,6 +2351,217 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := s.Keyring.GetAddr(1)
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, supply)
+	s.Require().NoError(err)
+	deployData := erc20Contract.Bin
+	deployData = append(deployData, ctorArgs...)
+	balanceOfData, err := erc20Contract.ABI.Pack("balanceOf", sender)
+	s.Require().NoError(err)
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(1))
+	s.Require().NoError(err)
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender)
+	contractAddr := crypto.CreateAddress(sender, nonce)
+	value := hexutil.Big(*big.NewInt(1000))
+
+	newReq := func(opts rpctypes.SimOpts) *types.SimulateV1Request {
+		bz, err := json.Marshal(opts)
+		s.Require().NoError(err)
+		ctx := s.Network.GetContext()
+		return &types.SimulateV1Request{
+			Opts:            bz,
+			GasCap:          config.DefaultGasCap,
+			ProposerAddress: ctx.BlockHeader().ProposerAddress,
+			ChainId:         s.Network.GetEIP155ChainID().Int64(),
+			BlockHash:       common.Bytes2Hex(ctx.HeaderHash()),
+			BlockTime:       ctx.BlockTime(),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		getReq    func() *types.SimulateV1Request
+		expErr    bool
+		expCode   int
+		checkFunc func(results []rpctypes.SimBlockResult)
+	}{
+		{
+			"fail - invalid opts",
+			func() *types.SimulateV1Request {
+				return &types.SimulateV1Request{Opts: []byte("invalid opts"), GasCap: config.DefaultGasCap}
+			},
+			true,
+			0,
+			nil,
+		},
+		{
+			"fail - empty input",
+			func() *types.SimulateV1Request {
+				return newReq(rpctypes.SimOpts{})
+			},
+			false,
+			rpctypes.ErrCodeInvalidParams,
+			nil,
+		},
+		{
+			"fail - block numbers not in order",
+			func() *types.SimulateV1Request {
+				number := hexutil.Big(*big.NewInt(s.Network.GetContext().BlockHeight()))
+				return newReq(rpctypes.SimOpts{
+					BlockStateCalls: []rpctypes.SimBlock{
+						{BlockOverrides: &rpctypes.BlockOverrides{Number: &number}},
+					},
+				})
+			},
+			false,
+			rpctypes.ErrCodeBlockNumberInvalid,
+			nil,
+		},
+		{
+			"fail - nonce too high in validation mode",
+			func() *types.SimulateV1Request {
+				highNonce := hexutil.Uint64(nonce + 10)
+				return newReq(rpctypes.SimOpts{
+					Validation: true,
+					BlockStateCalls: []rpctypes.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: &highNonce}}},
+					},
+				})
+			},
+			false,
+			rpctypes.ErrCodeNonceTooHigh,
+			nil,
+		},
+		{
+			"pass - state carries over to the following blocks",
+			func() *types.SimulateV1Request {
+				return newReq(rpctypes.SimOpts{
+					BlockStateCalls: []rpctypes.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &sender, Data: (*hexutil.Bytes)(&deployData)}}},
+						{Calls: []types.TransactionArgs{
+							{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&transferData)},
+							{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&balanceOfData)},
+						}},
+					},
+				})
+			},
+			false,
+			0,
+			func(results []rpctypes.SimBlockResult) {
+				s.Require().Len(results, 2)
+				height := s.Network.GetContext().BlockHeight()
+				s.Require().Equal(height+1, results[0].Header.Number.Int64())
+				s.Require().Equal(height+2, results[1].Header.Number.Int64())
+				s.Require().Equal(results[0].Header.Hash(), results[1].Header.ParentHash)
+
+				deploy := results[0].Calls[0]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), deploy.Status)
+				s.Require().Equal(results[0].Header.GasUsed, uint64(deploy.GasUsed))
+
+				transfer := results[1].Calls[0]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), transfer.Status)
+				s.Require().Len(transfer.Logs, 1)
+				s.Require().Equal(contractAddr, transfer.Logs[0].Address)
+				s.Require().Equal(results[1].Header.Hash(), transfer.Logs[0].BlockHash)
+
+				balanceOf := results[1].Calls[1]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), balanceOf.Status)
+				s.Require().Equal(new(big.Int).Sub(supply, big.NewInt(1)), new(big.Int).SetBytes(balanceOf.ReturnValue))
+			},
+		},
+		{
+			"pass - fill block gaps and trace transfers",
+			func() *types.SimulateV1Request {
+				number := hexutil.Big(*big.NewInt(s.Network.GetContext().BlockHeight() + 3))
+				return newReq(rpctypes.SimOpts{
+					TraceTransfers: true,
+					BlockStateCalls: []rpctypes.SimBlock{
+						{
+							BlockOverrides: &rpctypes.BlockOverrides{Number: &number},
+							Calls:          []types.TransactionArgs{{From: &sender, To: &recipient, Value: &value}},
+						},
+					},
+				})
+			},
+			false,
+			0,
+			func(results []rpctypes.SimBlockResult) {
+				s.Require().Len(results, 3)
+				s.Require().Empty(results[0].Calls)
+				s.Require().Empty(results[1].Calls)
+
+				transfer := results[2].Calls[0]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), transfer.Status)
+				s.Require().Len(transfer.Logs, 1)
+				log := transfer.Logs[0]
+				s.Require().Equal(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), log.Address)
+				s.Require().Equal(common.BytesToHash(sender.Bytes()), log.Topics[1])
+				s.Require().Equal(common.BytesToHash(recipient.Bytes()), log.Topics[2])
+				s.Require().Equal(value.ToInt(), new(big.Int).SetBytes(log.Data))
+			},
+		},
+		{
+			"pass - reverted call",
+			func() *types.SimulateV1Request {
+				// transfer more tokens than the sender owns
+				exceedingData, err := erc20Contract.ABI.Pack("transfer", recipient, new(big.Int).Add(supply, big.NewInt(1)))
+				s.Require().NoError(err)
+				return newReq(rpctypes.SimOpts{
+					BlockStateCalls: []rpctypes.SimBlock{
+						{Calls: []types.TransactionArgs{
+							{From: &sender, Data: (*hexutil.Bytes)(&deployData)},
+							{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&exceedingData)},
+						}},
+					},
+				})
+			},
+			false,
+			0,
+			func(results []rpctypes.SimBlockResult) {
+				s.Require().Len(results, 1)
+				s.Require().Len(results[0].Calls, 2)
+				reverted := results[0].Calls[1]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), reverted.Status)
+				s.Require().NotNil(reverted.Error)
+				s.Require().Equal(rpctypes.ErrCodeReverted, reverted.Error.Code)
+				s.Require().Empty(reverted.Logs)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), tc.getReq())
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(int64(tc.expCode), res.ErrorCode, res.Error)
+			if tc.checkFunc == nil {
+				return
+			}
+
+			var results []rpctypes.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &results))
+			tc.checkFunc(results)
+		})
+	}
+
+	// the simulation must not change the state
+	s.Require().Equal(nonce, s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender))
+	s.Require().False(s.Network.App.GetEVMKeeper().IsContract(s.Network.GetContext(), contractAddr))
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -2421,6 +2771,12 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
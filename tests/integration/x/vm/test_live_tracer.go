@@ -0,0 +1,77 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *KeeperTestSuite) TestLiveTracer() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	var (
+		events    []string
+		receipts  []*ethtypes.Receipt
+		recipient = common.Address{0xaa}
+		received  = new(big.Int)
+		sender    = s.Keyring.GetAddr(0)
+		// gas is the balance changes of the sender paying for the gas
+		gas = map[tracing.BalanceChangeReason]*big.Int{}
+	)
+	hooks := &tracing.Hooks{
+		OnBlockStart: func(tracing.BlockEvent) { events = append(events, "blockStart") },
+		OnBlockEnd:   func(error) { events = append(events, "blockEnd") },
+		OnTxStart: func(*tracing.VMContext, *ethtypes.Transaction, common.Address) {
+			events = append(events, "txStart")
+		},
+		OnTxEnd: func(receipt *ethtypes.Receipt, _ error) {
+			events = append(events, "txEnd")
+			receipts = append(receipts, receipt)
+		},
+		OnBalanceChange: func(addr common.Address, prev, balance *big.Int, reason tracing.BalanceChangeReason) {
+			if addr == recipient {
+				received.Add(received, balance).Sub(received, prev)
+			}
+			if addr == sender && (reason == tracing.BalanceDecreaseGasBuy || reason == tracing.BalanceIncreaseGasReturn) {
+				gas[reason] = new(big.Int).Sub(balance, prev)
+			}
+		},
+	}
+	k := s.Network.App.GetEVMKeeper()
+	k.WithLiveTracer(hooks)
+	defer k.WithLiveTracer(nil)
+
+	// the queries are not traced
+	_, err := k.EthCall(s.Network.GetContext(), &types.EthCallRequest{Args: []byte(`{}`)})
+	s.Require().NoError(err)
+	s.Require().Empty(events)
+
+	res, err := s.Factory.ExecuteEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+		To:       &recipient,
+		Amount:   big.NewInt(100),
+		GasLimit: 100_000,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().NoError(s.Network.NextBlock())
+
+	ethRes, err := types.DecodeTxResponse(res.Data)
+	s.Require().NoError(err)
+	s.Require().Len(receipts, 1)
+	s.Require().Equal(common.HexToHash(ethRes.Hash), receipts[0].TxHash)
+	s.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipts[0].Status)
+	s.Require().Equal(big.NewInt(100), received)
+
+	// the gas limit is bought by the ante handler and the leftover gas is refunded
+	s.Require().Len(gas, 2)
+	gasPrice := new(big.Int).Div(new(big.Int).Neg(gas[tracing.BalanceDecreaseGasBuy]), big.NewInt(100_000))
+	s.Require().Positive(gasPrice.Sign())
+	s.Require().Equal(new(big.Int).Mul(gasPrice, big.NewInt(int64(100_000-receipts[0].GasUsed))), gas[tracing.BalanceIncreaseGasReturn]) //nolint:gosec // G115
+	s.Require().Equal([]string{"blockStart", "txStart", "txEnd", "blockEnd", "blockStart", "blockEnd"}, events)
+}
@@ -524,6 +524,53 @@ func (s *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRefundGasToFeePayer() {
+	baseDenom := types.GetEVMCoinDenom()
+	balances := []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(6e18))),
+		},
+	}
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = balances
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	keyring := testKeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	txFactory := factory.New(unitNetwork, grpc.NewIntegrationHandler(unitNetwork))
+
+	sender, feePayer := keyring.GetKey(0), keyring.GetAccAddr(1)
+	recipient := utiltx.GenerateAddress()
+	coreMsg, err := txFactory.GenerateGethCoreMsg(
+		sender.Priv,
+		types.EvmTxArgs{To: &recipient, Amount: big.NewInt(100)},
+	)
+	s.Require().NoError(err)
+
+	ctx := unitNetwork.GetContext()
+	bankKeeper := unitNetwork.App.GetBankKeeper()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	senderBalance := bankKeeper.GetBalance(ctx, sender.AccAddr, baseDenom)
+	feePayerBalance := bankKeeper.GetBalance(ctx, feePayer, baseDenom)
+
+	// the leftover gas is refunded to the fee payer of the transaction
+	evmKeeper.SetTxFeePayer(ctx, feePayer)
+	s.Require().Equal(feePayer, evmKeeper.GetTxFeePayer(ctx))
+	leftoverGas := uint64(1000)
+	s.Require().NoError(evmKeeper.RefundGas(ctx, *coreMsg, leftoverGas, baseDenom))
+
+	refund := sdkmath.NewIntFromBigInt(new(big.Int).Mul(coreMsg.GasPrice, new(big.Int).SetUint64(leftoverGas)))
+	s.Require().True(refund.IsPositive())
+	s.Require().Equal(senderBalance, bankKeeper.GetBalance(ctx, sender.AccAddr, baseDenom))
+	s.Require().Equal(feePayerBalance.Amount.Add(refund), bankKeeper.GetBalance(ctx, feePayer, baseDenom).Amount)
+}
+
 func (s *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	s.SetupTest()
 	testCases := []struct {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -195,6 +196,14 @@ func (a *EvmAppAdapter) GetFeeGrantKeeper() feegrantkeeper.Keeper {
 	return feegrantkeeper.Keeper{}
 }
 
+func (a *EvmAppAdapter) GetAuthzKeeper() authzkeeper.Keeper {
+	if provider, ok := a.TestApp.(evm.AuthzKeeperProvider); ok {
+		return provider.GetAuthzKeeper()
+	}
+	panicMissingProvider("AuthzKeeperProvider")
+	return authzkeeper.Keeper{}
+}
+
 func (a *EvmAppAdapter) GetConsensusParamsKeeper() consensusparamkeeper.Keeper {
 	if provider, ok := a.TestApp.(evm.ConsensusParamsKeeperProvider); ok {
 		return provider.GetConsensusParamsKeeper()
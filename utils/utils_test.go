@@ -792,6 +792,54 @@ func TestCalcBaseFee(t *testing.T) {
 	}
 }
 
+func TestCalcNextBaseFee(t *testing.T) {
+	for _, chainID := range []constants.ChainID{constants.ExampleChainID, constants.SixDecimalsChainID} {
+		t.Run(chainID.ChainID, func(t *testing.T) {
+			evmConfigurator := evmtypes.NewEVMConfigurator().
+				WithEVMCoinInfo(constants.ExampleChainCoinInfo[chainID])
+			evmConfigurator.ResetTestConfig()
+			require.NoError(t, evmConfigurator.Configure())
+
+			config := &params.ChainConfig{LondonBlock: big.NewInt(0)}
+			factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+			parent := &ethtypes.Header{
+				Number:   big.NewInt(10),
+				BaseFee:  sdkmath.LegacyNewDec(2_000_000_000).MulInt(factor).TruncateInt().BigInt(),
+				GasLimit: 100,
+				GasUsed:  0,
+			}
+			feemarketParams := feemarkettypes.Params{
+				ElasticityMultiplier:     2,
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  sdkmath.LegacyNewDec(2_000_000_000),
+				MinGasPrice:              sdkmath.LegacyNewDec(1_000_000_000),
+				BaseFeeUpdateFraction:    100,
+				BaseFeeWindowSize:        2,
+			}
+
+			// the EIP-1559 algorithm uses the parent header
+			result, err := types.CalcNextBaseFee(config, parent, feemarketParams, 100, 100)
+			require.NoError(t, err)
+			expected, err := types.CalcBaseFee(config, parent, feemarketParams)
+			require.NoError(t, err)
+			require.Equal(t, expected, result)
+
+			// the exponential algorithm uses the excess gas
+			feemarketParams.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmExponential
+			result, err = types.CalcNextBaseFee(config, parent, feemarketParams, 100, 0)
+			require.NoError(t, err)
+			expectedFee := feemarkettypes.CalcExponentialBaseFee(100, 100, feemarketParams.MinGasPrice)
+			require.Equal(t, expectedFee.MulInt(factor).TruncateInt().BigInt(), result)
+
+			// the moving window algorithm uses the average window gas
+			feemarketParams.BaseFeeAlgorithm = feemarkettypes.BaseFeeAlgorithmMovingWindow
+			result, err = types.CalcNextBaseFee(config, parent, feemarketParams, 0, 100)
+			require.NoError(t, err)
+			require.Equal(t, sdkmath.LegacyNewDec(2_250_000_000).MulInt(factor).TruncateInt().BigInt(), result)
+		})
+	}
+}
+
 func TestHexAddressFromBech32String(t *testing.T) {
 	accAddr := "cosmos16val7w9lc7wltqvpt0kscaul4xd6l2l43nhcq4"
 	valAddr := "cosmosvaloper16val7w9lc7wltqvpt0kscaul4xd6l2l458rdvx"
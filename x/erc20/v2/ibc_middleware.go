@@ -13,10 +13,15 @@ import (
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ ibcapi.IBCModule = &IBCMiddleware{}
+var (
+	_ ibcapi.IBCModule                 = &IBCMiddleware{}
+	_ ibcapi.PacketUnmarshalerModuleV2 = &IBCMiddleware{}
+)
 
 // IBCMiddleware implements the ICS26 callbacks for the transfer middleware given
 // the erc20 keeper and the underlying application.
@@ -62,6 +67,7 @@ func (im IBCMiddleware) OnSendPacket(
 // representation.
 // If the acknowledgement fails, this callback will default to the ibc-core
 // packet callback.
+// If the erc20 keeper returns an error acknowledgement, the packet receive fails.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	sourceClient string,
@@ -86,7 +92,11 @@ func (im IBCMiddleware) OnRecvPacket(
 			Status: channeltypesv2.PacketStatus_Failure,
 		}
 	}
-	im.keeper.OnRecvPacket(ctx, packet, ack)
+	if erc20Ack := im.keeper.OnRecvPacket(ctx, packet, ack); !erc20Ack.Success() {
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
 	return recvResult
 }
 
@@ -159,6 +169,16 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	return im.keeper.OnTimeoutPacket(ctx, packet, data)
 }
 
+// UnmarshalPacketData implements the PacketUnmarshalerModuleV2 interface, so that the
+// callbacks middleware can wrap the erc20 middleware.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (interface{}, error) {
+	unmarshaler, ok := im.app.(ibcapi.PacketUnmarshalerModuleV2)
+	if !ok {
+		return nil, errorsmod.Wrapf(channeltypesv2.ErrInvalidPayload, "underlying application does not implement %T", (*ibcapi.PacketUnmarshalerModuleV2)(nil))
+	}
+	return unmarshaler.UnmarshalPacketData(payload)
+}
+
 func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64) (channeltypes.Packet, error) {
 	transferRepresentation, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
 	if err != nil {
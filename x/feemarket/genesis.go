@@ -23,6 +23,10 @@ func InitGenesis(
 	}
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
+	k.SetExcessGas(ctx, data.ExcessGas)
+	for _, entry := range data.BlockGasWindow {
+		k.SetWindowBlockGas(ctx, entry.Height, entry.Gas)
+	}
 
 	return []abci.ValidatorUpdate{}
 }
@@ -30,7 +34,9 @@ func InitGenesis(
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:         k.GetParams(ctx),
+		BlockGas:       k.GetBlockGasWanted(ctx),
+		ExcessGas:      k.GetExcessGas(ctx),
+		BlockGasWindow: k.GetBlockGasWindow(ctx),
 	}
 }
@@ -13,6 +13,8 @@ import (
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// The base fee is adjusted from the parent block gas wanted (EIP-1559), from the average gas wanted by the blocks of
+// a moving window, or computed from the accumulated excess gas (EIP-4844) depending on the BaseFeeAlgorithm parameter.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
@@ -23,8 +25,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -41,29 +41,70 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
+	parentGasTarget, ok := k.blockGasTarget(ctx, params)
+	if !ok {
+		return sdkmath.LegacyDec{}
+	}
+
 	parentGasUsed := k.GetBlockGasWanted(ctx)
 
+	switch params.BaseFeeAlgorithm {
+	case types.BaseFeeAlgorithmExponential:
+		// the excess gas of the parent block is accumulated during EndBlock
+		return types.CalcExponentialBaseFee(k.GetExcessGas(ctx), params.BaseFeeUpdateFraction, params.MinGasPrice)
+	case types.BaseFeeAlgorithmMovingWindow:
+		parentGasUsed = k.GetWindowAverageGas(ctx)
+	}
+
+	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+	return types.CalcGasBaseFee(
+		parentGasUsed,
+		parentGasTarget,
+		uint64(params.BaseFeeChangeDenominator),
+		parentBaseFee,
+		sdkmath.LegacyOneDec().QuoInt(factor),
+		params.MinGasPrice,
+	)
+}
+
+// blockGasTarget returns the gas target of the blocks, i.e. the maximum block
+// gas divided by the elasticity multiplier.
+func (k Keeper) blockGasTarget(ctx sdk.Context, params types.Params) (uint64, bool) {
 	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
 
 	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	consParams := ctx.ConsensusParams()
 	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
 		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
 	}
 
 	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
 	// validation
-	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetInt.IsUint64() {
-		return sdkmath.LegacyDec{}
+	gasTarget := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
+	if !gasTarget.IsUint64() {
+		return 0, false
 	}
+	return gasTarget.Uint64(), true
+}
 
-	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
-	return types.CalcGasBaseFee(
-		parentGasUsed,
-		parentGasTargetInt.Uint64(),
-		uint64(params.BaseFeeChangeDenominator),
-		parentBaseFee,
-		sdkmath.LegacyOneDec().QuoInt(factor),
-		params.MinGasPrice,
-	)
+// updateBaseFeeState records the gas wanted by the current block in the state
+// of the exponential and moving window base fee algorithms.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) updateBaseFeeState(ctx sdk.Context, params types.Params, gasWanted uint64) {
+	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+		return
+	}
+
+	switch params.BaseFeeAlgorithm {
+	case types.BaseFeeAlgorithmExponential:
+		gasTarget, ok := k.blockGasTarget(ctx, params)
+		if !ok {
+			return
+		}
+		excessGas := types.CalcExcessGas(k.GetExcessGas(ctx), gasWanted, gasTarget, params.BaseFeeUpdateFraction)
+		k.SetExcessGas(ctx, excessGas)
+	case types.BaseFeeAlgorithmMovingWindow:
+		k.SetWindowBlockGas(ctx, ctx.BlockHeight(), gasWanted)
+		k.PruneBlockGasWindow(ctx, ctx.BlockHeight()-int64(params.BaseFeeWindowSize)+1)
+	}
 }
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetFeeHistoryEntry sets the base fee and gas usage of a block to the fee history.
+// The fee history is a ring buffer of HistoryServeWindow blocks, the entry overrides
+// the one of the block HistoryServeWindow blocks before.
+func (k Keeper) SetFeeHistoryEntry(ctx sdk.Context, window uint64, entry types.FeeHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	ringIndex := uint64(entry.Height) % window //nolint:gosec // G115 // heights are positive
+	store.Set(types.FeeHistoryKey(ringIndex), k.cdc.MustMarshal(&entry))
+}
+
+// GetFeeHistoryEntry returns the base fee and gas usage of the block at the given
+// height from the fee history, false if the block is not in the fee history.
+func (k Keeper) GetFeeHistoryEntry(ctx sdk.Context, height int64) (types.FeeHistoryEntry, bool) {
+	window := k.GetParams(ctx).HistoryServeWindow
+	if window == 0 || height <= 0 {
+		return types.FeeHistoryEntry{}, false
+	}
+	return k.getFeeHistoryEntry(ctx, window, height)
+}
+
+// GetFeeHistory returns the base fee and gas usage of the blocks of the given range
+// from the fee history, skipping the blocks that are not in the fee history.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, fromHeight, toHeight int64) []types.FeeHistoryEntry {
+	window := k.GetParams(ctx).HistoryServeWindow
+	if window == 0 {
+		return nil
+	}
+
+	// only the last window blocks can be in the fee history
+	if toHeight-fromHeight+1 > int64(window) { //nolint:gosec // G115 // window is bounded by the params validation
+		fromHeight = toHeight - int64(window) + 1 //nolint:gosec // G115 // window is bounded by the params validation
+	}
+
+	var entries []types.FeeHistoryEntry
+	for height := max(fromHeight, 1); height <= toHeight; height++ {
+		if entry, found := k.getFeeHistoryEntry(ctx, window, height); found {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (k Keeper) getFeeHistoryEntry(ctx sdk.Context, window uint64, height int64) (types.FeeHistoryEntry, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeHistoryKey(uint64(height) % window)) //nolint:gosec // G115 // heights are positive
+	if bz == nil {
+		return types.FeeHistoryEntry{}, false
+	}
+
+	var entry types.FeeHistoryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	// the ring buffer slot may hold an older block
+	if entry.Height != height {
+		return types.FeeHistoryEntry{}, false
+	}
+	return entry, true
+}
+
+// pruneFeeHistory deletes the ring buffer slots from the given window on, as they
+// are never overridden once the window is lowered.
+func (k Keeper) pruneFeeHistory(ctx sdk.Context, window uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(window), nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordFeeHistory sets the base fee and gas usage of the current block to the fee
+// history.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) recordFeeHistory(ctx sdk.Context, params types.Params, gasWanted, gasUsed uint64) {
+	if params.HistoryServeWindow == 0 {
+		return
+	}
+
+	// same gas limit as the one of the Ethereum blocks served by the JSON-RPC
+	gasLimit := uint64(math.MaxUint32)
+	consParams := ctx.ConsensusParams()
+	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		gasLimit = uint64(consParams.Block.MaxGas)
+	}
+
+	entry := types.FeeHistoryEntry{
+		Height:    ctx.BlockHeight(),
+		GasWanted: gasWanted,
+		GasUsed:   gasUsed,
+		GasLimit:  gasLimit,
+	}
+	if params.IsBaseFeeEnabled(ctx.BlockHeight()) && !params.BaseFee.IsNil() {
+		baseFee := params.BaseFee
+		entry.BaseFee = &baseFee
+	}
+	k.SetFeeHistoryEntry(ctx, params.HistoryServeWindow, entry)
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -44,6 +47,28 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 	}
 
 	return &types.QueryBlockGasResponse{
-		Gas: gas.Int64(),
+		Gas:       gas.Int64(),
+		ExcessGas: k.GetExcessGas(ctx),
+		WindowGas: k.GetWindowAverageGas(ctx),
+	}, nil
+}
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FromHeight <= 0 || req.ToHeight < req.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+
+	if req.ToHeight-req.FromHeight >= types.MaxHistoryServeWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "height range cannot have more than %d blocks", types.MaxHistoryServeWindow)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeeHistoryResponse{
+		Entries: k.GetFeeHistory(ctx, req.FromHeight, req.ToHeight),
 	}, nil
 }
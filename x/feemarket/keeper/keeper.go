@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -59,3 +61,84 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixBlockGasWanted))
 }
+
+// ----------------------------------------------------------------------------
+// Excess Gas
+// Required by the exponential base fee calculation.
+// ----------------------------------------------------------------------------
+
+// SetExcessGas sets the excess gas accumulated above the gas target to the store.
+func (k Keeper) SetExcessGas(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixExcessGas, sdk.Uint64ToBigEndian(gas))
+}
+
+// GetExcessGas returns the excess gas accumulated above the gas target from the store.
+func (k Keeper) GetExcessGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixExcessGas))
+}
+
+// ----------------------------------------------------------------------------
+// Block Gas Window
+// Required by the moving window base fee calculation.
+// ----------------------------------------------------------------------------
+
+// SetWindowBlockGas sets the gas wanted by the block at the given height to the
+// moving window.
+func (k Keeper) SetWindowBlockGas(ctx sdk.Context, height int64, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockGasWindowKey(height), sdk.Uint64ToBigEndian(gas))
+}
+
+// GetBlockGasWindow returns the gas wanted by the blocks of the moving window,
+// ordered by height.
+func (k Keeper) GetBlockGasWindow(ctx sdk.Context) []types.BlockGasEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWindow)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var entries []types.BlockGasEntry
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, types.BlockGasEntry{
+			Height: int64(sdk.BigEndianToUint64(iterator.Key())), //nolint:gosec // G115 // heights are positive
+			Gas:    sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return entries
+}
+
+// PruneBlockGasWindow deletes the gas wanted by the blocks below the given
+// height from the moving window. It's a no-op if the height isn't positive, as
+// during the first window of the chain.
+func (k Keeper) PruneBlockGasWindow(ctx sdk.Context, height int64) {
+	if height <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWindow)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height))) //nolint:gosec // G115 // height is positive
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetWindowAverageGas returns the average gas wanted by the blocks of the
+// moving window, or the last block gas wanted if the window is empty.
+func (k Keeper) GetWindowAverageGas(ctx sdk.Context) uint64 {
+	entries := k.GetBlockGasWindow(ctx)
+	if len(entries) == 0 {
+		return k.GetBlockGasWanted(ctx)
+	}
+
+	total := sdkmath.ZeroInt()
+	for _, entry := range entries {
+		total = total.Add(sdkmath.NewIntFromUint64(entry.Gas))
+	}
+	return total.QuoRaw(int64(len(entries))).Uint64()
+}
@@ -27,6 +27,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	if window := k.GetParams(ctx).HistoryServeWindow; params.HistoryServeWindow < window {
+		k.pruneFeeHistory(ctx, params.HistoryServeWindow)
+	}
 	store.Set(types.ParamsKey, bz)
 
 	return nil
@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 1 to
+// version 2. Specifically, it sets the default values of the base fee burn and treasury
+// ratios, the base fee being left to the validators by version 1.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	if params.BaseFeeBurnRatio.IsNil() {
+		params.BaseFeeBurnRatio = types.DefaultBaseFeeBurnRatio
+	}
+	if params.BaseFeeTreasuryRatio.IsNil() {
+		params.BaseFeeTreasuryRatio = types.DefaultBaseFeeTreasuryRatio
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package v3
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 2 to
+// version 3. Specifically, it sets the default values of the base fee algorithm and fee
+// history parameters, the EIP-1559 algorithm being the one used by version 2.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.BaseFeeAlgorithm = types.BaseFeeAlgorithmEIP1559
+	params.BaseFeeUpdateFraction = types.DefaultBaseFeeUpdateFraction
+	params.BaseFeeWindowSize = types.DefaultBaseFeeWindowSize
+	params.HistoryServeWindow = types.DefaultHistoryServeWindow
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
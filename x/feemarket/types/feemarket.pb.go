@@ -25,6 +25,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm defines the algorithm used to compute the base fee of the
+// blocks.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee from the gas wanted by the
+	// parent block, as defined in EIP-1559
+	BaseFeeAlgorithmEIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_EXPONENTIAL computes the base fee as an exponential of
+	// the excess gas accumulated above the gas target, as the EIP-4844 blob base
+	// fee
+	BaseFeeAlgorithmExponential BaseFeeAlgorithm = 1
+	// BASE_FEE_ALGORITHM_MOVING_WINDOW adjusts the base fee from the average gas
+	// wanted by the blocks of a moving window
+	BaseFeeAlgorithmMovingWindow BaseFeeAlgorithm = 2
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_EXPONENTIAL",
+	2: "BASE_FEE_ALGORITHM_MOVING_WINDOW",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559":       0,
+	"BASE_FEE_ALGORITHM_EXPONENTIAL":   1,
+	"BASE_FEE_ALGORITHM_MOVING_WINDOW": 2,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -46,6 +83,29 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_burn_ratio is the share of the base fee paid by the transactions
+	// that is burned. The rest of the base fee and the priority tip go to the
+	// validators. The base fee is paid for the gas used by the EVM transactions
+	// and for the gas limit of the Cosmos ones, whose fees are not refunded.
+	BaseFeeBurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_ratio"`
+	// base_fee_treasury_ratio is the share of the base fee paid by the
+	// transactions that is sent to the treasury.
+	BaseFeeTreasuryRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=base_fee_treasury_ratio,json=baseFeeTreasuryRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_treasury_ratio"`
+	// treasury_address is the bech32 address receiving the treasury share of the
+	// base fee, the community pool receives it if empty.
+	TreasuryAddress string `protobuf:"bytes,11,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// base_fee_algorithm defines the algorithm used to compute the base fee.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,12,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=cosmos.evm.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// base_fee_update_fraction controls the rate of change of the base fee with
+	// the exponential algorithm, the base fee is multiplied by e for each
+	// base_fee_update_fraction gas of excess.
+	BaseFeeUpdateFraction uint64 `protobuf:"varint,13,opt,name=base_fee_update_fraction,json=baseFeeUpdateFraction,proto3" json:"base_fee_update_fraction,omitempty"`
+	// base_fee_window_size is the number of blocks of the moving window
+	// algorithm.
+	BaseFeeWindowSize uint32 `protobuf:"varint,14,opt,name=base_fee_window_size,json=baseFeeWindowSize,proto3" json:"base_fee_window_size,omitempty"`
+	// history_serve_window is the number of blocks whose base fee and gas usage
+	// are kept in the fee history, 0 disables the fee history.
+	HistoryServeWindow uint64 `protobuf:"varint,15,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,8 +169,121 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithmEIP1559
+}
+
+func (m *Params) GetBaseFeeUpdateFraction() uint64 {
+	if m != nil {
+		return m.BaseFeeUpdateFraction
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeWindowSize() uint32 {
+	if m != nil {
+		return m.BaseFeeWindowSize
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryServeWindow() uint64 {
+	if m != nil {
+		return m.HistoryServeWindow
+	}
+	return 0
+}
+
+// FeeHistoryEntry defines the base fee and gas usage of a block kept in the fee
+// history.
+type FeeHistoryEntry struct {
+	// height is the block height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the base fee of the block, nil if the base fee is disabled.
+	BaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee,omitempty"`
+	// gas_wanted is the block gas wanted used by the base fee calculation.
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas used by the block.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the gas limit of the block.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{1}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+func (m *FeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evm.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "cosmos.evm.feemarket.v1.FeeHistoryEntry")
 }
 
 func init() {
@@ -118,34 +291,59 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6f, 0x1a, 0x47,
+	0x18, 0xc6, 0x59, 0x87, 0xd8, 0x78, 0x1c, 0xc7, 0x64, 0x4a, 0xea, 0x2d, 0x34, 0xeb, 0x95, 0x7b,
+	0x08, 0xf1, 0x01, 0xea, 0x46, 0x51, 0xd5, 0x54, 0x3d, 0x80, 0x0d, 0x36, 0x15, 0xfe, 0xa3, 0xb5,
+	0x13, 0xaa, 0x5e, 0x46, 0xc3, 0xee, 0xeb, 0x65, 0x14, 0x76, 0x06, 0xcd, 0x0c, 0x38, 0xe4, 0x13,
+	0x54, 0x3e, 0xf5, 0x0b, 0xf8, 0xd4, 0x4b, 0x8f, 0xf9, 0x00, 0x3d, 0xf5, 0x94, 0x63, 0x8e, 0x55,
+	0x0f, 0x51, 0x65, 0x1f, 0xf2, 0x35, 0xaa, 0xfd, 0xc3, 0x82, 0xdc, 0x58, 0x15, 0x17, 0xb4, 0xfb,
+	0x3e, 0xef, 0xf3, 0xdb, 0x99, 0x79, 0xe7, 0x01, 0x3d, 0x76, 0x85, 0x0a, 0x84, 0xaa, 0xc2, 0x28,
+	0xa8, 0x9e, 0x01, 0x04, 0x54, 0xbe, 0x02, 0x5d, 0x1d, 0x6d, 0x4f, 0x5f, 0x2a, 0x03, 0x29, 0xb4,
+	0xc0, 0xeb, 0x71, 0x63, 0x05, 0x46, 0x41, 0x65, 0xaa, 0x8d, 0xb6, 0x8b, 0x0f, 0x68, 0xc0, 0xb8,
+	0xa8, 0x46, 0xbf, 0x71, 0x6f, 0xb1, 0xe0, 0x0b, 0x5f, 0x44, 0x8f, 0xd5, 0xf0, 0x29, 0xae, 0x6e,
+	0xfe, 0xb9, 0x84, 0x16, 0x8f, 0xa9, 0xa4, 0x81, 0xc2, 0x16, 0x5a, 0xe1, 0x82, 0x74, 0xa9, 0x02,
+	0x72, 0x06, 0x60, 0x1a, 0xb6, 0x51, 0xce, 0x39, 0xcb, 0x5c, 0xd4, 0xa9, 0x82, 0x26, 0x00, 0xfe,
+	0x01, 0x95, 0x26, 0x22, 0x71, 0x7b, 0x94, 0xfb, 0x40, 0x3c, 0xe0, 0x22, 0x60, 0x9c, 0x6a, 0x21,
+	0xcd, 0x05, 0xdb, 0x28, 0xaf, 0x3a, 0x66, 0x37, 0xee, 0xde, 0x89, 0x1a, 0x76, 0xa7, 0x3a, 0x7e,
+	0x8a, 0x1e, 0x42, 0x9f, 0x2a, 0xcd, 0x5c, 0xa6, 0xc7, 0x24, 0x18, 0xf6, 0x35, 0x1b, 0xf4, 0x19,
+	0x48, 0xf3, 0x4e, 0x64, 0x2c, 0x4c, 0xc5, 0x83, 0x54, 0xc3, 0x5f, 0xa1, 0x55, 0xe0, 0xb4, 0xdb,
+	0x07, 0xd2, 0x03, 0xe6, 0xf7, 0xb4, 0x79, 0xd7, 0x36, 0xca, 0x77, 0x9c, 0x7b, 0x71, 0x71, 0x3f,
+	0xaa, 0xe1, 0x1d, 0x94, 0x4b, 0x57, 0xbd, 0x68, 0x1b, 0xe5, 0xe5, 0x7a, 0xf9, 0xdd, 0x87, 0x8d,
+	0xcc, 0xdf, 0x1f, 0x36, 0x4a, 0xf1, 0xf9, 0x28, 0xef, 0x55, 0x85, 0x89, 0x6a, 0x40, 0x75, 0xaf,
+	0xd2, 0x06, 0x9f, 0xba, 0xe3, 0x5d, 0x70, 0x7f, 0xff, 0xf8, 0x76, 0xcb, 0x70, 0x96, 0x92, 0xf5,
+	0xe2, 0x36, 0x5a, 0x0d, 0x18, 0x27, 0x3e, 0x55, 0x64, 0x20, 0x99, 0x0b, 0xe6, 0xd2, 0x9c, 0xa4,
+	0x95, 0x80, 0xf1, 0x3d, 0xaa, 0x8e, 0x43, 0x33, 0x7e, 0x89, 0xf0, 0x84, 0x36, 0xb3, 0xd3, 0xdc,
+	0x9c, 0xc8, 0x7c, 0x8c, 0x9c, 0x39, 0x8f, 0x0e, 0xfa, 0x2c, 0x9d, 0x41, 0x77, 0x28, 0x39, 0x91,
+	0x54, 0x33, 0x61, 0x2e, 0xcf, 0x0b, 0x4e, 0x76, 0x5d, 0x1f, 0x4a, 0xee, 0x84, 0x04, 0x4c, 0xd0,
+	0x7a, 0x0a, 0xd6, 0x12, 0xa8, 0x1a, 0xca, 0x71, 0x02, 0x47, 0x73, 0xc2, 0x0b, 0x09, 0xfc, 0x34,
+	0xc1, 0xc4, 0x1f, 0x78, 0x82, 0xf2, 0x29, 0x97, 0x7a, 0x9e, 0x04, 0xa5, 0xcc, 0x95, 0x90, 0xec,
+	0xac, 0x4d, 0xea, 0xb5, 0xb8, 0x8c, 0x3b, 0x08, 0xa7, 0x6b, 0xa1, 0x7d, 0x5f, 0x48, 0xa6, 0x7b,
+	0x81, 0x79, 0xcf, 0x36, 0xca, 0xf7, 0xbf, 0x79, 0x52, 0xb9, 0xe5, 0xca, 0x57, 0x92, 0x6b, 0x5a,
+	0x9b, 0x18, 0xd2, 0x4d, 0xa6, 0x15, 0xfc, 0x2d, 0x32, 0x53, 0xf0, 0x70, 0xe0, 0x51, 0x0d, 0xe4,
+	0x4c, 0x52, 0x57, 0x33, 0xc1, 0xcd, 0x55, 0xdb, 0x28, 0x67, 0x9d, 0x87, 0x89, 0xe7, 0x45, 0xa4,
+	0x36, 0x13, 0x11, 0x57, 0x51, 0x21, 0x35, 0x9e, 0x33, 0xee, 0x89, 0x73, 0xa2, 0xd8, 0x1b, 0x30,
+	0xef, 0x47, 0x57, 0xf7, 0x41, 0x62, 0xea, 0x44, 0xca, 0x09, 0x7b, 0x03, 0xf8, 0x6b, 0x54, 0xe8,
+	0x31, 0xa5, 0x85, 0x1c, 0x13, 0x05, 0x72, 0x34, 0x71, 0x99, 0x6b, 0xd1, 0x57, 0x70, 0xa2, 0x9d,
+	0x84, 0x52, 0xec, 0x7a, 0xbe, 0x79, 0xf1, 0xf1, 0xed, 0xd6, 0xa3, 0x99, 0xe0, 0xbf, 0x9e, 0x89,
+	0x7e, 0x9c, 0xd0, 0x1f, 0xb3, 0xb9, 0x6c, 0xfe, 0xae, 0x93, 0x67, 0x9c, 0x69, 0x46, 0xfb, 0x69,
+	0x54, 0x37, 0xff, 0x30, 0xd0, 0x5a, 0x13, 0x60, 0x3f, 0xa6, 0x36, 0xb8, 0x96, 0x63, 0xfc, 0x39,
+	0x5a, 0x4c, 0x22, 0x63, 0x44, 0x91, 0x49, 0xde, 0xf0, 0xf3, 0x99, 0xb0, 0x2c, 0x44, 0x93, 0xdd,
+	0xf8, 0x9f, 0xa9, 0x4e, 0x33, 0xf2, 0x08, 0xa1, 0xf0, 0x46, 0x9f, 0x53, 0xae, 0xc1, 0x8b, 0x72,
+	0x9b, 0x75, 0x96, 0x7d, 0xaa, 0x3a, 0x51, 0x01, 0x7f, 0x81, 0x72, 0xa1, 0x3c, 0x54, 0xe0, 0x99,
+	0xd9, 0x48, 0x5c, 0xf2, 0xa9, 0x7a, 0xa1, 0xc0, 0xc3, 0x25, 0x14, 0xf6, 0x91, 0x3e, 0x0b, 0x58,
+	0x9c, 0xe1, 0xac, 0x13, 0xf6, 0xb6, 0xc3, 0xf7, 0xad, 0x2b, 0x03, 0xe5, 0x6f, 0x4e, 0x0f, 0x7f,
+	0x8f, 0x8a, 0xf5, 0xda, 0x49, 0x83, 0x34, 0x1b, 0x0d, 0x52, 0x6b, 0xef, 0x1d, 0x39, 0xad, 0xd3,
+	0xfd, 0x03, 0xd2, 0x68, 0x1d, 0x6f, 0x3f, 0x7b, 0xf6, 0x5d, 0x3e, 0x53, 0x2c, 0x5d, 0x5c, 0xda,
+	0xeb, 0x37, 0x5d, 0x89, 0x8c, 0x77, 0x90, 0xf5, 0x29, 0xf3, 0x4f, 0xc7, 0x47, 0x87, 0x8d, 0xc3,
+	0xd3, 0x56, 0xad, 0x9d, 0x37, 0x8a, 0x1b, 0x17, 0x97, 0x76, 0xe9, 0x3f, 0x80, 0xd7, 0x03, 0xc1,
+	0x81, 0x87, 0xc7, 0x8b, 0x9b, 0xc8, 0xfe, 0x04, 0xe4, 0xe0, 0xe8, 0x65, 0xeb, 0x70, 0x8f, 0x74,
+	0x5a, 0x87, 0xbb, 0x47, 0x9d, 0xfc, 0x42, 0xd1, 0xbe, 0xb8, 0xb4, 0xbf, 0xbc, 0x89, 0x39, 0x10,
+	0x23, 0xc6, 0xfd, 0x78, 0xb2, 0xc5, 0xec, 0x2f, 0xbf, 0x59, 0x99, 0x7a, 0xed, 0xdd, 0x95, 0x65,
+	0xbc, 0xbf, 0xb2, 0x8c, 0x7f, 0xae, 0x2c, 0xe3, 0xd7, 0x6b, 0x2b, 0xf3, 0xfe, 0xda, 0xca, 0xfc,
+	0x75, 0x6d, 0x65, 0x7e, 0x7e, 0xec, 0x33, 0xdd, 0x1b, 0x76, 0x2b, 0xae, 0x08, 0xaa, 0xb7, 0xcc,
+	0x5f, 0x8f, 0x07, 0xa0, 0xba, 0x8b, 0xd1, 0x5f, 0xf6, 0xd3, 0x7f, 0x07, 0x00, 0xef, 0x02, 0xd7,
+	0xb0, 0x1f, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +366,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryServeWindow != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.HistoryServeWindow))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BaseFeeWindowSize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeWindowSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BaseFeeUpdateFraction != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeUpdateFraction))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.BaseFeeTreasuryRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeTreasuryRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -226,6 +471,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFeemarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -261,6 +561,51 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeBurnRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeTreasuryRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	if m.BaseFeeUpdateFraction != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeUpdateFraction))
+	}
+	if m.BaseFeeWindowSize != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeWindowSize))
+	}
+	if m.HistoryServeWindow != 0 {
+		n += 1 + sovFeemarket(uint64(m.HistoryServeWindow))
+	}
+	return n
+}
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -478,6 +823,344 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTreasuryRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeTreasuryRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUpdateFraction", wireType)
+			}
+			m.BaseFeeUpdateFraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeUpdateFraction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeWindowSize", wireType)
+			}
+			m.BaseFeeWindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeWindowSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryServeWindow", wireType)
+			}
+			m.HistoryServeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryServeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -19,5 +21,24 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(gs.BlockGasWindow) > MaxBaseFeeWindowSize {
+		return fmt.Errorf("block gas window cannot have more than %d blocks: %d", MaxBaseFeeWindowSize, len(gs.BlockGasWindow))
+	}
+
+	seenHeights := make(map[int64]bool, len(gs.BlockGasWindow))
+	for _, entry := range gs.BlockGasWindow {
+		if entry.Height <= 0 {
+			return fmt.Errorf("block gas window height must be positive: %d", entry.Height)
+		}
+		if seenHeights[entry.Height] {
+			return fmt.Errorf("duplicated block gas window height: %d", entry.Height)
+		}
+		seenHeights[entry.Height] = true
+	}
+
+	return nil
 }
@@ -31,6 +31,12 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// excess_gas is the excess gas accumulated by the exponential base fee
+	// algorithm.
+	ExcessGas uint64 `protobuf:"varint,4,opt,name=excess_gas,json=excessGas,proto3" json:"excess_gas,omitempty"`
+	// block_gas_window is the gas wanted by the blocks of the moving window base
+	// fee algorithm.
+	BlockGasWindow []BlockGasEntry `protobuf:"bytes,5,rep,name=block_gas_window,json=blockGasWindow,proto3" json:"block_gas_window"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,8 +86,78 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetExcessGas() uint64 {
+	if m != nil {
+		return m.ExcessGas
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBlockGasWindow() []BlockGasEntry {
+	if m != nil {
+		return m.BlockGasWindow
+	}
+	return nil
+}
+
+// BlockGasEntry defines the gas wanted by a block.
+type BlockGasEntry struct {
+	// height is the block height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas is the gas wanted by the block.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *BlockGasEntry) Reset()         { *m = BlockGasEntry{} }
+func (m *BlockGasEntry) String() string { return proto.CompactTextString(m) }
+func (*BlockGasEntry) ProtoMessage()    {}
+func (*BlockGasEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c64d3a2a89a388, []int{1}
+}
+func (m *BlockGasEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasEntry.Merge(m, src)
+}
+func (m *BlockGasEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasEntry proto.InternalMessageInfo
+
+func (m *BlockGasEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockGasEntry) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.feemarket.v1.GenesisState")
+	proto.RegisterType((*BlockGasEntry)(nil), "cosmos.evm.feemarket.v1.BlockGasEntry")
 }
 
 func init() {
@@ -89,24 +165,29 @@ func init() {
 }
 
 var fileDescriptor_07c64d3a2a89a388 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
-	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94,
-	0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44,
-	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x3d, 0x17, 0x8f, 0x3b, 0xc4, 0xee, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6, 0x82,
-	0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0x6e,
-	0xd1, 0x0b, 0x00, 0x2b, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x9d, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5,
-	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e,
-	0x26, 0x01, 0xe6, 0x20, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xc7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf2, 0x64, 0x05, 0x92, 0x37, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x5e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbd, 0xe4, 0x86, 0x5e,
-	0x01, 0x00, 0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xbf, 0x4e, 0xc2, 0x40,
+	0x18, 0xef, 0x51, 0x24, 0x70, 0xa8, 0xc1, 0xc6, 0x28, 0xc1, 0x58, 0x08, 0x89, 0x42, 0x1c, 0x7a,
+	0x01, 0x27, 0x47, 0x9b, 0x18, 0x12, 0x27, 0x53, 0x07, 0x13, 0x1d, 0xc8, 0xb5, 0x7e, 0x94, 0x06,
+	0xaf, 0x47, 0x7a, 0x67, 0x81, 0xb7, 0xf0, 0x31, 0x1c, 0x7d, 0x0c, 0x46, 0x46, 0x27, 0x63, 0x60,
+	0xf0, 0x21, 0x5c, 0x4c, 0xaf, 0x88, 0x38, 0xb0, 0x34, 0x5f, 0xbf, 0xdf, 0x9f, 0xef, 0x77, 0xf9,
+	0xe1, 0x13, 0x8f, 0x0b, 0xc6, 0x05, 0x81, 0x98, 0x91, 0x1e, 0x00, 0xa3, 0xd1, 0x00, 0x24, 0x89,
+	0x5b, 0xc4, 0x87, 0x10, 0x44, 0x20, 0xac, 0x61, 0xc4, 0x25, 0x37, 0x0e, 0x53, 0x9a, 0x05, 0x31,
+	0xb3, 0x56, 0x34, 0x2b, 0x6e, 0x55, 0xf6, 0x28, 0x0b, 0x42, 0x4e, 0xd4, 0x37, 0xe5, 0x56, 0x1a,
+	0x9b, 0x2c, 0xff, 0x84, 0x29, 0x71, 0xdf, 0xe7, 0x3e, 0x57, 0x23, 0x49, 0xa6, 0x74, 0x5b, 0xff,
+	0x46, 0x78, 0xbb, 0x93, 0x1e, 0xbf, 0x95, 0x54, 0x82, 0x61, 0xe3, 0xdc, 0x90, 0x46, 0x94, 0x89,
+	0x32, 0xaa, 0xa1, 0x66, 0xb1, 0x5d, 0xb5, 0x36, 0x84, 0xb1, 0x6e, 0x14, 0xcd, 0x2e, 0x4c, 0x3f,
+	0xaa, 0xda, 0xeb, 0xd7, 0xdb, 0x19, 0x72, 0x96, 0x4a, 0xe3, 0x08, 0x17, 0xdc, 0x27, 0xee, 0x0d,
+	0xba, 0x3e, 0x15, 0x65, 0xbd, 0x86, 0x9a, 0x59, 0x27, 0xaf, 0x16, 0x1d, 0x2a, 0x8c, 0x63, 0x8c,
+	0x61, 0xec, 0x81, 0x10, 0x0a, 0xcd, 0x2a, 0xb4, 0x90, 0x6e, 0x12, 0xf8, 0x01, 0x97, 0x56, 0xda,
+	0xee, 0x28, 0x08, 0x1f, 0xf9, 0xa8, 0xbc, 0x55, 0xd3, 0x9b, 0xc5, 0xf6, 0xe9, 0xc6, 0x24, 0xf6,
+	0xd2, 0xfb, 0x2a, 0x94, 0xd1, 0x64, 0x3d, 0xd0, 0xee, 0xef, 0xd5, 0x3b, 0x65, 0x74, 0x9d, 0xcd,
+	0x67, 0x4a, 0xba, 0x93, 0x77, 0xa9, 0x80, 0x6e, 0x0f, 0xa0, 0x7e, 0x81, 0x77, 0xfe, 0x69, 0x8d,
+	0x03, 0x9c, 0xeb, 0x43, 0xe0, 0xf7, 0xa5, 0x7a, 0xbd, 0xee, 0x2c, 0xff, 0x8c, 0x12, 0xd6, 0x93,
+	0xb4, 0x19, 0x95, 0x36, 0x19, 0xed, 0xcb, 0xe9, 0xdc, 0x44, 0xb3, 0xb9, 0x89, 0x3e, 0xe7, 0x26,
+	0x7a, 0x59, 0x98, 0xda, 0x6c, 0x61, 0x6a, 0xef, 0x0b, 0x53, 0xbb, 0x6f, 0xf8, 0x81, 0xec, 0x3f,
+	0xbb, 0x96, 0xc7, 0x19, 0x59, 0x2b, 0x67, 0xbc, 0x56, 0x8f, 0x9c, 0x0c, 0x41, 0xb8, 0x39, 0x55,
+	0xc1, 0xf9, 0xcf, 0x00, 0x04, 0xad, 0xb9, 0x01, 0x16, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +210,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockGasWindow) > 0 {
+		for iNdEx := len(m.BlockGasWindow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockGasWindow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExcessGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExcessGas))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -147,6 +247,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockGasEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockGasEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -169,6 +302,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if m.ExcessGas != 0 {
+		n += 1 + sovGenesis(uint64(m.ExcessGas))
+	}
+	if len(m.BlockGasWindow) > 0 {
+		for _, e := range m.BlockGasWindow {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockGasEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovGenesis(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -259,6 +416,147 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessGas", wireType)
+			}
+			m.ExcessGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockGasWindow = append(m.BlockGasWindow, BlockGasEntry{})
+			if err := m.BlockGasWindow[len(m.BlockGasWindow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockGasEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockGasEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockGasEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,11 +28,36 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
 			},
 			true,
 		},
+		{
+			"valid genesis with block gas window",
+			&GenesisState{
+				Params:         DefaultParams(),
+				ExcessGas:      uint64(100),
+				BlockGasWindow: []BlockGasEntry{{Height: 1, Gas: 10}, {Height: 2, Gas: 20}},
+			},
+			true,
+		},
+		{
+			"invalid block gas window height",
+			&GenesisState{
+				Params:         DefaultParams(),
+				BlockGasWindow: []BlockGasEntry{{Height: 0, Gas: 10}},
+			},
+			false,
+		},
+		{
+			"duplicated block gas window height",
+			&GenesisState{
+				Params:         DefaultParams(),
+				BlockGasWindow: []BlockGasEntry{{Height: 1, Gas: 10}, {Height: 1, Gas: 20}},
+			},
+			false,
+		},
 		{
 			"valid New genesis",
 			NewGenesisState(
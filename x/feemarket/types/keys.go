@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -16,6 +18,9 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixExcessGas
+	prefixBlockGasWindow
+	prefixFeeHistory
 )
 
 const (
@@ -25,9 +30,23 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixExcessGas      = []byte{prefixExcessGas}
+	KeyPrefixBlockGasWindow = []byte{prefixBlockGasWindow}
+	KeyPrefixFeeHistory     = []byte{prefixFeeHistory}
 )
 
+// BlockGasWindowKey returns the key of the gas wanted by the block at the given
+// height in the moving window.
+func BlockGasWindowKey(height int64) []byte {
+	return append(KeyPrefixBlockGasWindow, sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // heights are positive
+}
+
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
 )
+
+// FeeHistoryKey returns the key of the given ring buffer index in the fee history.
+func FeeHistoryKey(index uint64) []byte {
+	return append(KeyPrefixFeeHistory, sdk.Uint64ToBigEndian(index)...)
+}
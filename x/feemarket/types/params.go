@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -19,10 +21,31 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeBurnRatio is 0 (i.e the base fee goes to the validators)
+	DefaultBaseFeeBurnRatio = math.LegacyZeroDec()
+	// DefaultBaseFeeTreasuryRatio is 0 (i.e the base fee goes to the validators)
+	DefaultBaseFeeTreasuryRatio = math.LegacyZeroDec()
+	// DefaultBaseFeeAlgorithm is the EIP-1559 base fee algorithm
+	DefaultBaseFeeAlgorithm = BaseFeeAlgorithmEIP1559
+	// DefaultBaseFeeUpdateFraction is 0 (i.e it must be set to use the
+	// exponential algorithm)
+	DefaultBaseFeeUpdateFraction = uint64(0)
+	// DefaultBaseFeeWindowSize is 0 (i.e it must be set to use the moving
+	// window algorithm)
+	DefaultBaseFeeWindowSize = uint32(0)
+	// DefaultHistoryServeWindow keeps the fee history of the last 1024 blocks
+	DefaultHistoryServeWindow = uint64(1024)
 
 	ParamsKey = []byte("Params")
 )
 
+// MaxBaseFeeWindowSize is the maximum number of blocks of the moving window
+// base fee algorithm.
+const MaxBaseFeeWindowSize = 1024
+
+// MaxHistoryServeWindow is the maximum number of blocks kept in the fee history.
+const MaxHistoryServeWindow = 8192
+
 // NewParams creates a new Params instance
 func NewParams(
 	noBaseFee bool,
@@ -41,6 +64,12 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+		BaseFeeTreasuryRatio:     DefaultBaseFeeTreasuryRatio,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		BaseFeeUpdateFraction:    DefaultBaseFeeUpdateFraction,
+		BaseFeeWindowSize:        DefaultBaseFeeWindowSize,
+		HistoryServeWindow:       DefaultHistoryServeWindow,
 	}
 }
 
@@ -54,6 +83,12 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+		BaseFeeTreasuryRatio:     DefaultBaseFeeTreasuryRatio,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		BaseFeeUpdateFraction:    DefaultBaseFeeUpdateFraction,
+		BaseFeeWindowSize:        DefaultBaseFeeWindowSize,
+		HistoryServeWindow:       DefaultHistoryServeWindow,
 	}
 }
 
@@ -79,7 +114,19 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeSplit(p.BaseFeeBurnRatio, p.BaseFeeTreasuryRatio, p.TreasuryAddress); err != nil {
+		return err
+	}
+
+	if p.HistoryServeWindow > MaxHistoryServeWindow {
+		return fmt.Errorf("history serve window cannot be greater than %d: %d", MaxHistoryServeWindow, p.HistoryServeWindow)
+	}
+
+	return p.validateBaseFeeAlgorithm()
 }
 
 func (p Params) IsBaseFeeEnabled(height int64) bool {
@@ -98,6 +145,19 @@ func validateMinGasPrice(gasPrice math.LegacyDec) error {
 	return nil
 }
 
+// BaseFeeSplit returns the shares of the base fee that are burned and sent to
+// the treasury, zero if unset.
+func (p Params) BaseFeeSplit() (burnRatio, treasuryRatio math.LegacyDec) {
+	burnRatio, treasuryRatio = math.LegacyZeroDec(), math.LegacyZeroDec()
+	if !p.BaseFeeBurnRatio.IsNil() {
+		burnRatio = p.BaseFeeBurnRatio
+	}
+	if !p.BaseFeeTreasuryRatio.IsNil() {
+		treasuryRatio = p.BaseFeeTreasuryRatio
+	}
+	return burnRatio, treasuryRatio
+}
+
 func validateMinGasMultiplier(multiplier math.LegacyDec) error {
 	if multiplier.IsNil() {
 		return fmt.Errorf("invalid parameter: nil")
@@ -113,3 +173,54 @@ func validateMinGasMultiplier(multiplier math.LegacyDec) error {
 
 	return nil
 }
+
+func (p Params) validateBaseFeeAlgorithm() error {
+	if p.BaseFeeWindowSize > MaxBaseFeeWindowSize {
+		return fmt.Errorf("base fee window size cannot be greater than %d: %d", MaxBaseFeeWindowSize, p.BaseFeeWindowSize)
+	}
+
+	switch p.BaseFeeAlgorithm {
+	case BaseFeeAlgorithmEIP1559:
+		return nil
+	case BaseFeeAlgorithmExponential:
+		if p.BaseFeeUpdateFraction == 0 {
+			return fmt.Errorf("base fee update fraction cannot be 0 with the exponential algorithm")
+		}
+		// the min gas price is the factor of the exponential
+		if !p.MinGasPrice.IsPositive() {
+			return fmt.Errorf("min gas price must be positive with the exponential algorithm: %s", p.MinGasPrice)
+		}
+		return nil
+	case BaseFeeAlgorithmMovingWindow:
+		if p.BaseFeeWindowSize == 0 {
+			return fmt.Errorf("base fee window size cannot be 0 with the moving window algorithm")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid base fee algorithm: %d", p.BaseFeeAlgorithm)
+	}
+}
+
+func validateBaseFeeSplit(burnRatio, treasuryRatio math.LegacyDec, treasuryAddress string) error {
+	for _, ratio := range []math.LegacyDec{burnRatio, treasuryRatio} {
+		if ratio.IsNil() {
+			return fmt.Errorf("invalid parameter: nil")
+		}
+
+		if ratio.IsNegative() {
+			return fmt.Errorf("value cannot be negative: %s", ratio)
+		}
+	}
+
+	if total := burnRatio.Add(treasuryRatio); total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn and treasury ratios cannot be greater than 1: %s", total)
+	}
+
+	if treasuryAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(treasuryAddress); err != nil {
+			return fmt.Errorf("invalid treasury address %s: %w", treasuryAddress, err)
+		}
+	}
+
+	return nil
+}
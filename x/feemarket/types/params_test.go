@@ -73,6 +73,85 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, math.LegacyNewDec(2000000000), int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: base fee burned and sent to the community pool",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				ElasticityMultiplier:     2,
+				BaseFee:                  DefaultBaseFee,
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:         math.LegacyNewDecWithPrec(7, 1),
+				BaseFeeTreasuryRatio:     math.LegacyNewDecWithPrec(3, 1),
+			},
+			false,
+		},
+		{
+			"invalid: base fee burn ratio is nil",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				ElasticityMultiplier:     2,
+				BaseFee:                  DefaultBaseFee,
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeTreasuryRatio:     DefaultBaseFeeTreasuryRatio,
+			},
+			true,
+		},
+		{
+			"invalid: base fee treasury ratio is negative",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				ElasticityMultiplier:     2,
+				BaseFee:                  DefaultBaseFee,
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+				BaseFeeTreasuryRatio:     math.LegacyNewDecWithPrec(-1, 1),
+			},
+			true,
+		},
+		{
+			"invalid: base fee ratios bigger than 1",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				ElasticityMultiplier:     2,
+				BaseFee:                  DefaultBaseFee,
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:         math.LegacyNewDecWithPrec(7, 1),
+				BaseFeeTreasuryRatio:     math.LegacyNewDecWithPrec(4, 1),
+			},
+			true,
+		},
+		{
+			"invalid: treasury address",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				ElasticityMultiplier:     2,
+				BaseFee:                  DefaultBaseFee,
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+				BaseFeeTreasuryRatio:     math.LegacyNewDecWithPrec(5, 1),
+				TreasuryAddress:          "invalid",
+			},
+			true,
+		},
+		{
+			"invalid: history serve window bigger than the max",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				ElasticityMultiplier:     2,
+				BaseFee:                  DefaultBaseFee,
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+				BaseFeeTreasuryRatio:     DefaultBaseFeeTreasuryRatio,
+				HistoryServeWindow:       MaxHistoryServeWindow + 1,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -113,3 +192,96 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsBaseFeeSplit() {
+	burnRatio, treasuryRatio := Params{}.BaseFeeSplit()
+	suite.Require().True(burnRatio.IsZero())
+	suite.Require().True(treasuryRatio.IsZero())
+
+	params := DefaultParams()
+	params.BaseFeeBurnRatio = math.LegacyNewDecWithPrec(5, 1)
+	burnRatio, treasuryRatio = params.BaseFeeSplit()
+	suite.Require().Equal(math.LegacyNewDecWithPrec(5, 1), burnRatio)
+	suite.Require().True(treasuryRatio.IsZero())
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeAlgorithm() {
+	testCases := []struct {
+		name     string
+		malleate func(*Params)
+		expError bool
+	}{
+		{
+			"valid: EIP-1559",
+			func(*Params) {},
+			false,
+		},
+		{
+			"valid: exponential",
+			func(p *Params) {
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmExponential
+				p.BaseFeeUpdateFraction = 1_000_000
+				p.MinGasPrice = math.LegacyNewDec(1)
+			},
+			false,
+		},
+		{
+			"invalid: exponential without update fraction",
+			func(p *Params) {
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmExponential
+				p.MinGasPrice = math.LegacyNewDec(1)
+			},
+			true,
+		},
+		{
+			"invalid: exponential without min gas price",
+			func(p *Params) {
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmExponential
+				p.BaseFeeUpdateFraction = 1_000_000
+			},
+			true,
+		},
+		{
+			"valid: moving window",
+			func(p *Params) {
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmMovingWindow
+				p.BaseFeeWindowSize = 10
+			},
+			false,
+		},
+		{
+			"invalid: moving window without window size",
+			func(p *Params) {
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmMovingWindow
+			},
+			true,
+		},
+		{
+			"invalid: window size bigger than the max",
+			func(p *Params) {
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmMovingWindow
+				p.BaseFeeWindowSize = MaxBaseFeeWindowSize + 1
+			},
+			true,
+		},
+		{
+			"invalid: unknown algorithm",
+			func(p *Params) {
+				p.BaseFeeAlgorithm = BaseFeeAlgorithm(3)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		tc.malleate(&params)
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
@@ -1,6 +1,15 @@
 package types
 
-import "cosmossdk.io/math"
+import (
+	gomath "math"
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+// maxExcessGasExponent bounds the exponent of the exponential base fee, so
+// that the excess gas can be drained in a reasonable number of blocks.
+const maxExcessGasExponent = 64
 
 func CalcGasBaseFee(gasUsed, gasTarget, baseFeeChangeDenom uint64, baseFee, minUnitGas, minGasPrice math.LegacyDec) math.LegacyDec {
 	// If the parent gasUsed is the same as the target, the baseFee remains unchanged.
@@ -28,3 +37,50 @@ func CalcGasBaseFee(gasUsed, gasTarget, baseFeeChangeDenom uint64, baseFee, minU
 	// max(minGasPrice, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
 	return math.LegacyMaxDec(baseFee.Sub(num), minGasPrice)
 }
+
+// CalcExcessGas returns the excess gas accumulated after a block that wanted
+// gasWanted, as defined by EIP-4844. The excess gas is capped at
+// updateFraction * maxExcessGasExponent.
+func CalcExcessGas(parentExcessGas, gasWanted, gasTarget, updateFraction uint64) uint64 {
+	excessGas := new(big.Int).SetUint64(parentExcessGas)
+	excessGas.Add(excessGas, new(big.Int).SetUint64(gasWanted))
+	excessGas.Sub(excessGas, new(big.Int).SetUint64(gasTarget))
+	if excessGas.Sign() < 0 {
+		return 0
+	}
+
+	maxExcessGas := new(big.Int).Mul(new(big.Int).SetUint64(updateFraction), big.NewInt(maxExcessGasExponent))
+	if excessGas.Cmp(maxExcessGas) > 0 {
+		excessGas = maxExcessGas
+	}
+	if !excessGas.IsUint64() {
+		return gomath.MaxUint64
+	}
+	return excessGas.Uint64()
+}
+
+// CalcExponentialBaseFee returns minGasPrice * e**(excessGas / updateFraction),
+// approximated with the Taylor expansion used by the EIP-4844 blob base fee.
+func CalcExponentialBaseFee(excessGas, updateFraction uint64, minGasPrice math.LegacyDec) math.LegacyDec {
+	if updateFraction == 0 {
+		return minGasPrice
+	}
+
+	// the factor is the min gas price scaled by the decimal precision
+	factor := minGasPrice.BigInt()
+	numerator := new(big.Int).SetUint64(excessGas)
+	denominator := new(big.Int).SetUint64(updateFraction)
+
+	output := new(big.Int)
+	accum := new(big.Int).Mul(factor, denominator)
+	for i := 1; accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+
+		accum.Mul(accum, numerator)
+		accum.Div(accum, denominator)
+		accum.Div(accum, big.NewInt(int64(i)))
+	}
+	output.Div(output, denominator)
+
+	return math.LegacyNewDecFromBigIntWithPrec(output, math.LegacyPrecision)
+}
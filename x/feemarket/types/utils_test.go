@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestCalcExcessGas(t *testing.T) {
+	testCases := []struct {
+		name            string
+		parentExcessGas uint64
+		gasWanted       uint64
+		expExcessGas    uint64
+	}{
+		{"below target without excess", 0, 50, 0},
+		{"below target drains the excess", 100, 50, 50},
+		{"below target drains all the excess", 30, 50, 0},
+		{"above target accumulates the excess", 100, 150, 150},
+		{"excess is capped", 6_390, 200, 6_400},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expExcessGas, CalcExcessGas(tc.parentExcessGas, tc.gasWanted, 100, 100))
+		})
+	}
+}
+
+func TestCalcExponentialBaseFee(t *testing.T) {
+	minGasPrice := math.LegacyNewDec(1_000_000_000)
+
+	// without excess gas the base fee is the min gas price
+	require.Equal(t, minGasPrice, CalcExponentialBaseFee(0, 1_000, minGasPrice))
+
+	// the base fee is multiplied by ~e for each update fraction of excess gas
+	baseFee := CalcExponentialBaseFee(1_000, 1_000, minGasPrice)
+	require.True(t, baseFee.GT(math.LegacyNewDec(2_718_281_828)), baseFee)
+	require.True(t, baseFee.LT(math.LegacyNewDec(2_718_281_829)), baseFee)
+
+	baseFee = CalcExponentialBaseFee(2_000, 1_000, minGasPrice)
+	require.True(t, baseFee.GT(math.LegacyNewDec(7_389_056_098)), baseFee)
+	require.True(t, baseFee.LT(math.LegacyNewDec(7_389_056_099)), baseFee)
+
+	// the base fee increases with the excess gas
+	require.True(t, CalcExponentialBaseFee(1_001, 1_000, minGasPrice).GT(CalcExponentialBaseFee(1_000, 1_000, minGasPrice)))
+}
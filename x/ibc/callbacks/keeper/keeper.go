@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -108,7 +110,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	contractAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := unmarshalTransferPacketData(packet.GetData(), version)
 	if err != nil {
 		return err
 	}
@@ -276,7 +278,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
@@ -371,7 +373,7 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
@@ -426,3 +428,36 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	writeFn()
 	return nil
 }
+
+// unmarshalSourcePacketData unmarshals the data of a packet sent by this chain, either
+// an ICS-20 transfer or an ICS-27 interchain account transaction sent by a controller.
+func unmarshalSourcePacketData(packet channeltypes.Packet, version string) (any, error) {
+	if strings.HasPrefix(packet.GetSourcePort(), icatypes.ControllerPortPrefix) {
+		var data icatypes.InterchainAccountPacketData
+		if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	return unmarshalTransferPacketData(packet.GetData(), version)
+}
+
+// unmarshalTransferPacketData unmarshals the data of an ICS-20 transfer packet. The
+// payloads of IBC v2 packets can use any of the ICS-20 encodings, which the callbacks
+// middleware does not pass along, so they are tried in turn starting with JSON, the
+// encoding of IBC v1 packets.
+func unmarshalTransferPacketData(bz []byte, version string) (transfertypes.InternalTransferRepresentation, error) {
+	data, err := transfertypes.UnmarshalPacketData(bz, version, transfertypes.EncodingJSON)
+	if err == nil {
+		return data, nil
+	}
+
+	for _, encoding := range []string{transfertypes.EncodingProtobuf, transfertypes.EncodingABI} {
+		if data, encodingErr := transfertypes.UnmarshalPacketData(bz, version, encoding); encodingErr == nil {
+			return data, nil
+		}
+	}
+
+	return transfertypes.InternalTransferRepresentation{}, err
+}
@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	"github.com/cosmos/evm/x/ibc/transfer/types"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -9,6 +14,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the modified IBC transfer keeper that embeds the original one.
@@ -48,3 +54,41 @@ func NewKeeper(
 		accountKeeper: authKeeper,
 	}
 }
+
+// ConvertNativeERC20 converts the native ERC20 tokens the sender is missing to
+// transfer the given coin to their Cosmos representation, the same way the Transfer
+// msg server does for IBC v1 transfers. It is a no-op if the coin is not the Cosmos
+// representation of an enabled native ERC20 token pair, or if the sender already
+// has enough balance of it.
+func (k Keeper) ConvertNativeERC20(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
+	if !k.erc20Keeper.IsERC20Enabled(ctx) {
+		return nil
+	}
+
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, strings.TrimPrefix(coin.Denom, erc20types.Erc20NativeCoinDenomPrefix))
+	if len(pairID) == 0 {
+		return nil
+	}
+
+	// the escrowed coin must be the Cosmos representation of the pair
+	pair, _ := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !pair.Enabled || !pair.IsNativeERC20() || pair.Denom != coin.Denom {
+		return nil
+	}
+
+	balance := k.bankKeeper.SpendableCoin(ctx, sender, pair.Denom)
+	if balance.Amount.GTE(coin.Amount) {
+		return nil
+	}
+
+	// only convert the remaining difference
+	msgConvertERC20 := erc20types.NewMsgConvertERC20(
+		coin.Amount.Sub(balance.Amount),
+		sender,
+		pair.GetERC20Contract(),
+		common.BytesToAddress(sender.Bytes()),
+	)
+
+	_, err := k.erc20Keeper.ConvertERC20(ctx, msgConvertERC20)
+	return err
+}
@@ -2,8 +2,12 @@ package v2
 
 import (
 	"github.com/cosmos/evm/x/ibc/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	v2 "github.com/cosmos/ibc-go/v10/modules/apps/transfer/v2"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ ibcapi.IBCModule = IBCModule{}
@@ -11,6 +15,7 @@ var _ ibcapi.IBCModule = IBCModule{}
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
 type IBCModule struct {
 	*v2.IBCModule
+	keeper keeper.Keeper
 }
 
 // NewIBCModule creates a new IBCModule given the keeper
@@ -18,5 +23,35 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 	transferModule := v2.NewIBCModule(k.Keeper)
 	return IBCModule{
 		IBCModule: &transferModule,
+		keeper:    k,
 	}
 }
+
+// OnSendPacket implements the IBCModule interface.
+// It converts the native ERC20 tokens the signer is missing to their Cosmos
+// representation before the default ICS20 OnSendPacket callback escrows or
+// burns the transferred coin.
+func (im IBCModule) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	coin, err := data.Token.ToCoin()
+	if err != nil {
+		return err
+	}
+
+	if err := im.keeper.ConvertNativeERC20(ctx, signer, coin); err != nil {
+		return err
+	}
+
+	return im.IBCModule.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
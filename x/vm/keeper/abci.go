@@ -29,19 +29,26 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	}
 
 	k.SetHeaderHash(ctx)
+	k.traceBlockStart(ctx)
 	return nil
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore. With the virtual fee collection, it splits the base fee paid by the block transactions.
+// The EVM end block logic doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	if k.evmMempool != nil && !k.evmMempool.HasEventBus() {
 		k.evmMempool.GetBlockchain().NotifyNewBlock()
 	}
 
+	if k.virtualFeeCollection {
+		k.distributeBlockBaseFee(ctx)
+	}
+
 	k.CollectTxBloom(ctx)
 	k.ResetTransientGasUsed(ctx)
+	k.ResetTxFeePayers(ctx)
+	k.traceBlockEnd()
 
 	return nil
 }
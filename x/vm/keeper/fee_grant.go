@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// txFeeGrant is the allowance of the fee granter of a sponsored transaction,
+// charged by the ante handler for the fees of the gas limit.
+type txFeeGrant struct {
+	granter sdk.AccAddress
+	grantee sdk.AccAddress
+	fees    sdk.Coins
+	// allowance is the allowance before the ante handler charged the fees
+	allowance feegrant.FeeAllowanceI
+}
+
+// TrackTxFeeGrant records the allowance of the fee granter paying the fees of the
+// current cosmos tx, before the fees are charged to it. The unused fees are given
+// back to the allowance once the transaction is executed. It's a no-op if the
+// feegrant keeper is not set.
+func (k Keeper) TrackTxFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, fees sdk.Coins) {
+	if k.feeGrantKeeper == nil {
+		return
+	}
+	// the allowance is missing if the ante handler rejects the fee grant
+	allowance, _ := k.feeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	store := ctx.ObjectStore(k.objectKey)
+	store.Set(types.ObjectFeeGrantKey(ctx.TxIndex()), &txFeeGrant{
+		granter:   granter,
+		grantee:   grantee,
+		fees:      fees,
+		allowance: allowance,
+	})
+}
+
+// refundFeeGrant gives the refunded fees of the leftover gas back to the allowance
+// which paid the fees of the current cosmos tx, as it stands after the execution.
+// If the allowance was exhausted by the fees charged by the ante handler, it's
+// granted again with the refunded fees as spend limit. The refund is skipped if
+// the allowance was revoked during the execution.
+func (k Keeper) refundFeeGrant(ctx sdk.Context, refunded sdk.Coins) error {
+	if k.feeGrantKeeper == nil {
+		return nil
+	}
+	v := ctx.ObjectStore(k.objectKey).Get(types.ObjectFeeGrantKey(ctx.TxIndex()))
+	if v == nil {
+		return nil
+	}
+	grant := v.(*txFeeGrant)
+
+	if !refunded.IsAllLTE(grant.fees) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "refunded fees %s exceed the granted fees %s", refunded, grant.fees)
+	}
+
+	allowance, err := k.feeGrantKeeper.GetAllowance(ctx, grant.granter, grant.grantee)
+	if errors.Is(err, errortypes.ErrNotFound) {
+		return k.restoreFeeGrant(ctx, grant, refunded)
+	}
+	if err != nil {
+		return err
+	}
+
+	allowance, ok := refundAllowance(allowance, refunded)
+	if !ok {
+		k.Logger(ctx).Debug("skipping the refund of the unsupported fee allowance", "granter", grant.granter.String(), "grantee", grant.grantee.String())
+		return nil
+	}
+	return k.feeGrantKeeper.UpdateAllowance(ctx, grant.granter, grant.grantee, allowance)
+}
+
+// restoreFeeGrant grants again the allowance exhausted by the fees charged by the
+// ante handler, with the refunded fees as spend limit. Nothing is granted if the
+// fees didn't exhaust the allowance, which was then revoked during the execution.
+func (k Keeper) restoreFeeGrant(ctx sdk.Context, grant *txFeeGrant, refunded sdk.Coins) error {
+	if grant.allowance == nil || refunded.IsZero() {
+		return nil
+	}
+	allowance, ok := exhaustedAllowance(ctx, grant.allowance, grant.fees, refunded)
+	if !ok {
+		return nil
+	}
+	return k.feeGrantKeeper.GrantAllowance(ctx, grant.granter, grant.grantee, allowance)
+}
+
+// exhaustedAllowance charges the fees to the allowance, as the ante handler did,
+// and returns it with the refunded fees as spend limit if the fees exhausted it.
+// It returns false otherwise, or if the allowance type is not supported.
+func exhaustedAllowance(ctx sdk.Context, allowance feegrant.FeeAllowanceI, fees, refunded sdk.Coins) (feegrant.FeeAllowanceI, bool) {
+	if a, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+		// the messages were accepted by the ante handler
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, false
+		}
+		inner, ok := exhaustedAllowance(ctx, inner, fees, refunded)
+		if !ok {
+			return nil, false
+		}
+		if err := a.SetAllowance(inner); err != nil {
+			return nil, false
+		}
+		return a, true
+	}
+
+	remove, err := allowance.Accept(ctx, fees, nil)
+	if err != nil || !remove {
+		return nil, false
+	}
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		a.SpendLimit = refunded
+		return a, true
+	case *feegrant.PeriodicAllowance:
+		a.Basic.SpendLimit = refunded
+		a.PeriodCanSpend = a.PeriodCanSpend.Add(refunded...).Min(a.PeriodSpendLimit)
+		return a, true
+	default:
+		return nil, false
+	}
+}
+
+// refundAllowance credits the refunded fees to the spend limits of the allowance.
+// It returns false if the allowance type is not supported.
+func refundAllowance(allowance feegrant.FeeAllowanceI, refunded sdk.Coins) (feegrant.FeeAllowanceI, bool) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		a.SpendLimit = refundSpendLimit(a.SpendLimit, refunded)
+		return a, true
+	case *feegrant.PeriodicAllowance:
+		a.Basic.SpendLimit = refundSpendLimit(a.Basic.SpendLimit, refunded)
+		// the fees were charged to the current period, it can't go beyond its limit
+		a.PeriodCanSpend = a.PeriodCanSpend.Add(refunded...).Min(a.PeriodSpendLimit)
+		return a, true
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, false
+		}
+		inner, ok := refundAllowance(inner, refunded)
+		if !ok {
+			return nil, false
+		}
+		if err := a.SetAllowance(inner); err != nil {
+			return nil, false
+		}
+		return a, true
+	default:
+		return nil, false
+	}
+}
+
+// refundSpendLimit credits the refunded fees to the spend limit, an empty spend
+// limit is unlimited.
+func refundSpendLimit(spendLimit, refunded sdk.Coins) sdk.Coins {
+	if spendLimit.Empty() {
+		return spendLimit
+	}
+	return spendLimit.Add(refunded...)
+}
@@ -6,12 +6,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -58,11 +60,12 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 	// Deduct fees from the user balance. Notice that it is used
 	// the bankWrapper to properly convert fees from the 18 decimals
 	// representation to the original one before calling into the bank keeper.
-	if k.virtualFeeCollection {
-		err = DeductFees(k.bankWrapper, k, ctx, signerAcc, fees)
-	} else {
-		err = authante.DeductFees(k.bankWrapper, ctx, signerAcc, fees)
-	}
+	err = k.traceBalanceChange(ctx, from, tracing.BalanceDecreaseGasBuy, func() error {
+		if k.virtualFeeCollection {
+			return DeductFees(k.bankWrapper, k, ctx, signerAcc, fees)
+		}
+		return authante.DeductFees(k.bankWrapper, ctx, signerAcc, fees)
+	})
 	if err != nil {
 		return errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
 	}
@@ -155,3 +158,175 @@ func DeductFees(bankKeeper types.BankKeeper, vmKeeper types.VMKeeper, ctx sdk.Co
 
 	return nil
 }
+
+// DistributeBaseFee splits the base fee paid by a transaction, held by the fee
+// collector, according to the feemarket params: the burn share is burned and
+// the treasury share is sent to the treasury address, or to the community pool
+// if none is set. The rest of the base fee is left to the validators along with
+// the priority tip. The base fee amount is expressed in 18 decimals.
+func (k *Keeper) DistributeBaseFee(ctx sdk.Context, baseFee *big.Int) error {
+	if baseFee == nil || baseFee.Sign() <= 0 {
+		return nil
+	}
+
+	params := k.feeMarketWrapper.GetParams(ctx)
+	burnRatio, treasuryRatio := params.BaseFeeSplit()
+	amount := sdkmath.NewIntFromBigInt(baseFee)
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	if burnAmt := burnRatio.MulInt(amount).TruncateInt(); burnAmt.IsPositive() {
+		if err := k.bankWrapper.BurnAmountFromAccount(ctx, feeCollector, burnAmt.BigInt()); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn base fee %s", burnAmt)
+		}
+	}
+
+	treasuryAmt := treasuryRatio.MulInt(amount).TruncateInt()
+	if !treasuryAmt.IsPositive() {
+		return nil
+	}
+
+	if params.TreasuryAddress != "" {
+		treasury, err := sdk.AccAddressFromBech32(params.TreasuryAddress)
+		if err != nil {
+			return errorsmod.Wrapf(err, "invalid treasury address %s", params.TreasuryAddress)
+		}
+		coins := sdk.Coins{sdk.NewCoin(types.GetEVMCoinDenom(), treasuryAmt)}
+		if err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasury, coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to send base fee %s to the treasury", coins)
+		}
+		return nil
+	}
+
+	if k.distrKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "no distribution keeper to fund the community pool with the base fee")
+	}
+	// the community pool is funded in the bank decimals, the remainder is left
+	// to the validators
+	poolAmt := types.ConvertBigIntFrom18DecimalsToLegacyDec(treasuryAmt.BigInt()).TruncateInt()
+	if !poolAmt.IsPositive() {
+		return nil
+	}
+	coins := sdk.Coins{sdk.NewCoin(types.GetEVMCoinDenom(), poolAmt)}
+	if err := k.distrKeeper.FundCommunityPool(ctx, coins, feeCollector); err != nil {
+		return errorsmod.Wrapf(err, "failed to fund the community pool with base fee %s", coins)
+	}
+	return nil
+}
+
+// distributeTxBaseFee splits the base fee paid for the gas used by an EVM
+// transaction. With the virtual fee collection, the fees are only credited to
+// the fee collector at the end of the block, so the base fee is accumulated
+// and split by distributeBlockBaseFee instead.
+func (k *Keeper) distributeTxBaseFee(ctx sdk.Context, gasUsed uint64) error {
+	baseFee := k.GetBaseFee(ctx)
+	if baseFee == nil {
+		return nil
+	}
+
+	amount := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed))
+	if k.virtualFeeCollection {
+		k.addTxBaseFee(ctx, amount)
+		return nil
+	}
+	return k.DistributeBaseFee(ctx, amount)
+}
+
+// distributeBlockBaseFee splits the base fee paid by the EVM transactions of
+// the block with the virtual fee collection, once the bank end blocker has
+// credited the fees to the fee collector. If the split fails, the base fee is
+// held by the EVM module account rather than left to the validators, and split
+// again at the end of the next block.
+func (k *Keeper) distributeBlockBaseFee(ctx sdk.Context) {
+	blockBaseFee := k.collectBlockBaseFee(ctx)
+	heldBaseFee := k.GetHeldBaseFee(ctx)
+	baseFee := new(big.Int).Add(blockBaseFee, heldBaseFee)
+	if baseFee.Sign() <= 0 {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	err := k.releaseHeldBaseFee(cacheCtx, heldBaseFee)
+	if err == nil {
+		err = k.DistributeBaseFee(cacheCtx, baseFee)
+	}
+	if err == nil {
+		write()
+		return
+	}
+	k.Logger(ctx).Error("failed to distribute the block base fee", "amount", baseFee.String(), "error", err.Error())
+
+	cacheCtx, write = ctx.CacheContext()
+	if err := k.holdBaseFee(cacheCtx, blockBaseFee); err != nil {
+		k.Logger(ctx).Error("failed to hold the block base fee", "amount", blockBaseFee.String(), "error", err.Error())
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFeeHeld,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+		),
+	)
+}
+
+// GetHeldBaseFee returns the base fee held by the EVM module account after a
+// failed split, expressed in 18 decimals.
+func (k Keeper) GetHeldBaseFee(ctx sdk.Context) *big.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixHeldBaseFee)
+	return new(big.Int).SetBytes(bz)
+}
+
+// holdBaseFee moves the base fee from the fee collector to the EVM module
+// account, so that it isn't allocated to the validators.
+func (k *Keeper) holdBaseFee(ctx sdk.Context, amount *big.Int) error {
+	if amount.Sign() > 0 {
+		feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		coins := sdk.Coins{sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(amount))}
+		if err := k.bankWrapper.SendCoinsFromAccountToModule(ctx, feeCollector, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+	held := new(big.Int).Add(k.GetHeldBaseFee(ctx), amount)
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixHeldBaseFee, held.Bytes())
+	return nil
+}
+
+// releaseHeldBaseFee gives the held base fee back to the fee collector.
+func (k *Keeper) releaseHeldBaseFee(ctx sdk.Context, amount *big.Int) error {
+	if amount.Sign() <= 0 {
+		return nil
+	}
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	coins := sdk.Coins{sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(amount))}
+	if err := k.bankWrapper.SendCoinsFromAccountToModule(ctx, moduleAddr, authtypes.FeeCollectorName, coins); err != nil {
+		return errorsmod.Wrapf(err, "failed to release the held base fee %s", coins)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.KeyPrefixHeldBaseFee)
+	return nil
+}
+
+// addTxBaseFee accumulates the base fee paid by the current cosmos tx.
+func (k Keeper) addTxBaseFee(ctx sdk.Context, amount *big.Int) {
+	store := ctx.ObjectStore(k.objectKey)
+	key := types.ObjectBaseFeeKey(ctx.TxIndex())
+	if v := store.Get(key); v != nil {
+		amount = new(big.Int).Add(v.(*big.Int), amount)
+	}
+	store.Set(key, amount)
+}
+
+// collectBlockBaseFee returns the base fee paid by the block transactions and
+// clears it.
+func (k Keeper) collectBlockBaseFee(ctx sdk.Context) *big.Int {
+	store := prefix.NewObjStore(ctx.ObjectStore(k.objectKey), types.KeyPrefixObjectBaseFee)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	total := new(big.Int)
+	for ; it.Valid(); it.Next() {
+		total.Add(total, it.Value().(*big.Int))
+		store.Delete(it.Key())
+	}
+	return total
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/cosmos/evm/x/vm/types"
@@ -28,8 +30,9 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 		homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, capped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// RefundGas transfers the leftover gas to the fee payer of the transaction, the sender of the message
+// unless the fees were paid by a fee granter, capped to half of the total gas consumed in the transaction.
+// The fees of the leftover gas are given back to the allowance of the fee granter. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
@@ -44,17 +47,27 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		var err error
-		if k.virtualFeeCollection {
-			err = k.bankWrapper.SendCoinsFromModuleToAccountVirtual(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
-		} else {
-			err = k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		feePayer := k.GetTxFeePayer(ctx)
+		if feePayer == nil {
+			feePayer = msg.From.Bytes()
 		}
+
+		// refund to fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		err := k.traceBalanceChange(ctx, common.BytesToAddress(feePayer), tracing.BalanceIncreaseGasReturn, func() error {
+			if k.virtualFeeCollection {
+				return k.bankWrapper.SendCoinsFromModuleToAccountVirtual(ctx, authtypes.FeeCollectorName, feePayer, refundedCoins)
+			}
+			return k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, feePayer, refundedCoins)
+		})
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		// the allowance of the fee granter is only charged for the fees of the gas used
+		if err := k.refundFeeGrant(ctx, refundedCoins); err != nil {
+			return errorsmod.Wrapf(err, "failed to refund %s to the fee allowance", refundedCoins.String())
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -152,6 +152,12 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if req.Pending {
+		var err error
+		if ctx, _, err = k.PendingContext(ctx); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
 
 	balanceInt := k.SpendableCoin(ctx, common.HexToAddress(req.Address))
 
@@ -246,6 +252,12 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if req.Pending {
+		if ctx, _, err = k.PendingContext(ctx); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -681,6 +693,10 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
+	if len(req.Predecessors) > maxTracePredecessors {
+		return nil, status.Errorf(codes.InvalidArgument, "too many predecessors, got %d: limit %d", len(req.Predecessors), maxTracePredecessors)
+	}
+
 	// get the context of block beginning
 	requestedHeight := req.BlockNumber
 	if requestedHeight < 1 {
@@ -713,6 +729,39 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 	// Get empty tx config
 	txConfig := statedb.NewEmptyTxConfig()
 
+	// apply the predecessor calls so that the traced call sees their state changes
+	var predecessorResults []*any
+	for i, bz := range req.Predecessors {
+		var args types.TransactionArgs
+		if err := json.Unmarshal(bz, &args); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid predecessor %d: %s", i, err.Error())
+		}
+		nonce := k.GetNonce(ctx, args.GetFrom())
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+		if err := args.CallDefaults(req.GasCap, baseFee, types.GetEthChainConfig().ChainID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid predecessor %d: %s", i, err.Error())
+		}
+		msg := args.ToMessage(baseFee, true, true)
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+
+		// the traced predecessors are executed as the traced call
+		if req.TracePredecessors {
+			result, err := k.traceTxWithMsg(ctx, cfg, txConfig, msg, req.GetTraceConfig(), true)
+			if err != nil {
+				return nil, err
+			}
+			predecessorResults = append(predecessorResults, result)
+			continue
+		}
+
+		// as for the predecessors of TraceTx, failed calls are ignored
+		msg.GasLimit = min(msg.GasLimit, maxPredecessorGas)
+		_, _ = k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, nil, true, cfg, txConfig, false, nil)
+	}
+	if len(req.Predecessors) > 0 {
+		txConfig.TxIndex++
+	}
+
 	// Get transaction msg from args
 	var args types.TransactionArgs
 	err = json.Unmarshal(req.Args, &args)
@@ -734,7 +783,12 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 		return nil, err
 	}
 
-	resultData, err := json.Marshal(result)
+	var resultData []byte
+	if req.TracePredecessors {
+		resultData, err = json.Marshal(append(predecessorResults, result))
+	} else {
+		resultData, err = json.Marshal(result)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -810,9 +864,10 @@ func (k *Keeper) traceTxWithMsg(
 	}
 
 	tCtx := &tracers.Context{
-		BlockHash: common.BytesToHash(ctx.HeaderHash()),
-		TxIndex:   int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
-		TxHash:    txConfig.TxHash,
+		BlockHash:   common.BytesToHash(ctx.HeaderHash()),
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
+		TxHash:      txConfig.TxHash,
 	}
 
 	if traceConfig.Tracer != "" {
@@ -882,6 +937,16 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 	return &types.QueryGlobalMinGasPriceResponse{MinGasPrice: minGasPrice}, nil
 }
 
+// PendingLogs implements the Query/PendingLogs gRPC method
+func (k Keeper) PendingLogs(c context.Context, _ *types.QueryPendingLogsRequest) (*types.QueryPendingLogsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	_, logs, err := k.PendingContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingLogsResponse{Logs: types.NewLogsFromEth(logs)}, nil
+}
+
 // Config implements the Query/Config gRPC method
 func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
 	config := types.GetChainConfig()
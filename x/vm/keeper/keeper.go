@@ -57,6 +57,10 @@ type Keeper struct {
 
 	// access historical headers for EVM state transition execution
 	stakingKeeper types.StakingKeeper
+	// fund the community pool with the treasury share of the base fee
+	distrKeeper types.DistributionKeeper
+	// give back the unused fees of the sponsored transactions to the allowances
+	feeGrantKeeper types.FeeGrantKeeper
 	// fetch EIP1559 base fee and parameters
 	feeMarketWrapper *wrappers.FeeMarketWrapper
 	// optional erc20Keeper interface needed to instantiate erc20 precompiles
@@ -69,6 +73,8 @@ type Keeper struct {
 
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
+	// liveTracer is fed with the blocks and the EVM executions of FinalizeBlock
+	liveTracer *tracing.Hooks
 
 	hooks types.EvmHooks
 	// EVM Hooks for tx post-processing
@@ -81,6 +87,11 @@ type Keeper struct {
 	// evmMempool is the custom EVM appside mempool
 	// if it is nil, the default comet mempool will be used
 	evmMempool *evmmempool.ExperimentalEVMMempool
+	// pendingTxSource provides the transactions executed in the pending state
+	pendingTxSource types.PendingTxSource
+	// pendingState caches the pending state until the head or the pending
+	// transactions change
+	pendingState *pendingStateCache
 
 	// virtualFeeCollection enabling will use "Virtual" methods from the bank module to accumulate
 	// fees to the fee collector module in the endBlocker instead of using regular sends during tx execution.
@@ -143,12 +154,15 @@ func NewKeeper(
 		consensusKeeper:  consensusKeeper,
 		erc20Keeper:      erc20Keeper,
 		storeKeys:        storeKeys,
+		pendingState:     &pendingStateCache{},
 	}
 }
 
 // EnableVirtualFeeCollection switches fee deduction for evm transactions to use the virtual fee collection of the
 // bank keeper via the object store.
 // Note: Do NOT use this if your chain does not have an 18 decimal point precision gas token.
+// The base fee burn and treasury shares of the feemarket params are applied to the evm transactions of the
+// block in the EVM EndBlocker, which must run after the bank EndBlocker crediting the fee collector.
 func (k *Keeper) EnableVirtualFeeCollection() {
 	k.virtualFeeCollection = true
 }
@@ -165,6 +179,20 @@ func (k *Keeper) WithDefaultEvmCoinInfo(coinInfo types.EvmCoinInfo) *Keeper {
 	return k
 }
 
+// WithDistributionKeeper sets the distribution keeper used to fund the community
+// pool with the treasury share of the base fee.
+func (k *Keeper) WithDistributionKeeper(dk types.DistributionKeeper) *Keeper {
+	k.distrKeeper = dk
+	return k
+}
+
+// WithFeeGrantKeeper sets the feegrant keeper used to charge the allowances of
+// the sponsored transactions for the fees of the gas used only.
+func (k *Keeper) WithFeeGrantKeeper(fk types.FeeGrantKeeper) *Keeper {
+	k.feeGrantKeeper = fk
+	return k
+}
+
 // ----------------------------------------------------------------------------
 // Block Bloom
 // Required by Web3 API.
@@ -261,8 +289,12 @@ func (k Keeper) GetAccountStorage(ctx sdk.Context, address common.Address) types
 // Account
 // ----------------------------------------------------------------------------
 
-// Tracer return a default vm.Tracer based on current keeper state
+// Tracer return a default vm.Tracer based on current keeper state, the live
+// tracer is returned during the block processing if set.
 func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *ethparams.ChainConfig) *tracing.Hooks {
+	if k.liveTracer != nil && ctx.ExecMode() == sdk.ExecModeFinalize {
+		return k.liveTracer
+	}
 	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight(), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 }
 
@@ -401,6 +433,37 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	return result, nil
 }
 
+// ResetTxFeePayers clears the fee payers and the fee grants of the block transactions,
+// called in EndBlocker.
+func (k Keeper) ResetTxFeePayers(ctx sdk.Context) {
+	for _, keyPrefix := range [][]byte{types.KeyPrefixObjectFeePayer, types.KeyPrefixObjectFeeGrant} {
+		store := prefix.NewObjStore(ctx.ObjectStore(k.objectKey), keyPrefix)
+		it := store.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			store.Delete(it.Key())
+		}
+		it.Close()
+	}
+}
+
+// GetTxFeePayer returns the account which paid the fees of the current cosmos tx,
+// nil if not set.
+func (k Keeper) GetTxFeePayer(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.ObjectStore(k.objectKey)
+	v := store.Get(types.ObjectFeePayerKey(ctx.TxIndex()))
+	if v == nil {
+		return nil
+	}
+	return v.(sdk.AccAddress)
+}
+
+// SetTxFeePayer sets the account which paid the fees of the current cosmos tx, the
+// leftover gas is refunded to it.
+func (k Keeper) SetTxFeePayer(ctx sdk.Context, feePayer sdk.AccAddress) {
+	store := ctx.ObjectStore(k.objectKey)
+	store.Set(types.ObjectFeePayerKey(ctx.TxIndex()), feePayer)
+}
+
 // KVStoreKeys returns KVStore keys injected to keeper
 func (k Keeper) KVStoreKeys() map[string]storetypes.StoreKey {
 	return k.storeKeys
@@ -409,6 +472,10 @@ func (k Keeper) KVStoreKeys() map[string]storetypes.StoreKey {
 // SetEvmMempool sets the evm mempool
 func (k *Keeper) SetEvmMempool(evmMempool *evmmempool.ExperimentalEVMMempool) {
 	k.evmMempool = evmMempool
+	k.pendingTxSource = nil
+	if evmMempool != nil {
+		k.pendingTxSource = evmMempool
+	}
 }
 
 // GetEvmMempool returns the evm mempool
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithLiveTracer sets the live tracer fed with the blocks and the EVM
+// executions of FinalizeBlock. During the block processing, it takes precedence
+// over the tracer set in the app config.
+func (k *Keeper) WithLiveTracer(hooks *tracing.Hooks) *Keeper {
+	k.liveTracer = hooks
+	if hooks != nil && hooks.OnBlockchainInit != nil {
+		hooks.OnBlockchainInit(types.GetEthChainConfig())
+	}
+	return k
+}
+
+// CloseLiveTracer notifies the live tracer the node is shutting down.
+func (k *Keeper) CloseLiveTracer() {
+	if k.liveTracer != nil && k.liveTracer.OnClose != nil {
+		k.liveTracer.OnClose()
+	}
+}
+
+// traceBlockStart notifies the live tracer of the start of the block, with an
+// eth header built from the block context.
+func (k *Keeper) traceBlockStart(ctx sdk.Context) {
+	if k.liveTracer == nil || k.liveTracer.OnBlockStart == nil {
+		return
+	}
+	header := &ethtypes.Header{
+		ParentHash: common.BytesToHash(ctx.BlockHeader().LastBlockId.Hash),
+		Number:     big.NewInt(ctx.BlockHeight()),
+		GasLimit:   antetypes.BlockGasLimit(ctx),
+		Time:       uint64(ctx.BlockTime().Unix()), //#nosec G115 -- int overflow is not a concern here
+		Difficulty: big.NewInt(0),
+		BaseFee:    k.GetBaseFee(ctx),
+	}
+	if coinbase, err := k.GetCoinbaseAddress(ctx, ctx.BlockHeader().ProposerAddress); err == nil {
+		header.Coinbase = coinbase
+	}
+	k.liveTracer.OnBlockStart(tracing.BlockEvent{Block: ethtypes.NewBlockWithHeader(header)})
+}
+
+// traceBlockEnd notifies the live tracer of the end of the block.
+func (k *Keeper) traceBlockEnd() {
+	if k.liveTracer != nil && k.liveTracer.OnBlockEnd != nil {
+		k.liveTracer.OnBlockEnd(nil)
+	}
+}
+
+// traceBalanceChange applies the balance change of addr made outside the EVM
+// and notifies the live tracer of it during the block processing.
+func (k *Keeper) traceBalanceChange(ctx sdk.Context, addr common.Address, reason tracing.BalanceChangeReason, change func() error) error {
+	if k.liveTracer == nil || k.liveTracer.OnBalanceChange == nil || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return change()
+	}
+	prev := k.GetBalance(ctx, addr).ToBig()
+	if err := change(); err != nil {
+		return err
+	}
+	if balance := k.GetBalance(ctx, addr).ToBig(); balance.Cmp(prev) != 0 {
+		k.liveTracer.OnBalanceChange(addr, prev, balance, reason)
+	}
+	return nil
+}
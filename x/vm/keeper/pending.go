@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// maxPendingTxs bounds the number of pending transactions executed to build
+	// the pending state, in addition to the block gas limit.
+	maxPendingTxs = 1_000
+	// maxPendingExecutionTime bounds the time spent executing the pending
+	// transactions to build the pending state.
+	maxPendingExecutionTime = 500 * time.Millisecond
+)
+
+// pendingStateCache holds the last pending state built, which is shared by the
+// pending queries until the head or the pending transactions change.
+type pendingStateCache struct {
+	mtx sync.Mutex
+
+	height int64
+	digest common.Hash
+	store  storetypes.CacheMultiStore
+	logs   []*ethtypes.Log
+}
+
+// PendingContext returns a cache of the state of the given context on top of
+// which the pending transactions of the EVM mempool are executed, in the order
+// they would be selected for the next block. It also returns the logs emitted
+// by the executed transactions.
+//
+// Transactions that cannot be executed (e.g. nonce gaps or insufficient funds
+// for the fees) are skipped along with the subsequent ones of the same sender.
+// The execution is bounded by the block gas limit, maxPendingTxs and
+// maxPendingExecutionTime, and neither runs the EVM hooks nor distributes the
+// base fee. The pending state is built once per head and set of pending
+// transactions. Nothing is persisted, and the state of the context is returned
+// unchanged if the EVM mempool is not set.
+func (k *Keeper) PendingContext(ctx sdk.Context) (sdk.Context, []*ethtypes.Log, error) {
+	if k.pendingTxSource == nil {
+		pendingCtx, _ := ctx.CacheContext()
+		return pendingCtx, nil, nil
+	}
+	ctx = k.SetConsensusParamsInCtx(ctx)
+
+	txs, digest := k.pendingTxSource.PendingEVMTransactions(ctx)
+
+	cache := k.pendingState
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+
+	if cache.store == nil || cache.height != ctx.BlockHeight() || cache.digest != digest {
+		pendingCtx, _ := ctx.CacheContext()
+		logs := k.executePendingTransactions(pendingCtx, txs)
+
+		cache.height = ctx.BlockHeight()
+		cache.digest = digest
+		cache.store = pendingCtx.MultiStore().(storetypes.CacheMultiStore)
+		cache.logs = logs
+	}
+
+	// branch the shared state so that the caller cannot alter it
+	return ctx.WithMultiStore(cache.store.CacheMultiStore()), cache.logs, nil
+}
+
+// executePendingTransactions executes the pending transactions on top of the
+// state of the context and returns the logs they emitted.
+func (k *Keeper) executePendingTransactions(ctx sdk.Context, txs types.PendingTxIterator) []*ethtypes.Log {
+	baseFee := k.GetBaseFee(ctx)
+	ethCfg := types.GetEthChainConfig()
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()), blockTime)
+	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), true, blockTime)
+	gasLimit := antetypes.BlockGasLimit(ctx)
+	deadline := time.Now().Add(maxPendingExecutionTime)
+
+	var (
+		logs     []*ethtypes.Log
+		gasUsed  uint64
+		txIndex  int
+		executed int
+	)
+	for executed < maxPendingTxs && time.Now().Before(deadline) {
+		tx := txs.Peek()
+		if tx == nil {
+			break
+		}
+		if gasLimit > 0 && gasUsed+tx.Gas() > gasLimit {
+			txs.Pop()
+			continue
+		}
+
+		executed++
+		txCtx := ctx.WithTxIndex(txIndex).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		res, err := k.applyPendingTransaction(txCtx, tx, signer, rules, baseFee)
+		if err != nil {
+			k.Logger(ctx).Debug("skipping pending transaction", "hash", tx.Hash().Hex(), "error", err.Error())
+			txs.Pop()
+			continue
+		}
+		txs.Shift()
+
+		for _, log := range types.LogsToEthereum(res.Logs) {
+			// the logs belong to the pending block, which has no hash yet
+			log.BlockNumber = uint64(ctx.BlockHeight()) + 1 //#nosec G115 -- block height is positive
+			log.TxIndex = uint(txIndex)                     //#nosec G115 -- tx index is positive
+			log.Index = uint(len(logs))
+			logs = append(logs, log)
+		}
+		gasUsed += res.GasUsed
+		txIndex++
+	}
+
+	return logs
+}
+
+// applyPendingTransaction executes the transaction the way it would be in a
+// block: the nonce of the sender is checked and incremented and the fees are
+// deducted, as done by the ante handler, before the state transition. The
+// changes are only written to the context when the transaction is executed.
+func (k *Keeper) applyPendingTransaction(
+	ctx sdk.Context,
+	tx *ethtypes.Transaction,
+	signer ethtypes.Signer,
+	rules ethparams.Rules,
+	baseFee *big.Int,
+) (*types.MsgEthereumTxResponse, error) {
+	from, err := signer.Sender(tx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to extract sender address from ethereum transaction")
+	}
+
+	tmpCtx, commitFn := ctx.CacheContext()
+
+	acct := k.accountKeeper.GetAccount(tmpCtx, from.Bytes())
+	if acct == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", from)
+	}
+	if acct.GetSequence() != tx.Nonce() {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d", tx.Nonce(), acct.GetSequence(),
+		)
+	}
+
+	feeBaseFee := baseFee
+	if feeBaseFee == nil {
+		feeBaseFee = new(big.Int)
+	}
+	fees, err := VerifyFee(tx, types.GetEVMCoinDenom(), feeBaseFee, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai, true)
+	if err != nil {
+		return nil, err
+	}
+	if !fees.IsZero() {
+		if err := k.DeductTxCostsFromUserBalance(tmpCtx, fees, from); err != nil {
+			return nil, err
+		}
+	}
+	k.SetTxFeePayer(tmpCtx, from.Bytes())
+
+	if err := acct.SetSequence(tx.Nonce() + 1); err != nil {
+		return nil, err
+	}
+	k.accountKeeper.SetAccount(tmpCtx, acct)
+
+	res, err := k.applyTransaction(tmpCtx, tx, true)
+	if err != nil {
+		return nil, err
+	}
+	commitFn()
+	return res, nil
+}
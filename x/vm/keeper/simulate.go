@@ -0,0 +1,719 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single request.
+	maxSimulateBlocks = 256
+
+	// simulateTimestampIncrement is the default increment between simulated
+	// block timestamps.
+	simulateTimestampIncrement = 12
+)
+
+var (
+	// simTransferTopic is the topic of the ERC-20 Transfer event, used for the
+	// logs of native value transfers (ERC-7528).
+	simTransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// simTransferAddress is the ERC-7528 address of the native token.
+	simTransferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+)
+
+// SimulateV1 implements the `eth_simulateV1` rpc api. It executes the given
+// blocks of calls sequentially on top of the requested block, so that every
+// call sees the state changes of the previous ones. None of the changes are
+// persisted.
+//
+// Errors that abort the whole simulation (e.g. invalid block numbers or, in
+// validation mode, invalid transactions) are returned in the response with
+// their JSON-RPC error code.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = k.SetConsensusParamsInCtx(ctx)
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// use the base fee of the block following the base block
+	if baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx); baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	gasCap := req.GasCap
+	if gasCap == 0 {
+		gasCap = math.MaxUint64
+	}
+
+	sim := &simulator{
+		k:   &k,
+		cfg: cfg,
+		base: &ethtypes.Header{
+			Number:   big.NewInt(ctx.BlockHeight()),
+			Time:     uint64(req.BlockTime.Unix()), //#nosec G115 -- int overflow is not a concern here
+			GasLimit: antetypes.BlockGasLimit(ctx),
+			Coinbase: cfg.CoinBase,
+		},
+		baseHash:       common.HexToHash(req.BlockHash),
+		gasRemaining:   gasCap,
+		validate:       opts.Validation,
+		traceTransfers: opts.TraceTransfers,
+	}
+
+	// the simulated blocks are executed on a branch of the state that is never written
+	simCtx, _ := ctx.CacheContext()
+
+	results, err := sim.execute(simCtx, opts.BlockStateCalls)
+	if err != nil {
+		var simErr *rpctypes.SimError
+		if errors.As(err, &simErr) {
+			return &types.SimulateV1Response{
+				Error:     simErr.Message,
+				ErrorCode: int64(simErr.Code),
+			}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Data: data}, nil
+}
+
+// simulator executes the blocks of an eth_simulateV1 request.
+type simulator struct {
+	k    *Keeper
+	cfg  *statedb.EVMConfig
+	base *ethtypes.Header
+	// baseHash is the hash of the base block, which is the CometBFT header hash
+	// and not the hash of base.
+	baseHash common.Hash
+	// gasRemaining is the gas left to be shared by all the simulated calls.
+	gasRemaining   uint64
+	validate       bool
+	traceTransfers bool
+}
+
+// execute runs the given blocks and returns their results.
+func (s *simulator) execute(ctx sdk.Context, blocks []rpctypes.SimBlock) ([]*rpctypes.SimBlockResult, error) {
+	if len(blocks) == 0 {
+		return nil, &rpctypes.SimError{Code: rpctypes.ErrCodeInvalidParams, Message: "empty input"}
+	}
+	if len(blocks) > maxSimulateBlocks {
+		return nil, &rpctypes.SimError{Code: rpctypes.ErrCodeClientLimitExceeded, Message: "too many blocks"}
+	}
+
+	blocks, err := s.sanitizeChain(blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results    = make([]*rpctypes.SimBlockResult, len(blocks))
+		parent     = s.base
+		parentHash = s.baseHash
+	)
+	for i, block := range blocks {
+		result, err := s.processBlock(ctx, block, parent, parentHash)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result
+		parent = result.Header
+		parentHash = result.Header.Hash()
+	}
+	return results, nil
+}
+
+// sanitizeChain checks that the block numbers and timestamps are increasing,
+// fills in their defaults and fills the gaps between the numbers with empty
+// blocks.
+func (s *simulator) sanitizeChain(blocks []rpctypes.SimBlock) ([]rpctypes.SimBlock, error) {
+	var (
+		res           = make([]rpctypes.SimBlock, 0, len(blocks))
+		prevNumber    = s.base.Number
+		prevTimestamp = s.base.Time
+	)
+	for _, block := range blocks {
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = new(rpctypes.BlockOverrides)
+		}
+		if block.BlockOverrides.Number == nil {
+			n := new(big.Int).Add(prevNumber, big.NewInt(1))
+			block.BlockOverrides.Number = (*hexutil.Big)(n)
+		}
+		diff := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), prevNumber)
+		if diff.Sign() <= 0 {
+			return nil, &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeBlockNumberInvalid,
+				Message: fmt.Sprintf("block numbers must be in order: %d <= %d", block.BlockOverrides.Number.ToInt(), prevNumber),
+			}
+		}
+		if total := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), s.base.Number); total.Cmp(big.NewInt(maxSimulateBlocks)) > 0 {
+			return nil, &rpctypes.SimError{Code: rpctypes.ErrCodeClientLimitExceeded, Message: "too many blocks"}
+		}
+		// fill the gap with empty blocks
+		for i := int64(1); i < diff.Int64(); i++ {
+			n := new(big.Int).Add(prevNumber, big.NewInt(i))
+			t := prevTimestamp + simulateTimestampIncrement
+			res = append(res, rpctypes.SimBlock{
+				BlockOverrides: &rpctypes.BlockOverrides{
+					Number: (*hexutil.Big)(n),
+					Time:   (*hexutil.Uint64)(&t),
+				},
+			})
+			prevTimestamp = t
+		}
+		prevNumber = block.BlockOverrides.Number.ToInt()
+
+		var t uint64
+		if block.BlockOverrides.Time == nil {
+			t = prevTimestamp + simulateTimestampIncrement
+			block.BlockOverrides.Time = (*hexutil.Uint64)(&t)
+		} else {
+			t = uint64(*block.BlockOverrides.Time)
+			if t <= prevTimestamp {
+				return nil, &rpctypes.SimError{
+					Code:    rpctypes.ErrCodeBlockTimestampInvalid,
+					Message: fmt.Sprintf("block timestamps must be in order: %d <= %d", t, prevTimestamp),
+				}
+			}
+		}
+		prevTimestamp = t
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// processBlock executes the calls of a single simulated block on top of the
+// state left by its parent and assembles the resulting block.
+func (s *simulator) processBlock(
+	ctx sdk.Context,
+	block rpctypes.SimBlock,
+	parent *ethtypes.Header,
+	parentHash common.Hash,
+) (*rpctypes.SimBlockResult, error) {
+	overrides := block.BlockOverrides
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   parent.Coinbase,
+		Difficulty: big.NewInt(0),
+		Number:     overrides.Number.ToInt(),
+		GasLimit:   parent.GasLimit,
+		Time:       uint64(*overrides.Time),
+		MixDigest:  common.MaxHash,
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.FeeRecipient != nil {
+		header.Coinbase = *overrides.FeeRecipient
+	}
+	switch {
+	case overrides.BaseFeePerGas != nil:
+		header.BaseFee = overrides.BaseFeePerGas.ToInt()
+	case s.cfg.BaseFee == nil:
+		// no base fee before London
+	case s.validate:
+		// NOTE: the base fee is not adjusted between the simulated blocks
+		header.BaseFee = new(big.Int).Set(s.cfg.BaseFee)
+	default:
+		// allow calls without gas price outside of validation mode
+		header.BaseFee = new(big.Int)
+	}
+
+	ctx = ctx.
+		WithBlockHeight(header.Number.Int64()).
+		WithBlockTime(time.Unix(int64(header.Time), 0).UTC()). //#nosec G115 -- int overflow is not a concern here
+		WithHeaderHash(nil)
+	ctx = withBlockGasLimit(ctx, header.GasLimit)
+
+	cfg := *s.cfg
+	cfg.CoinBase = header.Coinbase
+	cfg.BaseFee = header.BaseFee
+
+	rules := types.GetEthChainConfig().Rules(header.Number, true, header.Time)
+	precompileOverrides, err := s.k.applySimStateOverrides(ctx, block.StateOverrides, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		txs      = make([]*ethtypes.Transaction, len(block.Calls))
+		senders  = make([]common.Address, len(block.Calls))
+		receipts = make([]*ethtypes.Receipt, len(block.Calls))
+		results  = make([]rpctypes.SimCallResult, len(block.Calls))
+		allLogs  []*ethtypes.Log
+	)
+	for i, call := range block.Calls {
+		if err := s.sanitizeCall(ctx, &call, header); err != nil {
+			return nil, err
+		}
+
+		tx := call.ToTransaction(ethtypes.DynamicFeeTxType)
+		msg := call.ToMessage(header.BaseFee, !s.validate, true)
+		txConfig := statedb.NewTxConfig(tx.Hash(), uint(i)) //#nosec G115 -- int overflow is not a concern here
+
+		res, logs, err := s.applyMessage(ctx, msg, &cfg, txConfig, header.BaseFee, precompileOverrides)
+		if err != nil {
+			return nil, err
+		}
+
+		header.GasUsed += res.GasUsed
+		s.gasRemaining -= res.GasUsed
+
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: header.GasUsed,
+			Logs:              logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           res.GasUsed,
+			EffectiveGasPrice: msg.GasPrice,
+			BlockNumber:       header.Number,
+			TransactionIndex:  uint(i), //#nosec G115 -- int overflow is not a concern here
+		}
+		if msg.To == nil {
+			receipt.ContractAddress = crypto.CreateAddress(msg.From, msg.Nonce)
+		}
+
+		result := rpctypes.SimCallResult{
+			ReturnValue: res.Return(),
+			Logs:        logs,
+			GasUsed:     hexutil.Uint64(res.GasUsed),
+			Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if res.Failed() {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+			result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			if res.VmError == vm.ErrExecutionReverted.Error() {
+				revertErr := types.NewExecErrorWithReason(res.Revert())
+				result.Error = &rpctypes.SimCallError{
+					Message: revertErr.Error(),
+					Code:    rpctypes.ErrCodeReverted,
+					Data:    hexutil.Encode(res.Revert()),
+				}
+			} else {
+				result.Error = &rpctypes.SimCallError{
+					Message: res.VmError,
+					Code:    rpctypes.ErrCodeVMError,
+				}
+			}
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+
+		txs[i] = tx
+		senders[i] = msg.From
+		receipts[i] = receipt
+		results[i] = result
+		allLogs = append(allLogs, logs...)
+	}
+
+	simBlock := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	blockHash := simBlock.Hash()
+	for i, log := range allLogs {
+		log.BlockHash = blockHash
+		log.Index = uint(i) //#nosec G115 -- int overflow is not a concern here
+	}
+
+	// make the block hash available to the BLOCKHASH opcode of the following blocks
+	s.k.SetHeaderHash(ctx.WithHeaderHash(blockHash.Bytes()))
+
+	return &rpctypes.SimBlockResult{
+		Header:       simBlock.Header(),
+		Transactions: txs,
+		Senders:      senders,
+		Calls:        results,
+	}, nil
+}
+
+// sanitizeCall fills in the defaults of a simulated call.
+func (s *simulator) sanitizeCall(ctx sdk.Context, call *types.TransactionArgs, header *ethtypes.Header) error {
+	if call.Nonce == nil {
+		nonce := s.k.GetNonce(ctx, call.GetFrom())
+		call.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+	// let the call use all the gas left in the block unless explicitly specified
+	if call.Gas == nil {
+		remaining := header.GasLimit - header.GasUsed
+		call.Gas = (*hexutil.Uint64)(&remaining)
+	}
+	if header.GasUsed+uint64(*call.Gas) > header.GasLimit {
+		return &rpctypes.SimError{
+			Code:    rpctypes.ErrCodeBlockGasLimitReached,
+			Message: fmt.Sprintf("block gas limit reached: %d >= %d", header.GasUsed, header.GasLimit),
+		}
+	}
+	// the call gas is capped to the gas left to all the calls
+	if err := call.CallDefaults(s.gasRemaining, header.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+		return &rpctypes.SimError{Code: rpctypes.ErrCodeInvalidParams, Message: err.Error()}
+	}
+	if uint64(*call.Gas) > s.gasRemaining {
+		return &rpctypes.SimError{
+			Code:    rpctypes.ErrCodeInternalError,
+			Message: fmt.Sprintf("%s: have %d, want %d", core.ErrGasLimitReached, s.gasRemaining, uint64(*call.Gas)),
+		}
+	}
+	return nil
+}
+
+// applyMessage executes a simulated call and commits its state changes into
+// ctx. In validation mode, the message is checked like a transaction and the
+// sender is charged for the gas used. It returns the logs of the call, which
+// include the native value transfers if requested.
+func (s *simulator) applyMessage(
+	ctx sdk.Context,
+	msg *core.Message,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	baseFee *big.Int,
+	precompileOverrides *rpctypes.StateOverride,
+) (*types.MsgEthereumTxResponse, []*ethtypes.Log, error) {
+	if s.validate {
+		if err := s.preCheck(ctx, msg, baseFee); err != nil {
+			return nil, nil, simTxValidationError(err)
+		}
+		gasCost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)
+		if err := s.addBalance(ctx, msg.From, gasCost.Neg(gasCost)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// the nonce of contract creations is handled during the execution, the
+	// one of calls is incremented by the ante handler for transactions
+	if msg.To != nil {
+		acct := s.k.GetAccountOrEmpty(ctx, msg.From)
+		acct.Nonce++
+		if err := s.k.SetAccount(ctx, msg.From, acct); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		tracer *simTransferTracer
+		hooks  *tracing.Hooks
+	)
+	if s.traceTransfers {
+		tracer = &simTransferTracer{}
+		hooks = tracer.Hooks()
+	}
+
+	// NOTE: the message is applied as internal to report the gas actually used
+	// instead of the minimum gas charged to transactions, as the gas limit of
+	// the calls defaults to the gas left in the block.
+	res, err := s.k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, hooks, true, cfg, txConfig, true, precompileOverrides)
+	if err != nil {
+		return nil, nil, simTxValidationError(err)
+	}
+
+	if s.validate {
+		refund := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit-res.GasUsed), msg.GasPrice)
+		if err := s.addBalance(ctx, msg.From, refund); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+	if tracer != nil {
+		logs = tracer.merge(logs)
+	}
+	for _, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight())         //#nosec G115 -- int overflow is not a concern here
+		log.BlockTimestamp = uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+		log.TxHash = txConfig.TxHash
+		log.TxIndex = txConfig.TxIndex
+	}
+	return res, logs, nil
+}
+
+// preCheck performs the transaction validity checks that are done by the ante
+// handler for real transactions.
+func (s *simulator) preCheck(ctx sdk.Context, msg *core.Message, baseFee *big.Int) error {
+	stNonce := s.k.GetNonce(ctx, msg.From)
+	switch {
+	case stNonce < msg.Nonce:
+		return fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooHigh, msg.From.Hex(), msg.Nonce, stNonce)
+	case stNonce > msg.Nonce:
+		return fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooLow, msg.From.Hex(), msg.Nonce, stNonce)
+	}
+
+	if baseFee != nil {
+		if msg.GasFeeCap.Cmp(msg.GasTipCap) < 0 {
+			return fmt.Errorf("%w: address %v, maxPriorityFeePerGas: %s, maxFeePerGas: %s", core.ErrTipAboveFeeCap,
+				msg.From.Hex(), msg.GasTipCap, msg.GasFeeCap)
+		}
+		if msg.GasFeeCap.Cmp(baseFee) < 0 {
+			return fmt.Errorf("%w: address %v, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow,
+				msg.From.Hex(), msg.GasFeeCap, baseFee)
+		}
+	}
+
+	want := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+	want.Add(want, msg.Value)
+	have := s.k.GetAccountOrEmpty(ctx, msg.From).Balance.ToBig()
+	if have.Cmp(want) < 0 {
+		return fmt.Errorf("%w: address %v have %v want %v", core.ErrInsufficientFunds, msg.From.Hex(), have, want)
+	}
+	return nil
+}
+
+// addBalance adds the given (possibly negative) amount to the balance of addr.
+func (s *simulator) addBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	balance := s.k.GetAccountOrEmpty(ctx, addr).Balance.ToBig()
+	newBalance, overflow := uint256.FromBig(balance.Add(balance, amount))
+	if overflow || newBalance.Sign() < 0 {
+		return fmt.Errorf("invalid balance for address %s", addr.Hex())
+	}
+	return s.k.SetBalance(ctx, addr, newBalance)
+}
+
+// applySimStateOverrides validates the state overrides of a simulated block
+// and writes them into ctx, so that they remain visible to the following
+// blocks. It returns the overrides that move or replace precompiles, which
+// only apply to the calls of the current block.
+func (k *Keeper) applySimStateOverrides(
+	ctx sdk.Context,
+	overrides *rpctypes.StateOverride,
+	rules ethparams.Rules,
+) (*rpctypes.StateOverride, error) {
+	if overrides == nil {
+		return nil, nil
+	}
+
+	precompiles := vm.ActivePrecompiledContracts(rules)
+	// check the overrides against a state that is discarded afterwards
+	if err := overrides.Apply(statedb.New(ctx, k, statedb.NewEmptyTxConfig()), maps.Clone(precompiles)); err != nil {
+		return nil, &rpctypes.SimError{Code: rpctypes.ErrCodeInvalidParams, Message: err.Error()}
+	}
+
+	// NOTE: StateOverride.Apply finalises the state, dropping the dirty
+	// objects, so the overrides are written here instead.
+	var precompileOverrides rpctypes.StateOverride
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig())
+	for addr, account := range *overrides {
+		if _, isPrecompile := precompiles[addr]; isPrecompile {
+			if precompileOverrides == nil {
+				precompileOverrides = make(rpctypes.StateOverride)
+			}
+			precompileOverrides[addr] = rpctypes.OverrideAccount{MovePrecompileTo: account.MovePrecompileTo}
+		}
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce), tracing.NonceChangeUnspecified)
+		}
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			balance, _ := uint256.FromBig((*big.Int)(*account.Balance))
+			stateDB.SetBalance(addr, balance, tracing.BalanceChangeUnspecified)
+		}
+	}
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
+	for addr, account := range *overrides {
+		storage := account.StateDiff
+		if account.State != nil {
+			var keys []common.Hash
+			k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.DeleteState(ctx, addr, key)
+			}
+			storage = account.State
+		}
+		if storage == nil {
+			continue
+		}
+		for key, value := range *storage {
+			if value == (common.Hash{}) {
+				k.DeleteState(ctx, addr, key)
+			} else {
+				k.SetState(ctx, addr, key, value.Bytes())
+			}
+		}
+	}
+
+	if precompileOverrides == nil {
+		return nil, nil
+	}
+	return &precompileOverrides, nil
+}
+
+// withBlockGasLimit returns a context with the block gas limit of the
+// consensus params set to gasLimit.
+func withBlockGasLimit(ctx sdk.Context, gasLimit uint64) sdk.Context {
+	maxGas := int64(-1)
+	if gasLimit <= math.MaxInt64 {
+		maxGas = int64(gasLimit)
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp.Block == nil {
+		cp.Block = &tmproto.BlockParams{}
+	} else {
+		block := *cp.Block
+		cp.Block = &block
+	}
+	cp.Block.MaxGas = maxGas
+	return ctx.WithConsensusParams(cp)
+}
+
+// simTxValidationError maps the transaction validation errors to their
+// JSON-RPC error codes.
+func simTxValidationError(err error) error {
+	code := rpctypes.ErrCodeInternalError
+	switch {
+	case errors.Is(err, core.ErrNonceTooHigh):
+		code = rpctypes.ErrCodeNonceTooHigh
+	case errors.Is(err, core.ErrNonceTooLow):
+		code = rpctypes.ErrCodeNonceTooLow
+	case errors.Is(err, core.ErrTipAboveFeeCap),
+		errors.Is(err, core.ErrFeeCapTooLow):
+		code = rpctypes.ErrCodeInvalidParams
+	case errors.Is(err, core.ErrInsufficientFunds),
+		errors.Is(err, core.ErrInsufficientFundsForTransfer):
+		code = rpctypes.ErrCodeInsufficientFunds
+	case errors.Is(err, core.ErrIntrinsicGas),
+		errors.Is(err, core.ErrFloorDataGas):
+		code = rpctypes.ErrCodeIntrinsicGas
+	}
+	return &rpctypes.SimError{Code: code, Message: err.Error()}
+}
+
+// simTransferTracer records the native value transfers of a simulated call as
+// ERC-7528 Transfer logs.
+//
+// The logs emitted by the EVM are not passed to tracers, so each transfer log
+// keeps the number of EVM logs emitted before it to merge both afterwards.
+type simTransferTracer struct {
+	stateDB *statedb.StateDB
+	// frames holds the transfer logs of every call frame in progress
+	frames [][]simTransferLog
+	logs   []simTransferLog
+}
+
+type simTransferLog struct {
+	pos int
+	log *ethtypes.Log
+}
+
+// Hooks returns the tracing hooks of the tracer.
+func (t *simTransferTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: t.onTxStart,
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+	}
+}
+
+func (t *simTransferTracer) onTxStart(env *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.stateDB, _ = env.StateDB.(*statedb.StateDB)
+}
+
+func (t *simTransferTracer) onEnter(_ int, typ byte, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, nil)
+	if vm.OpCode(typ) == vm.DELEGATECALL || value == nil || value.Sign() <= 0 || t.stateDB == nil {
+		return
+	}
+	last := len(t.frames) - 1
+	t.frames[last] = append(t.frames[last], simTransferLog{
+		pos: len(t.stateDB.Logs()),
+		log: &ethtypes.Log{
+			Address: simTransferAddress,
+			Topics: []common.Hash{
+				simTransferTopic,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *simTransferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+	last := len(t.frames) - 1
+	frame := t.frames[last]
+	t.frames = t.frames[:last]
+	if reverted {
+		return
+	}
+	if last == 0 {
+		t.logs = frame
+		return
+	}
+	t.frames[last-1] = append(t.frames[last-1], frame...)
+}
+
+// merge interleaves the recorded transfer logs with the given EVM logs in
+// execution order.
+func (t *simTransferTracer) merge(logs []*ethtypes.Log) []*ethtypes.Log {
+	if len(t.logs) == 0 {
+		return logs
+	}
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(t.logs))
+	j := 0
+	for i, log := range logs {
+		for ; j < len(t.logs) && t.logs[j].pos <= i; j++ {
+			merged = append(merged, t.logs[j].log)
+		}
+		merged = append(merged, log)
+	}
+	for ; j < len(t.logs); j++ {
+		merged = append(merged, t.logs[j].log)
+	}
+	return merged
+}
@@ -196,6 +196,13 @@ func calculateCumulativeGasFromEthResponse(meter storetypes.GasMeter, res *types
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
+	return k.applyTransaction(ctx, tx, false)
+}
+
+// applyTransaction implements ApplyTransaction. Pending transactions are executed
+// to build the pending state, without running the EVM hooks nor distributing the
+// base fee, which only happen to the transactions of a block.
+func (k *Keeper) applyTransaction(ctx sdk.Context, tx *ethtypes.Transaction, pending bool) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
@@ -263,7 +270,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	// Only call PostTxProcessing if there are hooks set, to avoid calling commitFn unnecessarily
-	if !k.HasHooks() {
+	if pending || !k.HasHooks() {
 		// If there are no hooks, we can commit the state immediately if the tx is successful
 		if commitFn != nil && !res.Failed() {
 			commitFn()
@@ -307,6 +314,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrapf(err, "failed to refund leftover gas to sender %s", msg.From)
 	}
 
+	// burn or send to the treasury the shares of the base fee paid for the gas used
+	if !pending {
+		if err = k.distributeTxBaseFee(ctx, res.GasUsed); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to distribute base fee")
+		}
+	}
+
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
@@ -400,14 +414,27 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
 	vmCfg := evm.Config
 	if vmCfg.Tracer != nil {
-		vmCfg.Tracer.OnTxStart(
-			evm.GetVMContext(),
-			ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
-			msg.From,
-		)
+		stateDB.SetTracer(vmCfg.Tracer)
+		if vmCfg.Tracer.OnTxStart != nil {
+			vmCfg.Tracer.OnTxStart(
+				evm.GetVMContext(),
+				ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: msg.Nonce, To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit, GasPrice: msg.GasPrice}),
+				msg.From,
+			)
+		}
 		defer func() {
 			if vmCfg.Tracer.OnTxEnd != nil {
-				vmCfg.Tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: msg.GasLimit - leftoverGas}, vmErr)
+				receipt := &ethtypes.Receipt{
+					Status:           ethtypes.ReceiptStatusSuccessful,
+					TxHash:           txConfig.TxHash,
+					GasUsed:          msg.GasLimit - leftoverGas,
+					BlockNumber:      evm.Context.BlockNumber,
+					TransactionIndex: txConfig.TxIndex,
+				}
+				if vmErr != nil {
+					receipt.Status = ethtypes.ReceiptStatusFailed
+				}
+				vmCfg.Tracer.OnTxEnd(receipt, vmErr)
 			}
 		}()
 	}
@@ -3,6 +3,7 @@ package statedb
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"slices"
 	"sort"
 
@@ -80,6 +81,9 @@ type StateDB struct {
 
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
+
+	// hooks are the tracer hooks notified of the state changes
+	hooks *tracing.Hooks
 }
 
 func (s *StateDB) CreateContract(address common.Address) {
@@ -159,6 +163,12 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	}
 }
 
+// SetTracer sets the tracer hooks notified of the balance, nonce, code and
+// storage changes and of the emitted logs.
+func (s *StateDB) SetTracer(hooks *tracing.Hooks) {
+	s.hooks = hooks
+}
+
 // Keeper returns the underlying `Keeper`
 func (s *StateDB) Keeper() Keeper {
 	return s.keeper
@@ -224,6 +234,9 @@ func (s *StateDB) AddLog(log *ethtypes.Log) {
 	log.TxIndex = s.txConfig.TxIndex
 	log.Index = uint(len(s.logs))
 	s.logs = append(s.logs, log)
+	if s.hooks != nil && s.hooks.OnLog != nil {
+		s.hooks.OnLog(log)
+	}
 }
 
 // Logs returns the logs of current transaction.
@@ -472,7 +485,11 @@ func (s *StateDB) AddBalance(addr common.Address, amount *uint256.Int, reason tr
 	if stateObject == nil {
 		return uint256.Int{}
 	}
-	return stateObject.AddBalance(amount)
+	prev := stateObject.AddBalance(amount)
+	if s.hooks != nil && s.hooks.OnBalanceChange != nil && !amount.IsZero() {
+		s.hooks.OnBalanceChange(addr, prev.ToBig(), new(uint256.Int).Add(&prev, amount).ToBig(), reason)
+	}
+	return prev
 }
 
 // SubBalance subtracts amount from the account associated with addr.
@@ -484,14 +501,25 @@ func (s *StateDB) SubBalance(addr common.Address, amount *uint256.Int, reason tr
 	if amount.IsZero() {
 		return *(stateObject.Balance())
 	}
-	return stateObject.SubBalance(amount)
+	prev := stateObject.SubBalance(amount)
+	if s.hooks != nil && s.hooks.OnBalanceChange != nil {
+		s.hooks.OnBalanceChange(addr, prev.ToBig(), new(uint256.Int).Sub(&prev, amount).ToBig(), reason)
+	}
+	return prev
 }
 
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64, reason tracing.NonceChangeReason) {
 	stateObject := s.getOrNewStateObject(addr)
-	if stateObject != nil {
-		stateObject.SetNonce(nonce)
+	if stateObject == nil {
+		return
+	}
+	prev := stateObject.Nonce()
+	stateObject.SetNonce(nonce)
+	if s.hooks != nil && s.hooks.OnNonceChangeV2 != nil {
+		s.hooks.OnNonceChangeV2(addr, prev, nonce, reason)
+	} else if s.hooks != nil && s.hooks.OnNonceChange != nil {
+		s.hooks.OnNonceChange(addr, prev, nonce)
 	}
 }
 
@@ -500,8 +528,13 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) []byte {
 	stateObject := s.getOrNewStateObject(addr)
 	var prev []byte
 	if stateObject != nil {
+		prevHash := common.BytesToHash(stateObject.CodeHash())
 		prev = slices.Clone(stateObject.code)
-		stateObject.SetCode(crypto.Keccak256Hash(code), code)
+		codeHash := crypto.Keccak256Hash(code)
+		stateObject.SetCode(codeHash, code)
+		if s.hooks != nil && s.hooks.OnCodeChange != nil {
+			s.hooks.OnCodeChange(addr, prevHash, prev, codeHash, code)
+		}
 	}
 	return prev
 }
@@ -509,7 +542,11 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) []byte {
 // SetState sets the contract state.
 func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.Hash {
 	if stateObject := s.getOrNewStateObject(addr); stateObject != nil {
-		return stateObject.SetState(key, value)
+		prev := stateObject.SetState(key, value)
+		if s.hooks != nil && s.hooks.OnStorageChange != nil && prev != value {
+			s.hooks.OnStorageChange(addr, key, prev, value)
+		}
+		return prev
 	}
 	return common.Hash{}
 }
@@ -549,6 +586,9 @@ func (s *StateDB) SelfDestruct(addr common.Address) uint256.Int {
 	})
 	stateObject.markSelfDestructed()
 	stateObject.account.Balance = new(uint256.Int)
+	if s.hooks != nil && s.hooks.OnBalanceChange != nil && !prevBalance.IsZero() {
+		s.hooks.OnBalanceChange(addr, prevBalance.ToBig(), new(big.Int), tracing.BalanceDecreaseSelfdestruct)
+	}
 	return prevBalance
 }
 
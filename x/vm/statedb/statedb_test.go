@@ -617,6 +617,54 @@ func (suite *StateDBTestSuite) TestLog() {
 	suite.Require().Equal(expecedLog, db.Logs()[1])
 }
 
+func (suite *StateDBTestSuite) TestTracerHooks() {
+	type balanceChange struct {
+		addr      common.Address
+		prev, new string
+	}
+	var (
+		balanceChanges []balanceChange
+		nonceChanges   int
+		codeChanges    int
+		storageChanges int
+		logs           int
+	)
+	hooks := &tracing.Hooks{
+		OnBalanceChange: func(addr common.Address, prev, new *big.Int, _ tracing.BalanceChangeReason) {
+			balanceChanges = append(balanceChanges, balanceChange{addr, prev.String(), new.String()})
+		},
+		OnNonceChange:   func(common.Address, uint64, uint64) { nonceChanges++ },
+		OnCodeChange:    func(common.Address, common.Hash, []byte, common.Hash, []byte) { codeChanges++ },
+		OnStorageChange: func(common.Address, common.Hash, common.Hash, common.Hash) { storageChanges++ },
+		OnLog:           func(*ethtypes.Log) { logs++ },
+	}
+
+	db := statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), emptyTxConfig)
+	db.SetTracer(hooks)
+	db.AddBalance(address, uint256.NewInt(10), tracing.BalanceChangeTransfer)
+	db.SubBalance(address, uint256.NewInt(3), tracing.BalanceChangeTransfer)
+	// the zero changes are not notified
+	db.AddBalance(address, uint256.NewInt(0), tracing.BalanceChangeTransfer)
+	db.SubBalance(address, uint256.NewInt(0), tracing.BalanceChangeTransfer)
+	db.SetNonce(address, 1, tracing.NonceChangeUnspecified)
+	db.SetCode(address, []byte("code"))
+	db.SetState(address, common.Hash{1}, common.Hash{2})
+	// the storage is unchanged
+	db.SetState(address, common.Hash{1}, common.Hash{2})
+	db.AddLog(&ethtypes.Log{Address: address})
+	db.SelfDestruct(address)
+
+	suite.Require().Equal([]balanceChange{
+		{address, "0", "10"},
+		{address, "10", "7"},
+		{address, "7", "0"},
+	}, balanceChanges)
+	suite.Require().Equal(1, nonceChanges)
+	suite.Require().Equal(1, codeChanges)
+	suite.Require().Equal(1, storageChanges)
+	suite.Require().Equal(1, logs)
+}
+
 func (suite *StateDBTestSuite) TestRefund() {
 	testCases := []struct {
 		name      string
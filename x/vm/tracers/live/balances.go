@@ -0,0 +1,137 @@
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func init() {
+	tracers.LiveDirectory.Register(TracerBalances, newBalanceTracer)
+}
+
+// balanceChange is a balance change of an account
+type balanceChange struct {
+	// TxHash is the hash of the eth tx changing the balance, empty if the
+	// balance isn't changed by an eth tx
+	TxHash  common.Hash    `json:"txHash"`
+	Address common.Address `json:"address"`
+	Prev    *hexutil.Big   `json:"prev"`
+	New     *hexutil.Big   `json:"new"`
+	Reason  string         `json:"reason"`
+}
+
+// blockBalanceChanges are the balance changes of a block, written as a json
+// line at the end of the block
+type blockBalanceChanges struct {
+	Number  uint64          `json:"blockNumber"`
+	Changes []balanceChange `json:"changes"`
+}
+
+// balanceTracer records the balance changes made by the EVM executions, the
+// changes of the reverted calls are discarded.
+type balanceTracer struct {
+	sink  io.WriteCloser
+	block blockBalanceChanges
+	// frames are the changes of the pending calls of the current eth tx, the
+	// first frame holds the changes made outside the calls
+	frames [][]balanceChange
+}
+
+func newBalanceTracer(cfg json.RawMessage) (*tracing.Hooks, error) {
+	var config FileSinkConfig
+	if err := json.Unmarshal(cfg, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	sink, err := NewFileSink(config, "balances.jsonl")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &balanceTracer{sink: sink}
+	return &tracing.Hooks{
+		OnBlockStart:    t.onBlockStart,
+		OnBlockEnd:      t.onBlockEnd,
+		OnTxStart:       t.onTxStart,
+		OnTxEnd:         t.onTxEnd,
+		OnEnter:         t.onEnter,
+		OnExit:          t.onExit,
+		OnBalanceChange: t.onBalanceChange,
+		OnClose:         t.onClose,
+	}, nil
+}
+
+func (t *balanceTracer) onBlockStart(ev tracing.BlockEvent) {
+	t.block = blockBalanceChanges{Number: ev.Block.NumberU64()}
+}
+
+func (t *balanceTracer) onBlockEnd(_ error) {
+	bz, err := json.Marshal(t.block)
+	if err != nil {
+		log.Warn("Failed to marshal balance changes", "block", t.block.Number, "err", err)
+		return
+	}
+	if _, err := t.sink.Write(append(bz, '\n')); err != nil {
+		log.Warn("Failed to write balance changes", "block", t.block.Number, "err", err)
+	}
+}
+
+func (t *balanceTracer) onTxStart(_ *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.frames = [][]balanceChange{nil}
+}
+
+func (t *balanceTracer) onTxEnd(receipt *ethtypes.Receipt, _ error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	for _, change := range t.frames[0] {
+		if receipt != nil {
+			change.TxHash = receipt.TxHash
+		}
+		t.block.Changes = append(t.block.Changes, change)
+	}
+	t.frames = nil
+}
+
+func (t *balanceTracer) onEnter(_ int, _ byte, _, _ common.Address, _ []byte, _ uint64, _ *big.Int) {
+	t.frames = append(t.frames, nil)
+}
+
+func (t *balanceTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	if len(t.frames) < 2 {
+		return
+	}
+	changes := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if !reverted {
+		t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], changes...)
+	}
+}
+
+func (t *balanceTracer) onBalanceChange(addr common.Address, prev, newBalance *big.Int, reason tracing.BalanceChangeReason) {
+	change := balanceChange{
+		Address: addr,
+		Prev:    (*hexutil.Big)(prev),
+		New:     (*hexutil.Big)(newBalance),
+		Reason:  reason.String(),
+	}
+	if len(t.frames) == 0 {
+		t.block.Changes = append(t.block.Changes, change)
+		return
+	}
+	t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], change)
+}
+
+func (t *balanceTracer) onClose() {
+	if err := t.sink.Close(); err != nil {
+		log.Warn("Failed to close balance tracer sink", "err", err)
+	}
+}
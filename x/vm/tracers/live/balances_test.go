@@ -0,0 +1,73 @@
+package live_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/tracers/live"
+)
+
+func TestNew(t *testing.T) {
+	_, err := live.New("unknown", nil, live.FileSinkConfig{Path: t.TempDir()})
+	require.Error(t, err)
+	_, err = live.New(live.TracerBalances, json.RawMessage("{"), live.FileSinkConfig{Path: t.TempDir()})
+	require.Error(t, err)
+	_, err = live.New(live.TracerBalances, nil, live.FileSinkConfig{})
+	require.Error(t, err)
+
+	// the path of the tracer config takes precedence over the sink one
+	dir := t.TempDir()
+	hooks, err := live.New(live.TracerSupply, json.RawMessage(`{"path":"`+dir+`"}`), live.FileSinkConfig{Path: "unused"})
+	require.NoError(t, err)
+	hooks.OnBlockStart(tracing.BlockEvent{Block: ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(1)})})
+	hooks.OnBlockEnd(nil)
+	hooks.OnClose()
+	require.FileExists(t, filepath.Join(dir, "supply.jsonl"))
+}
+
+func TestBalanceTracer(t *testing.T) {
+	dir := t.TempDir()
+	hooks, err := live.New(live.TracerBalances, nil, live.FileSinkConfig{Path: dir})
+	require.NoError(t, err)
+
+	addr1, addr2 := common.Address{1}, common.Address{2}
+	txHash := common.Hash{1}
+	for _, number := range []int64{1, 2} {
+		hooks.OnBlockStart(tracing.BlockEvent{Block: ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(number)})})
+		if number == 2 {
+			hooks.OnTxStart(nil, nil, addr1)
+			hooks.OnEnter(0, 0, addr1, addr2, nil, 0, nil)
+			hooks.OnBalanceChange(addr1, big.NewInt(10), big.NewInt(9), tracing.BalanceChangeTransfer)
+			// the changes of the reverted call are discarded
+			hooks.OnEnter(1, 0, addr2, addr1, nil, 0, nil)
+			hooks.OnBalanceChange(addr2, big.NewInt(0), big.NewInt(1), tracing.BalanceChangeTransfer)
+			hooks.OnExit(1, nil, 0, nil, true)
+			hooks.OnExit(0, nil, 0, nil, false)
+			hooks.OnTxEnd(&ethtypes.Receipt{TxHash: txHash}, nil)
+		}
+		hooks.OnBlockEnd(nil)
+	}
+	hooks.OnClose()
+
+	bz, err := os.ReadFile(filepath.Join(dir, "balances.jsonl"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	require.Len(t, lines, 2)
+	require.JSONEq(t, `{"blockNumber":1,"changes":null}`, lines[0])
+	require.JSONEq(t, `{"blockNumber":2,"changes":[{
+		"txHash":"`+txHash.Hex()+`",
+		"address":"`+strings.ToLower(addr1.Hex())+`",
+		"prev":"0xa",
+		"new":"0x9",
+		"reason":"Transfer"
+	}]}`, lines[1])
+}
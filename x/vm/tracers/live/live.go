@@ -0,0 +1,80 @@
+// Package live provides the live tracers fed with the blocks and the EVM
+// executions processed by the node, along with the rotating file sink they
+// write their output to.
+//
+// The tracers are instantiated from the go-ethereum live tracers directory, so
+// custom tracers can be made available by registering their constructor with
+// tracers.LiveDirectory.Register before the app is created.
+package live
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	// register the go-ethereum supply tracer
+	_ "github.com/ethereum/go-ethereum/eth/tracers/live"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	// TracerSupply is the go-ethereum tracer recording the supply changes of
+	// each block
+	TracerSupply = "supply"
+	// TracerBalances is the tracer recording the balance changes of each block
+	TracerBalances = "balances"
+)
+
+// FileSinkConfig is the config of the rotating file sink, it's decoded from
+// the config of the tracers writing to it.
+type FileSinkConfig struct {
+	// Path is the directory the output files are written to
+	Path string `json:"path"`
+	// MaxSize is the size in megabytes of an output file before it gets
+	// rotated, it defaults to 100 megabytes.
+	MaxSize int `json:"maxSize"`
+}
+
+// NewFileSink creates a sink writing to the named file of the directory, the
+// file is rotated once it reaches the max size.
+func NewFileSink(cfg FileSinkConfig, fileName string) (io.WriteCloser, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("tracer output path is required")
+	}
+	return &lumberjack.Logger{
+		Filename: filepath.Join(cfg.Path, fileName),
+		MaxSize:  cfg.MaxSize,
+	}, nil
+}
+
+// New instantiates the live tracer registered under the name with its json
+// config. The path and the max size of the file sink are set from the sink
+// config unless they are set by the tracer config.
+func New(name string, config json.RawMessage, sink FileSinkConfig) (*tracing.Hooks, error) {
+	fields := make(map[string]any)
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, &fields); err != nil {
+			return nil, fmt.Errorf("invalid config of live tracer %s: %w", name, err)
+		}
+	}
+	if _, ok := fields["path"]; !ok {
+		fields["path"] = sink.Path
+	}
+	if _, ok := fields["maxSize"]; !ok && sink.MaxSize > 0 {
+		fields["maxSize"] = sink.MaxSize
+	}
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := tracers.LiveDirectory.New(name, bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create live tracer %s: %w", name, err)
+	}
+	return hooks, nil
+}
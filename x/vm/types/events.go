@@ -2,9 +2,10 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeFeeMarket  = "evm_fee_market"
+	EventTypeEthereumTx  = TypeMsgEthereumTx
+	EventTypeBlockBloom  = "block_bloom"
+	EventTypeFeeMarket   = "evm_fee_market"
+	EventTypeBaseFeeHeld = "base_fee_held"
 
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
@@ -14,6 +14,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -55,6 +56,19 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModuleVirtual(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected distribution keeper interface
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FeeGrantKeeper defines the expected feegrant keeper interface, the unused fees
+// of the sponsored transactions are given back to the allowances
+type FeeGrantKeeper interface {
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+}
+
 // StakingKeeper returns the historical headers kept in store.
 type StakingKeeper interface {
 	GetHistoricalInfo(ctx context.Context, height int64) (stakingtypes.HistoricalInfo, error)
@@ -100,3 +114,23 @@ type ConsensusParamsKeeper interface {
 type VMKeeper interface {
 	GetEvmCoinInfo(ctx sdk.Context) (coinInfo EvmCoinInfo)
 }
+
+// PendingTxSource defines the expected interface of the mempool providing the
+// EVM transactions pending inclusion in the next block.
+type PendingTxSource interface {
+	// PendingEVMTransactions returns the executable transactions in the order
+	// they are selected for the next block, and a digest of the set.
+	PendingEVMTransactions(ctx sdk.Context) (PendingTxIterator, common.Hash)
+}
+
+// PendingTxIterator iterates over the pending EVM transactions, the ones of an
+// account by nonce.
+type PendingTxIterator interface {
+	// Peek returns the next transaction, nil if there is none left.
+	Peek() *ethtypes.Transaction
+	// Shift moves to the next transaction of the sender of the current one.
+	Shift()
+	// Pop leaves out the current transaction along with the following ones of
+	// its sender.
+	Pop()
+}
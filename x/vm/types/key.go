@@ -30,12 +30,16 @@ const (
 	prefixParams
 	prefixCodeHash
 	prefixEvmCoinInfo
+	prefixHeldBaseFee
 )
 
 // prefix bytes for the EVM object store
 const (
 	prefixObjectBloom = iota + 1
 	prefixObjectGasUsed
+	prefixObjectFeePayer
+	prefixObjectBaseFee
+	prefixObjectFeeGrant
 )
 
 // KVStore key prefixes
@@ -45,12 +49,16 @@ var (
 	KeyPrefixParams      = []byte{prefixParams}
 	KeyPrefixCodeHash    = []byte{prefixCodeHash}
 	KeyPrefixEvmCoinInfo = []byte{prefixEvmCoinInfo}
+	KeyPrefixHeldBaseFee = []byte{prefixHeldBaseFee}
 )
 
 // Object Store key prefixes
 var (
-	KeyPrefixObjectBloom   = []byte{prefixObjectBloom}
-	KeyPrefixObjectGasUsed = []byte{prefixObjectGasUsed}
+	KeyPrefixObjectBloom    = []byte{prefixObjectBloom}
+	KeyPrefixObjectGasUsed  = []byte{prefixObjectGasUsed}
+	KeyPrefixObjectFeePayer = []byte{prefixObjectFeePayer}
+	KeyPrefixObjectBaseFee  = []byte{prefixObjectBaseFee}
+	KeyPrefixObjectFeeGrant = []byte{prefixObjectFeeGrant}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -70,6 +78,20 @@ func ObjectGasUsedKey(txIndex int) []byte {
 	return key[:]
 }
 
+func ObjectFeePayerKey(txIndex int) []byte {
+	var key [1 + 8]byte
+	key[0] = prefixObjectFeePayer
+	binary.BigEndian.PutUint64(key[1:], uint64(txIndex)) //nolint:gosec
+	return key[:]
+}
+
+func ObjectBaseFeeKey(txIndex int) []byte {
+	var key [1 + 8]byte
+	key[0] = prefixObjectBaseFee
+	binary.BigEndian.PutUint64(key[1:], uint64(txIndex)) //nolint:gosec
+	return key[:]
+}
+
 func ObjectBloomKey(txIndex, msgIndex int) []byte {
 	var key [1 + 8 + 8]byte
 	key[0] = prefixObjectBloom
@@ -77,3 +99,10 @@ func ObjectBloomKey(txIndex, msgIndex int) []byte {
 	binary.BigEndian.PutUint64(key[9:], uint64(msgIndex)) //nolint:gosec
 	return key[:]
 }
+
+func ObjectFeeGrantKey(txIndex int) []byte {
+	var key [1 + 8]byte
+	key[0] = prefixObjectFeeGrant
+	binary.BigEndian.PutUint64(key[1:], uint64(txIndex)) //nolint:gosec
+	return key[:]
+}
@@ -40,6 +40,9 @@ var (
 const (
 	// TypeMsgEthereumTx defines the type string of an Ethereum transaction
 	TypeMsgEthereumTx = "ethereum_tx"
+	// FeeGrantSignPrefix prefixes the transaction hash signed by the fee granter
+	// of a sponsored Ethereum transaction
+	FeeGrantSignPrefix = "evm_fee_grant:"
 )
 
 var MsgEthereumTxCustomGetSigner = txsigning.CustomGetSigner{
@@ -215,6 +218,21 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 	return msg.FromSignedEthereumTx(tx, ethSigner)
 }
 
+// FeeGrantSignBytes returns the bytes signed by the fee granter of a sponsored
+// Ethereum transaction. They bind the fee granter set on the wrapping Cosmos tx,
+// which isn't covered by the Ethereum signature, to the transaction hash.
+func FeeGrantSignBytes(txHash common.Hash) []byte {
+	return append([]byte(FeeGrantSignPrefix), txHash.Bytes()...)
+}
+
+// SetFeeGrant sets the fee granter paying the fees of the Ethereum transaction
+// on the wrapping Cosmos tx, along with the granter signature of the
+// FeeGrantSignBytes of the transaction.
+func SetFeeGrant(txBuilder client.TxBuilder, granter sdk.AccAddress, sig signingtypes.SignatureV2) error {
+	txBuilder.SetFeeGranter(granter)
+	return txBuilder.SetSignatures(sig)
+}
+
 // GetGas implements the GasTx interface. It returns the GasLimit of the transaction.
 func (msg MsgEthereumTx) GetGas() uint64 {
 	return msg.Raw.Gas()